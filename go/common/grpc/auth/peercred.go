@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// PeerCredentialsAuthType is the authentication type of local socket peer credentials.
+const PeerCredentialsAuthType = "peercred"
+
+// PeerCredentials are the credentials of a process connected over a local socket.
+type PeerCredentials struct {
+	// PID is the process ID of the peer.
+	PID int32
+	// UID is the user ID of the peer.
+	UID uint32
+	// GID is the group ID of the peer.
+	GID uint32
+}
+
+// PeerCredentialsInfo contains the credentials of a peer connected over a local socket.
+type PeerCredentialsInfo struct {
+	credentials.CommonAuthInfo
+
+	// Credentials are the peer credentials. They are nil in case the peer is not connected
+	// over a local socket or if the credentials could not be obtained.
+	Credentials *PeerCredentials
+}
+
+// AuthType returns the authentication type.
+func (PeerCredentialsInfo) AuthType() string {
+	return PeerCredentialsAuthType
+}
+
+type peerCredentials struct{}
+
+func (peerCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return conn, PeerCredentialsInfo{
+		CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.NoSecurity},
+	}, nil
+}
+
+func (peerCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	info := PeerCredentialsInfo{
+		CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.NoSecurity},
+	}
+	if uc, ok := conn.(*net.UnixConn); ok {
+		// Failing to obtain the credentials is not fatal as the peer will just not match any
+		// credential-based access control rules.
+		info.Credentials, _ = getPeerCredentials(uc)
+	}
+	return conn, info, nil
+}
+
+func (peerCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: PeerCredentialsAuthType}
+}
+
+func (p peerCredentials) Clone() credentials.TransportCredentials {
+	return p
+}
+
+func (peerCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+// NewPeerCredentials returns insecure transport credentials which record the credentials of
+// peers connected over local sockets (see PeerCredentialsInfo).
+func NewPeerCredentials() credentials.TransportCredentials {
+	return peerCredentials{}
+}
@@ -0,0 +1,36 @@
+//go:build linux
+// +build linux
+
+package auth
+
+import (
+	"fmt"
+	"net"
+	"syscall"
+)
+
+func getPeerCredentials(conn *net.UnixConn) (*PeerCredentials, error) {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain raw connection: %w", err)
+	}
+
+	var (
+		ucred   *syscall.Ucred
+		credErr error
+	)
+	if err = raw.Control(func(fd uintptr) {
+		ucred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	}); err != nil {
+		return nil, fmt.Errorf("failed to access raw connection: %w", err)
+	}
+	if credErr != nil {
+		return nil, fmt.Errorf("failed to obtain peer credentials: %w", credErr)
+	}
+
+	return &PeerCredentials{
+		PID: ucred.Pid,
+		UID: ucred.Uid,
+		GID: ucred.Gid,
+	}, nil
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package auth
+
+import (
+	"fmt"
+	"net"
+)
+
+func getPeerCredentials(conn *net.UnixConn) (*PeerCredentials, error) {
+	return nil, fmt.Errorf("peer credentials not supported on this platform")
+}
@@ -0,0 +1,284 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+)
+
+// MethodWildcard is the method pattern that matches all methods.
+const MethodWildcard = "*"
+
+// MethodPolicy is a method-level access control policy for gRPC services.
+//
+// Methods are referred to by the (case-insensitive) name of the service without the
+// `oasis-core.` prefix and the name of the method separated by a dot, e.g., the method
+// `/oasis-core.Staking/Account` is referred to as `staking.Account`. A method pattern is either
+// a method name, a method name prefix followed by `*` (e.g., `consensus.*` or `staking.Get*`) or
+// `*` which matches all methods.
+type MethodPolicy struct {
+	// Rules are the access control rules. A request is allowed iff any of the rules matching the
+	// peer allows the requested method.
+	Rules []*MethodRule `json:"rules"`
+}
+
+// MethodRule is a method-level access control rule.
+type MethodRule struct {
+	// PublicKeys are the public keys of client TLS certificates the rule applies to.
+	PublicKeys []signature.PublicKey `json:"public_keys,omitempty"`
+	// UIDs are the user IDs of local socket peers the rule applies to.
+	UIDs []uint32 `json:"uids,omitempty"`
+	// GIDs are the group IDs of local socket peers the rule applies to.
+	GIDs []uint32 `json:"gids,omitempty"`
+	// AnyPeer is a flag indicating that the rule applies to all peers.
+	AnyPeer bool `json:"any_peer,omitempty"`
+
+	// Methods are the patterns of methods the peers are allowed to call.
+	Methods []string `json:"methods"`
+}
+
+// ValidateBasic performs basic method rule validity checks.
+func (r *MethodRule) ValidateBasic() error {
+	if !r.AnyPeer && len(r.PublicKeys) == 0 && len(r.UIDs) == 0 && len(r.GIDs) == 0 {
+		return fmt.Errorf("no peers specified")
+	}
+	if len(r.Methods) == 0 {
+		return fmt.Errorf("no methods specified")
+	}
+	for _, m := range r.Methods {
+		if _, err := newMethodPattern(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateBasic performs basic method policy validity checks.
+func (p *MethodPolicy) ValidateBasic() error {
+	for i, r := range p.Rules {
+		if r == nil {
+			return fmt.Errorf("rule %d: missing rule", i)
+		}
+		if err := r.ValidateBasic(); err != nil {
+			return fmt.Errorf("rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// LoadMethodPolicy loads a JSON-encoded method policy from the given file.
+func LoadMethodPolicy(path string) (*MethodPolicy, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("grpc/auth: failed to read method policy: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	var policy MethodPolicy
+	if err = dec.Decode(&policy); err != nil {
+		return nil, fmt.Errorf("grpc/auth: failed to parse method policy: %w", err)
+	}
+	if err = policy.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("grpc/auth: invalid method policy: %w", err)
+	}
+	return &policy, nil
+}
+
+type methodPattern struct {
+	name     string
+	isPrefix bool
+}
+
+func (p *methodPattern) matches(method string) bool {
+	if p.isPrefix {
+		return strings.HasPrefix(method, p.name)
+	}
+	return method == p.name
+}
+
+func newMethodPattern(pattern string) (*methodPattern, error) {
+	if pattern == MethodWildcard {
+		return &methodPattern{isPrefix: true}, nil
+	}
+
+	svc, method, ok := strings.Cut(pattern, ".")
+	if !ok || svc == "" || method == "" {
+		return nil, fmt.Errorf("malformed method pattern: '%s'", pattern)
+	}
+	name := strings.ToLower(svc) + "." + method
+	if strings.HasSuffix(name, MethodWildcard) {
+		return &methodPattern{name: strings.TrimSuffix(name, MethodWildcard), isPrefix: true}, nil
+	}
+	if strings.Contains(name, MethodWildcard) {
+		return nil, fmt.Errorf("malformed method pattern: '%s'", pattern)
+	}
+	return &methodPattern{name: name}, nil
+}
+
+// policyMethodName converts a full gRPC method name (e.g., `/oasis-core.Staking/Account`) into
+// the form used by method policies (e.g., `staking.Account`).
+func policyMethodName(fullMethodName string) string {
+	svc, method, ok := strings.Cut(strings.TrimPrefix(fullMethodName, "/"), "/")
+	if !ok {
+		return ""
+	}
+	if idx := strings.LastIndex(svc, "."); idx >= 0 {
+		svc = svc[idx+1:]
+	}
+	return strings.ToLower(svc) + "." + method
+}
+
+type methodRule struct {
+	anyPeer    bool
+	publicKeys map[signature.PublicKey]bool
+	uids       map[uint32]bool
+	gids       map[uint32]bool
+
+	methods []*methodPattern
+}
+
+func (r *methodRule) matchesPeer(pk *signature.PublicKey, creds *PeerCredentials) bool {
+	switch {
+	case r.anyPeer:
+		return true
+	case pk != nil && r.publicKeys[*pk]:
+		return true
+	case creds != nil && (r.uids[creds.UID] || r.gids[creds.GID]):
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *methodRule) matchesMethod(method string) bool {
+	for _, p := range r.methods {
+		if p.matches(method) {
+			return true
+		}
+	}
+	return false
+}
+
+// MethodAuthorizer enforces a method-level access control policy.
+type MethodAuthorizer struct {
+	rules []*methodRule
+}
+
+// Authorize checks whether the peer obtained from the given context is allowed to call the given
+// method and returns a `codes.PermissionDenied` error if it is not.
+func (a *MethodAuthorizer) Authorize(ctx context.Context, fullMethodName string) error {
+	pk, creds := peerIdentityFromContext(ctx)
+	method := policyMethodName(fullMethodName)
+	for _, r := range a.rules {
+		if r.matchesPeer(pk, creds) && r.matchesMethod(method) {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "grpc: access to method %s denied", fullMethodName)
+}
+
+// UnaryServerInterceptor returns a unary server interceptor enforcing the policy.
+//
+// In contrast to authentication functions, the policy can not be overridden by services.
+func (a *MethodAuthorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := a.Authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a stream server interceptor enforcing the policy.
+//
+// In contrast to authentication functions, the policy can not be overridden by services.
+func (a *MethodAuthorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := a.Authorize(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// NewMethodAuthorizer creates a new method authorizer enforcing the given policy.
+func NewMethodAuthorizer(policy *MethodPolicy) (*MethodAuthorizer, error) {
+	if err := policy.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("grpc/auth: invalid method policy: %w", err)
+	}
+
+	var a MethodAuthorizer
+	for _, r := range policy.Rules {
+		rule := &methodRule{
+			anyPeer:    r.AnyPeer,
+			publicKeys: make(map[signature.PublicKey]bool),
+			uids:       make(map[uint32]bool),
+			gids:       make(map[uint32]bool),
+		}
+		for _, pk := range r.PublicKeys {
+			rule.publicKeys[pk] = true
+		}
+		for _, uid := range r.UIDs {
+			rule.uids[uid] = true
+		}
+		for _, gid := range r.GIDs {
+			rule.gids[gid] = true
+		}
+		for _, m := range r.Methods {
+			p, _ := newMethodPattern(m) // Already validated.
+			rule.methods = append(rule.methods, p)
+		}
+		a.rules = append(a.rules, rule)
+	}
+	return &a, nil
+}
+
+// peerIdentityFromContext returns the public key of the peer's TLS certificate and the peer's
+// local socket credentials, if available.
+func peerIdentityFromContext(ctx context.Context) (*signature.PublicKey, *PeerCredentials) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	switch info := p.AuthInfo.(type) {
+	case credentials.TLSInfo:
+		if len(info.State.PeerCertificates) != 1 {
+			return nil, nil
+		}
+		rawPk, ok := info.State.PeerCertificates[0].PublicKey.(ed25519.PublicKey)
+		if !ok {
+			return nil, nil
+		}
+		var pk signature.PublicKey
+		if err := pk.UnmarshalBinary(rawPk); err != nil {
+			return nil, nil
+		}
+		return &pk, nil
+	case PeerCredentialsInfo:
+		return nil, info.Credentials
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,99 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	memorySigner "github.com/oasisprotocol/oasis-core/go/common/crypto/signature/signers/memory"
+	"github.com/oasisprotocol/oasis-core/go/common/grpc/auth"
+)
+
+func TestMethodPolicy(t *testing.T) {
+	require := require.New(t)
+
+	signer := memorySigner.NewTestSigner("grpc/auth: method policy test")
+	pk := signer.Public()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "policy.json")
+	err := os.WriteFile(path, []byte(`{
+		"rules": [
+			{"public_keys": ["`+pk.String()+`"], "methods": ["consensus.*", "staking.Account"]},
+			{"uids": [1000], "methods": ["*"]},
+			{"any_peer": true, "methods": ["NodeController.IsSynced"]}
+		]
+	}`), 0o600)
+	require.NoError(err, "WriteFile")
+
+	policy, err := auth.LoadMethodPolicy(path)
+	require.NoError(err, "LoadMethodPolicy")
+	require.Len(policy.Rules, 3)
+
+	authorizer, err := auth.NewMethodAuthorizer(policy)
+	require.NoError(err, "NewMethodAuthorizer")
+
+	tlsCtx := func(pk signature.PublicKey) context.Context {
+		rawPk, _ := pk.MarshalBinary()
+		return peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{{PublicKey: ed25519.PublicKey(rawPk)}},
+			}},
+		})
+	}
+	credsCtx := func(uid, gid uint32) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: auth.PeerCredentialsInfo{Credentials: &auth.PeerCredentials{UID: uid, GID: gid}},
+		})
+	}
+	var otherPk signature.PublicKey
+	require.NoError(otherPk.UnmarshalHex("47aadd91516ac548decdb436fde957992610facc09ba2f850da0fe1b2be96119"))
+
+	for _, tc := range []struct {
+		ctx     context.Context
+		method  string
+		allowed bool
+	}{
+		{tlsCtx(pk), "/oasis-core.Consensus/GetBlock", true},
+		{tlsCtx(pk), "/oasis-core.Staking/Account", true},
+		{tlsCtx(pk), "/oasis-core.Staking/Accounts", false},
+		{tlsCtx(pk), "/oasis-core.NodeController/RequestShutdown", false},
+		{tlsCtx(pk), "/oasis-core.NodeController/IsSynced", true},
+		{tlsCtx(otherPk), "/oasis-core.Consensus/GetBlock", false},
+		{credsCtx(1000, 1000), "/oasis-core.NodeController/RequestShutdown", true},
+		{credsCtx(1001, 1000), "/oasis-core.Consensus/GetBlock", false},
+		{credsCtx(1001, 1000), "/oasis-core.NodeController/IsSynced", true},
+		{context.Background(), "/oasis-core.Consensus/GetBlock", false},
+		{context.Background(), "/oasis-core.NodeController/IsSynced", true},
+	} {
+		err = authorizer.Authorize(tc.ctx, tc.method)
+		if tc.allowed {
+			require.NoError(err, "method %s should be allowed", tc.method)
+		} else {
+			require.Error(err, "method %s should be denied", tc.method)
+			require.Equal(codes.PermissionDenied, status.Code(err), "error should be permission denied")
+		}
+	}
+
+	// Invalid policies.
+	for _, p := range []*auth.MethodPolicy{
+		{Rules: []*auth.MethodRule{{Methods: []string{"*"}}}},
+		{Rules: []*auth.MethodRule{{AnyPeer: true}}},
+		{Rules: []*auth.MethodRule{{AnyPeer: true, Methods: []string{"consensus"}}}},
+		{Rules: []*auth.MethodRule{{AnyPeer: true, Methods: []string{"consensus.Get*Block"}}}},
+	} {
+		_, err = auth.NewMethodAuthorizer(p)
+		require.Error(err, "NewMethodAuthorizer should fail for invalid policies")
+	}
+}
@@ -437,6 +437,9 @@ type ServerConfig struct { // nolint: maligned
 	InstallWrapper bool
 	// AuthFunc is the authentication function for access control.
 	AuthFunc auth.AuthenticationFunction
+	// MethodAuthorizer is the optional method-level access control policy enforcer. In contrast
+	// to AuthFunc, it applies to all services and cannot be overridden by them.
+	MethodAuthorizer *auth.MethodAuthorizer
 	// ClientCommonName is the expected common name on client TLS certificates. If not specified,
 	// the default identity.CommonName will be used.
 	ClientCommonName string
@@ -620,13 +623,17 @@ func NewServer(config *ServerConfig) (*Server, error) {
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		logAdapter.unaryLogger,
 		serverUnaryErrorMapper,
-		auth.UnaryServerInterceptor(config.AuthFunc),
 	}
 	streamInterceptors := []grpc.StreamServerInterceptor{
 		logAdapter.streamLogger,
 		serverStreamErrorMapper,
-		auth.StreamServerInterceptor(config.AuthFunc),
 	}
+	if config.MethodAuthorizer != nil {
+		unaryInterceptors = append(unaryInterceptors, config.MethodAuthorizer.UnaryServerInterceptor())
+		streamInterceptors = append(streamInterceptors, config.MethodAuthorizer.StreamServerInterceptor())
+	}
+	unaryInterceptors = append(unaryInterceptors, auth.UnaryServerInterceptor(config.AuthFunc))
+	streamInterceptors = append(streamInterceptors, auth.StreamServerInterceptor(config.AuthFunc))
 	if config.InstallWrapper {
 		wrapper = newWrapper()
 		unaryInterceptors = append(unaryInterceptors, wrapper.unaryInterceptor)
@@ -656,6 +663,9 @@ func NewServer(config *ServerConfig) (*Server, error) {
 		}
 
 		sOpts = append(sOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else if config.MethodAuthorizer != nil {
+		// Record local socket peer credentials so that they can be used for access control.
+		sOpts = append(sOpts, grpc.Creds(auth.NewPeerCredentials()))
 	}
 	sOpts = append(sOpts, config.CustomOptions...)
 
@@ -14,6 +14,7 @@ import (
 	"github.com/oasisprotocol/oasis-core/go/common/errors"
 	"github.com/oasisprotocol/oasis-core/go/common/node"
 	"github.com/oasisprotocol/oasis-core/go/common/pubsub"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
 	"github.com/oasisprotocol/oasis-core/go/common/service"
 	"github.com/oasisprotocol/oasis-core/go/common/version"
 	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
@@ -107,6 +108,10 @@ var (
 
 	// ErrInvalidArgument is the error returned when the request contains an invalid argument.
 	ErrInvalidArgument = errors.New(moduleName, 6, "consensus: invalid argument")
+
+	// ErrTransactionNotFound is the error returned when the given transaction cannot be found in
+	// the transaction index, possibly because it was pruned.
+	ErrTransactionNotFound = errors.New(moduleName, 7, "consensus: transaction not found")
 )
 
 // FeatureMask is the consensus backend feature bitmask.
@@ -155,6 +160,10 @@ type ClientBackend interface {
 	// included in a block and returns a proof of inclusion.
 	SubmitTxWithProof(ctx context.Context, tx *transaction.SignedTransaction) (*transaction.Proof, error)
 
+	// SubmitMultisigTx submits a consensus transaction authorized by a multisig account and waits
+	// for the transaction to be included in a block.
+	SubmitMultisigTx(ctx context.Context, tx *transaction.MultiSignedTransaction) error
+
 	// StateToGenesis returns the genesis state at the specified block height.
 	StateToGenesis(ctx context.Context, height int64) (*genesis.Document, error)
 
@@ -196,6 +205,18 @@ type ClientBackend interface {
 	// height.
 	GetTransactionsWithResults(ctx context.Context, height int64) (*TransactionsWithResults, error)
 
+	// GetTransactionByHash returns an indexed transaction with the given hash. In case the same
+	// transaction has been included in multiple blocks, the most recent one is returned.
+	//
+	// NOTE: This requires the transaction index to be enabled.
+	GetTransactionByHash(ctx context.Context, txHash hash.Hash) (*IndexedTransaction, error)
+
+	// GetTransactionsBySigner returns a page of indexed transactions signed by the given signer,
+	// ordered from the most recent to the oldest.
+	//
+	// NOTE: This requires the transaction index to be enabled.
+	GetTransactionsBySigner(ctx context.Context, req *GetTransactionsBySignerRequest) (*GetTransactionsBySignerResponse, error)
+
 	// GetUnconfirmedTransactions returns a list of transactions currently in the local node's
 	// mempool. These have not yet been included in a block.
 	GetUnconfirmedTransactions(ctx context.Context) ([][]byte, error)
@@ -441,6 +462,10 @@ type TransactionAuthHandler interface {
 	// GetSignerNonce returns the nonce that should be used by the given
 	// signer for transmitting the next transaction.
 	GetSignerNonce(ctx context.Context, req *GetSignerNonceRequest) (uint64, error)
+
+	// GetMinGasPrice returns the minimum gas price (the base fee) that a transaction must pay
+	// in order to be included in the block following the given height.
+	GetMinGasPrice(ctx context.Context, height int64) (*quantity.Quantity, error)
 }
 
 // EstimateGasRequest is a EstimateGas request.
@@ -455,6 +480,56 @@ type GetSignerNonceRequest struct {
 	Height         int64           `json:"height"`
 }
 
+// IndexedTransaction is a transaction obtained from the transaction index.
+type IndexedTransaction struct {
+	// Height is the height of the block containing the transaction.
+	Height int64 `json:"height"`
+	// Index is the index of the transaction within the block.
+	Index uint32 `json:"index"`
+	// Hash is the transaction hash.
+	Hash hash.Hash `json:"hash"`
+	// Signer is the address of the transaction signer. It is only available for well-formed
+	// transactions.
+	Signer *staking.Address `json:"signer,omitempty"`
+	// Transaction is the raw signed transaction.
+	Transaction []byte `json:"transaction"`
+	// Result is the result of executing the transaction.
+	Result *results.Result `json:"result"`
+}
+
+// TransactionCursor identifies the position of a transaction in the chain.
+type TransactionCursor struct {
+	// Height is the block height.
+	Height int64 `json:"height"`
+	// Index is the index of the transaction within the block.
+	Index uint32 `json:"index"`
+}
+
+// MaxTransactionsBySignerLimit is the maximum number of transactions returned by a single
+// GetTransactionsBySigner query.
+const MaxTransactionsBySignerLimit = 100
+
+// GetTransactionsBySignerRequest is a GetTransactionsBySigner request.
+type GetTransactionsBySignerRequest struct {
+	// Signer is the address of the transaction signer.
+	Signer staking.Address `json:"signer"`
+	// Cursor is the optional cursor returned by a previous query. If set, only transactions
+	// older than the cursor are returned.
+	Cursor *TransactionCursor `json:"cursor,omitempty"`
+	// Limit is the maximum number of transactions to return. If zero or greater than
+	// MaxTransactionsBySignerLimit, MaxTransactionsBySignerLimit is used.
+	Limit uint32 `json:"limit,omitempty"`
+}
+
+// GetTransactionsBySignerResponse is a GetTransactionsBySigner response.
+type GetTransactionsBySignerResponse struct {
+	// Transactions are the indexed transactions.
+	Transactions []*IndexedTransaction `json:"transactions"`
+	// NextCursor is the cursor that can be used to query the next page of transactions. It is
+	// nil when there are no more transactions.
+	NextCursor *TransactionCursor `json:"next_cursor,omitempty"`
+}
+
 // TransactionsWithResults is GetTransactionsWithResults response.
 //
 // Results[i] are the results of executing Transactions[i].
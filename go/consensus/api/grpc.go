@@ -6,8 +6,10 @@ import (
 	"google.golang.org/grpc"
 
 	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
 	cmnGrpc "github.com/oasisprotocol/oasis-core/go/common/grpc"
 	"github.com/oasisprotocol/oasis-core/go/common/pubsub"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
 	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
 	genesis "github.com/oasisprotocol/oasis-core/go/genesis/api"
 	governance "github.com/oasisprotocol/oasis-core/go/governance/api"
@@ -28,12 +30,16 @@ var (
 	methodSubmitTxNoWait = serviceName.NewMethod("SubmitTxNoWait", transaction.SignedTransaction{})
 	// methodSubmitTxWithProof is the SubmitTxWithProof method.
 	methodSubmitTxWithProof = serviceName.NewMethod("SubmitTxWithProof", transaction.SignedTransaction{})
+	// methodSubmitMultisigTx is the SubmitMultisigTx method.
+	methodSubmitMultisigTx = serviceName.NewMethod("SubmitMultisigTx", transaction.MultiSignedTransaction{})
 	// methodStateToGenesis is the StateToGenesis method.
 	methodStateToGenesis = serviceName.NewMethod("StateToGenesis", int64(0))
 	// methodEstimateGas is the EstimateGas method.
 	methodEstimateGas = serviceName.NewMethod("EstimateGas", &EstimateGasRequest{})
 	// methodGetSignerNonce is a GetSignerNonce method.
 	methodGetSignerNonce = serviceName.NewMethod("GetSignerNonce", &GetSignerNonceRequest{})
+	// methodGetMinGasPrice is the GetMinGasPrice method.
+	methodGetMinGasPrice = serviceName.NewMethod("GetMinGasPrice", int64(0))
 	// methodGetBlock is the GetBlock method.
 	methodGetBlock = serviceName.NewMethod("GetBlock", int64(0))
 	// methodGetLightBlock is the GetLightBlock method.
@@ -44,6 +50,10 @@ var (
 	methodGetTransactions = serviceName.NewMethod("GetTransactions", int64(0))
 	// methodGetTransactionsWithResults is the GetTransactionsWithResults method.
 	methodGetTransactionsWithResults = serviceName.NewMethod("GetTransactionsWithResults", int64(0))
+	// methodGetTransactionByHash is the GetTransactionByHash method.
+	methodGetTransactionByHash = serviceName.NewMethod("GetTransactionByHash", hash.Hash{})
+	// methodGetTransactionsBySigner is the GetTransactionsBySigner method.
+	methodGetTransactionsBySigner = serviceName.NewMethod("GetTransactionsBySigner", &GetTransactionsBySignerRequest{})
 	// methodGetUnconfirmedTransactions is the GetUnconfirmedTransactions method.
 	methodGetUnconfirmedTransactions = serviceName.NewMethod("GetUnconfirmedTransactions", nil)
 	// methodGetGenesisDocument is the GetGenesisDocument method.
@@ -85,6 +95,10 @@ var (
 				MethodName: methodSubmitTxWithProof.ShortName(),
 				Handler:    handlerSubmitTxWithProof,
 			},
+			{
+				MethodName: methodSubmitMultisigTx.ShortName(),
+				Handler:    handlerSubmitMultisigTx,
+			},
 			{
 				MethodName: methodStateToGenesis.ShortName(),
 				Handler:    handlerStateToGenesis,
@@ -97,6 +111,10 @@ var (
 				MethodName: methodGetSignerNonce.ShortName(),
 				Handler:    handlerGetSignerNonce,
 			},
+			{
+				MethodName: methodGetMinGasPrice.ShortName(),
+				Handler:    handlerGetMinGasPrice,
+			},
 			{
 				MethodName: methodGetBlock.ShortName(),
 				Handler:    handlerGetBlock,
@@ -117,6 +135,14 @@ var (
 				MethodName: methodGetTransactionsWithResults.ShortName(),
 				Handler:    handlerGetTransactionsWithResults,
 			},
+			{
+				MethodName: methodGetTransactionByHash.ShortName(),
+				Handler:    handlerGetTransactionByHash,
+			},
+			{
+				MethodName: methodGetTransactionsBySigner.ShortName(),
+				Handler:    handlerGetTransactionsBySigner,
+			},
 			{
 				MethodName: methodGetUnconfirmedTransactions.ShortName(),
 				Handler:    handlerGetUnconfirmedTransactions,
@@ -237,6 +263,29 @@ func handlerSubmitTxWithProof(
 	return interceptor(ctx, rq, info, handler)
 }
 
+func handlerSubmitMultisigTx(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	rq := new(transaction.MultiSignedTransaction)
+	if err := dec(rq); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return nil, srv.(ClientBackend).SubmitMultisigTx(ctx, rq)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: methodSubmitMultisigTx.FullName(),
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, srv.(ClientBackend).SubmitMultisigTx(ctx, req.(*transaction.MultiSignedTransaction))
+	}
+	return interceptor(ctx, rq, info, handler)
+}
+
 func handlerStateToGenesis(
 	srv interface{},
 	ctx context.Context,
@@ -306,6 +355,29 @@ func handlerGetSignerNonce(
 	return interceptor(ctx, rq, info, handler)
 }
 
+func handlerGetMinGasPrice(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	var height int64
+	if err := dec(&height); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientBackend).GetMinGasPrice(ctx, height)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: methodGetMinGasPrice.FullName(),
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientBackend).GetMinGasPrice(ctx, req.(int64))
+	}
+	return interceptor(ctx, height, info, handler)
+}
+
 func handlerGetBlock(
 	srv interface{},
 	ctx context.Context,
@@ -421,6 +493,52 @@ func handlerGetTransactionsWithResults(
 	return interceptor(ctx, height, info, handler)
 }
 
+func handlerGetTransactionByHash(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	var txHash hash.Hash
+	if err := dec(&txHash); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientBackend).GetTransactionByHash(ctx, txHash)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: methodGetTransactionByHash.FullName(),
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientBackend).GetTransactionByHash(ctx, req.(hash.Hash))
+	}
+	return interceptor(ctx, txHash, info, handler)
+}
+
+func handlerGetTransactionsBySigner(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	var rq GetTransactionsBySignerRequest
+	if err := dec(&rq); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientBackend).GetTransactionsBySigner(ctx, &rq)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: methodGetTransactionsBySigner.FullName(),
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientBackend).GetTransactionsBySigner(ctx, req.(*GetTransactionsBySignerRequest))
+	}
+	return interceptor(ctx, &rq, info, handler)
+}
+
 func handlerGetUnconfirmedTransactions(
 	srv interface{},
 	ctx context.Context,
@@ -676,6 +794,10 @@ func (c *consensusClient) SubmitTxNoWait(ctx context.Context, tx *transaction.Si
 	return c.conn.Invoke(ctx, methodSubmitTxNoWait.FullName(), tx, nil)
 }
 
+func (c *consensusClient) SubmitMultisigTx(ctx context.Context, tx *transaction.MultiSignedTransaction) error {
+	return c.conn.Invoke(ctx, methodSubmitMultisigTx.FullName(), tx, nil)
+}
+
 func (c *consensusClient) SubmitTxWithProof(ctx context.Context, tx *transaction.SignedTransaction) (*transaction.Proof, error) {
 	var proof transaction.Proof
 	if err := c.conn.Invoke(ctx, methodSubmitTxWithProof.FullName(), tx, &proof); err != nil {
@@ -708,6 +830,14 @@ func (c *consensusClient) GetSignerNonce(ctx context.Context, req *GetSignerNonc
 	return nonce, nil
 }
 
+func (c *consensusClient) GetMinGasPrice(ctx context.Context, height int64) (*quantity.Quantity, error) {
+	var rsp quantity.Quantity
+	if err := c.conn.Invoke(ctx, methodGetMinGasPrice.FullName(), height, &rsp); err != nil {
+		return nil, err
+	}
+	return &rsp, nil
+}
+
 func (c *consensusClient) GetBlock(ctx context.Context, height int64) (*Block, error) {
 	var rsp Block
 	if err := c.conn.Invoke(ctx, methodGetBlock.FullName(), height, &rsp); err != nil {
@@ -748,6 +878,22 @@ func (c *consensusClient) GetTransactionsWithResults(ctx context.Context, height
 	return &rsp, nil
 }
 
+func (c *consensusClient) GetTransactionByHash(ctx context.Context, txHash hash.Hash) (*IndexedTransaction, error) {
+	var rsp IndexedTransaction
+	if err := c.conn.Invoke(ctx, methodGetTransactionByHash.FullName(), txHash, &rsp); err != nil {
+		return nil, err
+	}
+	return &rsp, nil
+}
+
+func (c *consensusClient) GetTransactionsBySigner(ctx context.Context, req *GetTransactionsBySignerRequest) (*GetTransactionsBySignerResponse, error) {
+	var rsp GetTransactionsBySignerResponse
+	if err := c.conn.Invoke(ctx, methodGetTransactionsBySigner.FullName(), req, &rsp); err != nil {
+		return nil, err
+	}
+	return &rsp, nil
+}
+
 func (c *consensusClient) GetUnconfirmedTransactions(ctx context.Context) ([][]byte, error) {
 	var rsp [][]byte
 	if err := c.conn.Invoke(ctx, methodGetUnconfirmedTransactions.FullName(), nil, &rsp); err != nil {
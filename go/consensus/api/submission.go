@@ -108,6 +108,15 @@ func (m *submissionManager) EstimateGasAndSetFee(ctx context.Context, signer sig
 	if err != nil {
 		return fmt.Errorf("failed to determine gas price: %w", err)
 	}
+	// Make sure that the gas price covers the current base fee.
+	var minGasPrice *quantity.Quantity
+	minGasPrice, err = m.backend.GetMinGasPrice(ctx, HeightLatest)
+	if err != nil {
+		return fmt.Errorf("failed to query minimum gas price: %w", err)
+	}
+	if amount.Cmp(minGasPrice) < 0 {
+		amount = minGasPrice
+	}
 	var gasQuantity quantity.Quantity
 	if err = gasQuantity.FromUint64(uint64(gas)); err != nil {
 		return fmt.Errorf("failed to compute fee amount: %w", err)
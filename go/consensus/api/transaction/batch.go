@@ -0,0 +1,152 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/errors"
+	"github.com/oasisprotocol/oasis-core/go/common/prettyprint"
+)
+
+// MaxBatchCalls is the maximum number of calls in a batch transaction.
+const MaxBatchCalls = 32
+
+var (
+	// ErrInvalidBatch is the error returned when a batch transaction is malformed.
+	ErrInvalidBatch = errors.New(moduleName, 11, "transaction: invalid batch")
+
+	// MethodBatch is the method name for batch transactions.
+	MethodBatch = NewMethodName("consensus", "Batch", Batch{})
+
+	_ prettyprint.PrettyPrinter = (*Batch)(nil)
+)
+
+// BatchCall is a single method call in a batch transaction.
+type BatchCall struct {
+	// Method is the method that should be called.
+	Method MethodName `json:"method"`
+	// Body is the method call body.
+	Body cbor.RawMessage `json:"body,omitempty"`
+}
+
+// Batch is a batch transaction body.
+//
+// All calls in a batch are executed atomically in order, on behalf of the transaction signer and
+// under the nonce and fee of the enclosing transaction. In case any of the calls fails, the effects
+// of all calls are reverted.
+type Batch struct {
+	// Calls are the method calls in the batch.
+	Calls []BatchCall `json:"calls"`
+}
+
+// MethodMetadata returns the method metadata.
+//
+// Whether a batch can be authorized by a multisig account further depends on the batched calls
+// (see Transaction.AllowsMultisig).
+func (b Batch) MethodMetadata() MethodMetadata {
+	return MethodMetadata{
+		AllowMultisig: true,
+	}
+}
+
+// ValidateBasic performs basic batch validity checks.
+func (b *Batch) ValidateBasic() error {
+	switch n := len(b.Calls); {
+	case n == 0:
+		return fmt.Errorf("%w: no calls", ErrInvalidBatch)
+	case n > MaxBatchCalls:
+		return fmt.Errorf("%w: too many calls (%d > %d)", ErrInvalidBatch, n, MaxBatchCalls)
+	}
+
+	for i, call := range b.Calls {
+		if err := call.Method.SanityCheck(); err != nil {
+			return fmt.Errorf("%w: call %d: %s", ErrInvalidBatch, i, err)
+		}
+		switch {
+		case call.Method == MethodBatch:
+			return fmt.Errorf("%w: call %d: nested batches are not allowed", ErrInvalidBatch, i)
+		case !call.Method.AllowsBatch():
+			return fmt.Errorf("%w: call %d: method %s is not allowed in batches", ErrInvalidBatch, i, call.Method)
+		}
+	}
+	return nil
+}
+
+// AllowsMultisig returns true iff all of the batched calls may be authorized by a multisig account.
+func (b *Batch) AllowsMultisig() bool {
+	for _, call := range b.Calls {
+		if !call.Method.AllowsMultisig() {
+			return false
+		}
+	}
+	return true
+}
+
+// PrettyPrint writes a pretty-printed representation of the batch to the given writer.
+func (b Batch) PrettyPrint(ctx context.Context, prefix string, w io.Writer) {
+	fmt.Fprintf(w, "%sCalls:\n", prefix)
+	for i, call := range b.Calls {
+		fmt.Fprintf(w, "%s  %d. Method: %s\n", prefix, i+1, call.Method)
+		fmt.Fprintf(w, "%s     Body:\n", prefix)
+		Transaction{Method: call.Method, Body: call.Body}.PrettyPrintBody(ctx, prefix+"       ", w)
+	}
+}
+
+// PrettyType returns a representation of Batch that can be used for pretty printing.
+func (b Batch) PrettyType() (interface{}, error) {
+	calls := make([]*PrettyTransaction, 0, len(b.Calls))
+	for _, call := range b.Calls {
+		tx := Transaction{Method: call.Method, Body: call.Body}
+		pt, err := tx.PrettyType()
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, pt.(*PrettyTransaction))
+	}
+	return &PrettyBatch{Calls: calls}, nil
+}
+
+// PrettyBatch is used for pretty-printing batches so that the actual content of the batched calls
+// is displayed instead of the binary blobs.
+//
+// It should only be used for pretty printing.
+type PrettyBatch struct {
+	Calls []*PrettyTransaction `json:"calls"`
+}
+
+// NewBatchTx creates a new batch transaction.
+func NewBatchTx(nonce uint64, fee *Fee, calls []BatchCall) *Transaction {
+	return NewTransaction(nonce, fee, MethodBatch, &Batch{Calls: calls})
+}
+
+// BatchCallEvent is the event emitted for each executed call of a batch transaction.
+//
+// In case a call fails, an event with the error is emitted for the failing call only as the events
+// of all previous calls are reverted together with their effects.
+type BatchCallEvent struct {
+	// Index is the index of the call in the batch.
+	Index uint32 `json:"index"`
+	// Method is the called method.
+	Method MethodName `json:"method"`
+	// GasUsed is the amount of gas used by the call.
+	GasUsed Gas `json:"gas_used"`
+
+	// Module is the module of the call error, if any.
+	Module string `json:"module,omitempty"`
+	// Code is the code of the call error, if any.
+	Code uint32 `json:"code,omitempty"`
+	// Message is the message of the call error, if any.
+	Message string `json:"message,omitempty"`
+}
+
+// EventKind returns a string representation of this event's kind.
+func (e *BatchCallEvent) EventKind() string {
+	return "batch_call"
+}
+
+// IsSuccess returns true iff the call was successful.
+func (e *BatchCallEvent) IsSuccess() bool {
+	return e.Code == errors.CodeNoError
+}
@@ -0,0 +1,75 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+)
+
+type testMethodBodyMultisig struct{}
+
+func (tb testMethodBodyMultisig) MethodMetadata() MethodMetadata {
+	return MethodMetadata{
+		AllowMultisig: true,
+		AllowBatch:    true,
+	}
+}
+
+var (
+	testMethodBatchMultisig = NewMethodName("test", "BatchMultisig", testMethodBodyMultisig{})
+	testMethodBatchCritical = NewMethodName("test", "BatchCritical", testMethodBodyCritical{})
+	testMethodBatchPlain    = NewMethodName("test", "BatchPlain", testMethodBodyNormal{})
+)
+
+func TestBatch(t *testing.T) {
+	require := require.New(t)
+
+	require.ErrorIs((&Batch{}).ValidateBasic(), ErrInvalidBatch, "empty batch should be invalid")
+
+	var tooMany Batch
+	for i := 0; i <= MaxBatchCalls; i++ {
+		tooMany.Calls = append(tooMany.Calls, BatchCall{Method: testMethodBatchPlain})
+	}
+	require.ErrorIs(tooMany.ValidateBasic(), ErrInvalidBatch, "oversized batch should be invalid")
+
+	nested := Batch{Calls: []BatchCall{
+		{Method: testMethodBatchMultisig},
+		{Method: MethodBatch, Body: cbor.Marshal(&Batch{Calls: []BatchCall{{Method: testMethodBatchMultisig}}})},
+	}}
+	require.ErrorIs(nested.ValidateBasic(), ErrInvalidBatch, "nested batch should be invalid")
+
+	critical := Batch{Calls: []BatchCall{{Method: testMethodBatchCritical}}}
+	require.ErrorIs(critical.ValidateBasic(), ErrInvalidBatch, "batch with critical methods should be invalid")
+
+	plain := Batch{Calls: []BatchCall{{Method: testMethodBatchMultisig}, {Method: testMethodBatchPlain}}}
+	require.ErrorIs(plain.ValidateBasic(), ErrInvalidBatch, "batch with non-batchable methods should be invalid")
+
+	empty := Batch{Calls: []BatchCall{{Method: ""}}}
+	require.ErrorIs(empty.ValidateBasic(), ErrInvalidBatch, "batch with empty methods should be invalid")
+
+	batch := Batch{Calls: []BatchCall{
+		{Method: testMethodBatchMultisig, Body: cbor.Marshal(testMethodBodyMultisig{})},
+		{Method: testMethodBatchMultisig},
+	}}
+	require.NoError(batch.ValidateBasic(), "ValidateBasic")
+	require.False(MethodBatch.IsCritical(), "batch method should not be critical")
+
+	// Multisig authorization depends on the batched calls.
+	tx := NewBatchTx(1, &Fee{Gas: 1000}, batch.Calls)
+	require.True(tx.AllowsMultisig(), "batch with multisig methods should allow multisig")
+	tx = NewBatchTx(1, &Fee{Gas: 1000}, append(batch.Calls, BatchCall{Method: testMethodBatchPlain}))
+	require.False(tx.AllowsMultisig(), "batch with non-multisig methods should not allow multisig")
+	tx.Body = cbor.RawMessage("malformed")
+	require.False(tx.AllowsMultisig(), "malformed batch should not allow multisig")
+
+	// Round-trip through serialization.
+	tx = NewBatchTx(1, &Fee{Gas: 1000}, batch.Calls)
+	var decodedTx Transaction
+	require.NoError(cbor.Unmarshal(cbor.Marshal(tx), &decodedTx), "Unmarshal")
+	require.EqualValues(MethodBatch, decodedTx.Method)
+	var decoded Batch
+	require.NoError(cbor.Unmarshal(decodedTx.Body, &decoded), "Unmarshal(body)")
+	require.EqualValues(batch, decoded)
+}
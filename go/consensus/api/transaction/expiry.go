@@ -0,0 +1,66 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/oasisprotocol/oasis-core/go/common/errors"
+	"github.com/oasisprotocol/oasis-core/go/common/prettyprint"
+)
+
+var (
+	// ErrTransactionExpired is the error returned when a transaction has expired.
+	ErrTransactionExpired = errors.New(moduleName, 10, "transaction: transaction expired")
+
+	_ prettyprint.PrettyPrinter = (*Expiry)(nil)
+)
+
+// Expiry is the transaction expiry.
+//
+// A transaction with an expiry can only be included in a block if none of the configured limits
+// has been exceeded.
+type Expiry struct {
+	// Height is the last consensus block height at which the transaction can be included.
+	Height int64 `json:"height,omitempty"`
+	// Epoch is the last epoch in which the transaction can be included.
+	Epoch uint64 `json:"epoch,omitempty"`
+}
+
+// ValidateBasic performs basic expiry validity checks.
+func (e *Expiry) ValidateBasic() error {
+	if e.Height < 0 {
+		return fmt.Errorf("transaction: invalid expiry height: %d", e.Height)
+	}
+	if e.Height == 0 && e.Epoch == 0 {
+		return fmt.Errorf("transaction: expiry must specify a height or an epoch")
+	}
+	return nil
+}
+
+// IsExpired returns true iff a transaction with this expiry can no longer be included in a block
+// at the given height and epoch.
+func (e *Expiry) IsExpired(height int64, epoch uint64) bool {
+	if e.Height > 0 && height > e.Height {
+		return true
+	}
+	if e.Epoch > 0 && epoch > e.Epoch {
+		return true
+	}
+	return false
+}
+
+// PrettyPrint writes a pretty-printed representation of the expiry to the given writer.
+func (e Expiry) PrettyPrint(ctx context.Context, prefix string, w io.Writer) {
+	if e.Height > 0 {
+		fmt.Fprintf(w, "%sHeight: %d\n", prefix, e.Height)
+	}
+	if e.Epoch > 0 {
+		fmt.Fprintf(w, "%sEpoch:  %d\n", prefix, e.Epoch)
+	}
+}
+
+// PrettyType returns a representation of Expiry that can be used for pretty printing.
+func (e Expiry) PrettyType() (interface{}, error) {
+	return e, nil
+}
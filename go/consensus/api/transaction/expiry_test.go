@@ -0,0 +1,42 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+)
+
+func TestExpiry(t *testing.T) {
+	require := require.New(t)
+
+	require.Error((&Expiry{}).ValidateBasic(), "empty expiry should be invalid")
+	require.Error((&Expiry{Height: -1}).ValidateBasic(), "negative height should be invalid")
+
+	e := Expiry{Height: 100}
+	require.NoError(e.ValidateBasic(), "ValidateBasic")
+	require.False(e.IsExpired(99, 1000), "transaction should not be expired before the height")
+	require.False(e.IsExpired(100, 1000), "transaction should not be expired at the height")
+	require.True(e.IsExpired(101, 0), "transaction should be expired after the height")
+
+	e = Expiry{Epoch: 10}
+	require.NoError(e.ValidateBasic(), "ValidateBasic")
+	require.False(e.IsExpired(1000, 10), "transaction should not be expired in the epoch")
+	require.True(e.IsExpired(1, 11), "transaction should be expired after the epoch")
+
+	e = Expiry{Height: 100, Epoch: 10}
+	require.True(e.IsExpired(101, 1), "transaction should be expired when the height is exceeded")
+	require.True(e.IsExpired(1, 11), "transaction should be expired when the epoch is exceeded")
+
+	// Transactions without an expiry should serialize the same as before.
+	tx := NewTransaction(1, nil, "test.Expiry", nil)
+	raw := cbor.Marshal(tx)
+	tx.Expiry = &Expiry{Height: 100}
+	require.NotEqual(raw, cbor.Marshal(tx), "expiry should be serialized")
+	tx.Expiry = nil
+	require.Equal(raw, cbor.Marshal(tx), "transactions without expiry should serialize the same")
+
+	tx.Expiry = &Expiry{}
+	require.Error(tx.SanityCheck(), "SanityCheck should fail for an invalid expiry")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
 	"github.com/oasisprotocol/oasis-core/go/common/errors"
 	"github.com/oasisprotocol/oasis-core/go/common/prettyprint"
 	"github.com/oasisprotocol/oasis-core/go/common/quantity"
@@ -32,6 +33,11 @@ type Fee struct {
 	Amount quantity.Quantity `json:"amount"`
 	// Gas is the maximum gas that a transaction can use.
 	Gas Gas `json:"gas"`
+
+	// Payer is the optional public key of the account sponsoring the transaction by paying its
+	// fee. If set, the transaction must also carry a valid fee payer signature and the fee is
+	// charged from the payer's general account instead of the sender's.
+	Payer *signature.PublicKey `json:"payer,omitempty"`
 }
 
 // PrettyPrint writes a pretty-printed representation of the fee to the given
@@ -45,6 +51,10 @@ func (f Fee) PrettyPrint(ctx context.Context, prefix string, w io.Writer) {
 	fmt.Fprintf(w, "%s(gas price: ", prefix)
 	token.PrettyPrintAmount(ctx, *f.GasPrice(), w)
 	fmt.Fprintln(w, " per gas unit)")
+
+	if f.Payer != nil {
+		fmt.Fprintf(w, "%sPayer: %s\n", prefix, f.Payer)
+	}
 }
 
 // PrettyType returns a representation of Fee that can be used for pretty
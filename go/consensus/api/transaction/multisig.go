@@ -0,0 +1,309 @@
+package transaction
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	"github.com/oasisprotocol/oasis-core/go/common/errors"
+	"github.com/oasisprotocol/oasis-core/go/common/prettyprint"
+)
+
+// MaxMultisigSigners is the maximum number of signers in a multisig configuration.
+const MaxMultisigSigners = 32
+
+var (
+	// ErrInvalidMultisigConfig is the error returned when a multisig configuration is invalid.
+	ErrInvalidMultisigConfig = errors.New(moduleName, 6, "transaction: invalid multisig configuration")
+
+	// ErrInsufficientSignatures is the error returned when a multisig transaction does not carry
+	// enough valid signatures to satisfy the multisig threshold.
+	ErrInsufficientSignatures = errors.New(moduleName, 7, "transaction: insufficient signatures")
+
+	// ErrMultisigNotAllowed is the error returned when a multisig transaction calls a method that
+	// can not be authorized by a multisig account.
+	ErrMultisigNotAllowed = errors.New(moduleName, 8, "transaction: method not allowed for multisig accounts")
+
+	// MultisigSignatureContext is the context used by multisig signers for signing transactions.
+	//
+	// It is distinct from SignatureContext so that a signature share can never be used to
+	// authorize a transaction on behalf of the signer's own account.
+	MultisigSignatureContext = signature.NewContext("oasis-core/consensus: multisig tx", signature.WithChainSeparation())
+
+	_ prettyprint.PrettyPrinter = (*MultiSignedTransaction)(nil)
+)
+
+// MultisigConfig is a M-of-N multisig account configuration.
+type MultisigConfig struct {
+	// Signers are the public keys of the signers.
+	Signers []signature.PublicKey `json:"signers"`
+	// Threshold is the minimum number of distinct signers required to authorize a transaction.
+	Threshold uint16 `json:"threshold"`
+}
+
+// ValidateBasic performs basic multisig configuration validity checks.
+func (c *MultisigConfig) ValidateBasic() error {
+	if len(c.Signers) == 0 {
+		return fmt.Errorf("%w: no signers", ErrInvalidMultisigConfig)
+	}
+	if len(c.Signers) > MaxMultisigSigners {
+		return fmt.Errorf("%w: too many signers (max: %d)", ErrInvalidMultisigConfig, MaxMultisigSigners)
+	}
+	if c.Threshold == 0 || int(c.Threshold) > len(c.Signers) {
+		return fmt.Errorf("%w: threshold must be between 1 and %d", ErrInvalidMultisigConfig, len(c.Signers))
+	}
+	seen := make(map[signature.PublicKey]bool)
+	for _, pk := range c.Signers {
+		if !pk.IsValid() {
+			return fmt.Errorf("%w: invalid signer %s", ErrInvalidMultisigConfig, pk)
+		}
+		if seen[pk] {
+			return fmt.Errorf("%w: duplicate signer %s", ErrInvalidMultisigConfig, pk)
+		}
+		seen[pk] = true
+	}
+	return nil
+}
+
+// IsSigner returns true iff the given public key is one of the signers.
+func (c *MultisigConfig) IsSigner(pk signature.PublicKey) bool {
+	for _, signer := range c.Signers {
+		if signer.Equal(pk) {
+			return true
+		}
+	}
+	return false
+}
+
+// Canonical returns a copy of the multisig configuration with signers in canonical (sorted)
+// order, so that the order in which signers are specified is not significant.
+func (c *MultisigConfig) Canonical() *MultisigConfig {
+	signers := append([]signature.PublicKey{}, c.Signers...)
+	sort.Slice(signers, func(i, j int) bool {
+		return bytes.Compare(signers[i][:], signers[j][:]) < 0
+	})
+	return &MultisigConfig{
+		Signers:   signers,
+		Threshold: c.Threshold,
+	}
+}
+
+// MultiSignedTransaction is a consensus transaction authorized by a multisig account.
+type MultiSignedTransaction struct {
+	// Config is the multisig configuration of the account authorizing the transaction.
+	Config MultisigConfig `json:"config"`
+
+	// Blob is the signed CBOR-serialized transaction.
+	Blob []byte `json:"untrusted_raw_value"`
+	// Signatures are the signatures over the blob by (a subset of) the signers.
+	Signatures []signature.Signature `json:"signatures"`
+
+	// FeePayerSignature is the signature of the fee payer over the blob in case the transaction
+	// is sponsored (see Fee.Payer).
+	FeePayerSignature *signature.Signature `json:"fee_payer_signature,omitempty"`
+}
+
+// Hash returns the cryptographic hash of the encoded transaction.
+func (s *MultiSignedTransaction) Hash() hash.Hash {
+	return hash.NewFrom(s)
+}
+
+// AddSignature adds a signature to the multisig transaction.
+//
+// Signatures by public keys that are not signers or that are already present are rejected.
+func (s *MultiSignedTransaction) AddSignature(sig signature.Signature) error {
+	if !s.Config.IsSigner(sig.PublicKey) {
+		return fmt.Errorf("transaction: %s is not a multisig signer", sig.PublicKey)
+	}
+	for _, existing := range s.Signatures {
+		if existing.PublicKey.Equal(sig.PublicKey) {
+			return fmt.Errorf("transaction: duplicate signature by %s", sig.PublicKey)
+		}
+	}
+	if !sig.Verify(MultisigSignatureContext, s.Blob) {
+		return signature.ErrVerifyFailed
+	}
+	s.Signatures = append(s.Signatures, sig)
+	return nil
+}
+
+// Sign signs the multisig transaction using the given signer and adds the signature.
+func (s *MultiSignedTransaction) Sign(signer signature.Signer) error {
+	sig, err := signature.Sign(signer, MultisigSignatureContext, s.Blob)
+	if err != nil {
+		return err
+	}
+	return s.AddSignature(*sig)
+}
+
+// Merge adds all signatures from another partially signed multisig transaction over the same
+// transaction, skipping any signatures that are already present.
+func (s *MultiSignedTransaction) Merge(other *MultiSignedTransaction) error {
+	if !s.sameContent(other) {
+		return fmt.Errorf("transaction: multisig transactions differ")
+	}
+OUTER:
+	for _, sig := range other.Signatures {
+		for _, existing := range s.Signatures {
+			if existing.PublicKey.Equal(sig.PublicKey) {
+				continue OUTER
+			}
+		}
+		if err := s.AddSignature(sig); err != nil {
+			return err
+		}
+	}
+	if s.FeePayerSignature == nil {
+		s.FeePayerSignature = other.FeePayerSignature
+	}
+	return nil
+}
+
+func (s *MultiSignedTransaction) sameContent(other *MultiSignedTransaction) bool {
+	return bytes.Equal(s.Blob, other.Blob) && bytes.Equal(cbor.Marshal(s.Config), cbor.Marshal(other.Config))
+}
+
+// Verify verifies that the multisig configuration is valid and that the transaction carries
+// valid signatures by at least the threshold number of distinct signers.
+func (s *MultiSignedTransaction) Verify() error {
+	if err := s.Config.ValidateBasic(); err != nil {
+		return err
+	}
+	if len(s.Signatures) > len(s.Config.Signers) {
+		return fmt.Errorf("transaction: too many signatures")
+	}
+
+	seen := make(map[signature.PublicKey]bool)
+	var numValid int
+	for _, sig := range s.Signatures {
+		if !s.Config.IsSigner(sig.PublicKey) {
+			return fmt.Errorf("transaction: %s is not a multisig signer", sig.PublicKey)
+		}
+		if seen[sig.PublicKey] {
+			return fmt.Errorf("transaction: duplicate signature by %s", sig.PublicKey)
+		}
+		seen[sig.PublicKey] = true
+
+		if !sig.Verify(MultisigSignatureContext, s.Blob) {
+			return signature.ErrVerifyFailed
+		}
+		numValid++
+	}
+	if numValid < int(s.Config.Threshold) {
+		return ErrInsufficientSignatures
+	}
+	return nil
+}
+
+// Open first verifies the blob signatures and then unmarshals the blob.
+//
+// In case the transaction is sponsored, the fee payer signature is verified as well.
+func (s *MultiSignedTransaction) Open(tx *Transaction) error { // nolint: interfacer
+	if err := s.Verify(); err != nil {
+		return err
+	}
+	// Make sure no stale fields (e.g., the fee payer) survive decoding into a reused transaction.
+	*tx = Transaction{}
+	if err := cbor.Unmarshal(s.Blob, tx); err != nil {
+		return err
+	}
+	return verifyFeePayer(tx, s.Blob, s.FeePayerSignature)
+}
+
+// PrettyPrint writes a pretty-printed representation of the type
+// to the given writer.
+func (s MultiSignedTransaction) PrettyPrint(ctx context.Context, prefix string, w io.Writer) {
+	fmt.Fprintf(w, "%sHash: %s\n", prefix, s.Hash())
+
+	fmt.Fprintf(w, "%sThreshold: %d\n", prefix, s.Config.Threshold)
+	fmt.Fprintf(w, "%sSigners:\n", prefix)
+	for _, pk := range s.Config.Signers {
+		fmt.Fprintf(w, "%s  - %s\n", prefix, pk)
+	}
+	fmt.Fprintf(w, "%sSignatures:\n", prefix)
+	for _, sig := range s.Signatures {
+		fmt.Fprintf(w, "%s  - %s\n", prefix, sig.PublicKey)
+		fmt.Fprintf(w, "%s    (signature: %s)\n", prefix, sig.Signature)
+		if !sig.Verify(MultisigSignatureContext, s.Blob) {
+			fmt.Fprintf(w, "%s    [INVALID SIGNATURE]\n", prefix)
+		}
+	}
+	if s.FeePayerSignature != nil {
+		fmt.Fprintf(w, "%sFee payer: %s\n", prefix, s.FeePayerSignature.PublicKey)
+		fmt.Fprintf(w, "%s           (signature: %s)\n", prefix, s.FeePayerSignature.Signature)
+		if !s.FeePayerSignature.Verify(FeePayerSignatureContext, s.Blob) {
+			fmt.Fprintf(w, "%s           [INVALID SIGNATURE]\n", prefix)
+		}
+	}
+
+	var tx Transaction
+	fmt.Fprintf(w, "%sContent:\n", prefix)
+	if err := cbor.Unmarshal(s.Blob, &tx); err != nil {
+		fmt.Fprintf(w, "%s  <error: %s>\n", prefix, err)
+		fmt.Fprintf(w, "%s  <malformed: %s>\n", prefix, base64.StdEncoding.EncodeToString(s.Blob))
+		return
+	}
+
+	tx.PrettyPrint(ctx, prefix+"  ", w)
+}
+
+// PrettyType returns a representation of the type that can be used for pretty printing.
+func (s MultiSignedTransaction) PrettyType() (interface{}, error) {
+	var tx Transaction
+	if err := cbor.Unmarshal(s.Blob, &tx); err != nil {
+		return nil, fmt.Errorf("malformed signed blob: %w", err)
+	}
+	body, err := tx.PrettyType()
+	if err != nil {
+		return nil, err
+	}
+	return &PrettyMultiSignedTransaction{
+		Config:            s.Config,
+		Body:              body,
+		Signatures:        s.Signatures,
+		FeePayerSignature: s.FeePayerSignature,
+	}, nil
+}
+
+// PrettyMultiSignedTransaction is used for pretty-printing multisig transactions so that the
+// actual content is displayed instead of the binary blob.
+//
+// It should only be used for pretty printing.
+type PrettyMultiSignedTransaction struct {
+	Config            MultisigConfig        `json:"config"`
+	Body              interface{}           `json:"untrusted_raw_value"`
+	Signatures        []signature.Signature `json:"signatures"`
+	FeePayerSignature *signature.Signature  `json:"fee_payer_signature,omitempty"`
+}
+
+// NewMultiSignedTransaction creates a new multisig transaction without any signatures.
+func NewMultiSignedTransaction(cfg *MultisigConfig, tx *Transaction) (*MultiSignedTransaction, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &MultiSignedTransaction{
+		Config: *cfg,
+		Blob:   cbor.Marshal(tx),
+	}, nil
+}
+
+// AllowsMultisig returns true iff the transaction may be authorized by a multisig account.
+//
+// In case of batch transactions, all of the batched calls must allow multisig authorization.
+func (t *Transaction) AllowsMultisig() bool {
+	if t.Method != MethodBatch {
+		return t.Method.AllowsMultisig()
+	}
+
+	var batch Batch
+	if err := cbor.Unmarshal(t.Body, &batch); err != nil {
+		return false
+	}
+	return batch.AllowsMultisig()
+}
@@ -0,0 +1,115 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	memorySigner "github.com/oasisprotocol/oasis-core/go/common/crypto/signature/signers/memory"
+)
+
+func TestMultisigConfig(t *testing.T) {
+	require := require.New(t)
+
+	signer1 := memorySigner.NewTestSigner("consensus/transaction: multisig signer 1")
+	signer2 := memorySigner.NewTestSigner("consensus/transaction: multisig signer 2")
+
+	cfg := MultisigConfig{
+		Signers:   []signature.PublicKey{signer1.Public(), signer2.Public()},
+		Threshold: 2,
+	}
+	require.NoError(cfg.ValidateBasic(), "ValidateBasic")
+	require.True(cfg.IsSigner(signer1.Public()))
+
+	reordered := MultisigConfig{
+		Signers:   []signature.PublicKey{signer2.Public(), signer1.Public()},
+		Threshold: 2,
+	}
+	require.EqualValues(cfg.Canonical(), reordered.Canonical(), "canonical configuration should not depend on signer order")
+
+	for _, c := range []MultisigConfig{
+		{},
+		{Signers: []signature.PublicKey{signer1.Public()}, Threshold: 0},
+		{Signers: []signature.PublicKey{signer1.Public()}, Threshold: 2},
+		{Signers: []signature.PublicKey{signer1.Public(), signer1.Public()}, Threshold: 1},
+	} {
+		require.ErrorIs(c.ValidateBasic(), ErrInvalidMultisigConfig, "ValidateBasic should fail for invalid configurations")
+	}
+}
+
+func TestMultiSignedTransaction(t *testing.T) {
+	require := require.New(t)
+
+	signature.SetChainContext("test: oasis-core tests")
+
+	signer1 := memorySigner.NewTestSigner("consensus/transaction: multisig signer 1")
+	signer2 := memorySigner.NewTestSigner("consensus/transaction: multisig signer 2")
+	signer3 := memorySigner.NewTestSigner("consensus/transaction: multisig signer 3")
+	other := memorySigner.NewTestSigner("consensus/transaction: multisig other signer")
+
+	cfg := &MultisigConfig{
+		Signers:   []signature.PublicKey{signer1.Public(), signer2.Public(), signer3.Public()},
+		Threshold: 2,
+	}
+	tx := NewTransaction(42, nil, "test.Multisig", nil)
+
+	// Collect partial signatures independently.
+	partial1, err := NewMultiSignedTransaction(cfg, tx)
+	require.NoError(err, "NewMultiSignedTransaction")
+	require.NoError(partial1.Sign(signer1), "Sign")
+	require.ErrorIs(partial1.Verify(), ErrInsufficientSignatures, "one signature should not be enough")
+
+	partial2, err := NewMultiSignedTransaction(cfg, tx)
+	require.NoError(err, "NewMultiSignedTransaction")
+	require.NoError(partial2.Sign(signer2), "Sign")
+	require.Error(partial2.Sign(signer2), "duplicate signatures should be rejected")
+	require.Error(partial2.Sign(other), "signatures by non-signers should be rejected")
+
+	// Combine them.
+	require.NoError(partial1.Merge(partial2), "Merge")
+	require.NoError(partial1.Merge(partial2), "Merge should skip existing signatures")
+	require.Len(partial1.Signatures, 2)
+
+	var opened Transaction
+	require.NoError(partial1.Open(&opened), "Open")
+	require.EqualValues(42, opened.Nonce)
+
+	// Round-trip through serialization.
+	var decoded MultiSignedTransaction
+	require.NoError(cbor.Unmarshal(cbor.Marshal(partial1), &decoded), "Unmarshal")
+	require.NoError(decoded.Verify(), "Verify")
+
+	// Regular signed transaction envelopes must not decode as multisig ones and vice versa.
+	var sigTx SignedTransaction
+	require.Error(cbor.Unmarshal(cbor.Marshal(partial1), &sigTx), "multisig envelope should not decode as SignedTransaction")
+
+	// Signature shares must not be usable as standalone signed transactions.
+	for _, sig := range partial1.Signatures {
+		share := SignedTransaction{
+			Signed: signature.Signed{
+				Blob:      partial1.Blob,
+				Signature: sig,
+			},
+		}
+		var shareTx Transaction
+		require.Error(share.Open(&shareTx), "signature share should not open as a signed transaction")
+	}
+
+	// Regular transaction signatures must not be usable as signature shares.
+	plain, err := Sign(signer3, tx)
+	require.NoError(err, "Sign")
+	require.Error(decoded.AddSignature(plain.Signature), "regular signature should not be accepted as a share")
+
+	// Tampered transactions should fail verification.
+	tampered := decoded
+	tampered.Blob = cbor.Marshal(NewTransaction(43, nil, "test.Multisig", nil))
+	require.Error(tampered.Verify(), "Verify should fail for a tampered blob")
+
+	// Merging signatures over a different transaction should fail.
+	partial3, err := NewMultiSignedTransaction(cfg, NewTransaction(43, nil, "test.Multisig", nil))
+	require.NoError(err, "NewMultiSignedTransaction")
+	require.NoError(partial3.Sign(signer3), "Sign")
+	require.Error(partial1.Merge(partial3), "Merge should fail for different transactions")
+}
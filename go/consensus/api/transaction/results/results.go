@@ -2,6 +2,7 @@ package results
 
 import (
 	"github.com/oasisprotocol/oasis-core/go/common/errors"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
 	governance "github.com/oasisprotocol/oasis-core/go/governance/api"
 	registry "github.com/oasisprotocol/oasis-core/go/registry/api"
 	roothash "github.com/oasisprotocol/oasis-core/go/roothash/api"
@@ -15,6 +16,9 @@ type Event struct {
 	Registry   *registry.Event   `json:"registry,omitempty"`
 	RootHash   *roothash.Event   `json:"roothash,omitempty"`
 	Governance *governance.Event `json:"governance,omitempty"`
+
+	// BatchCall is the result of a single call in a batch transaction.
+	BatchCall *transaction.BatchCallEvent `json:"batch_call,omitempty"`
 }
 
 // Error is a transaction execution error.
@@ -0,0 +1,81 @@
+package transaction
+
+import (
+	"fmt"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	"github.com/oasisprotocol/oasis-core/go/common/errors"
+)
+
+var (
+	// ErrInvalidFeePayer is the error returned when the fee payer signature of a sponsored
+	// transaction is missing or invalid.
+	ErrInvalidFeePayer = errors.New(moduleName, 9, "transaction: invalid fee payer signature")
+
+	// FeePayerSignatureContext is the context used by fee payers for signing sponsored
+	// transactions.
+	//
+	// It is distinct from SignatureContext so that a fee payer signature can never be used to
+	// authorize a transaction on behalf of the fee payer.
+	FeePayerSignatureContext = signature.NewContext("oasis-core/consensus: tx fee payer", signature.WithChainSeparation())
+)
+
+// IsSponsored returns true iff the transaction fee is paid by a fee payer other than the sender.
+func (t *Transaction) IsSponsored() bool {
+	return t.Fee != nil && t.Fee.Payer != nil
+}
+
+// verifyFeePayer verifies the fee payer signature over the given transaction blob.
+func verifyFeePayer(tx *Transaction, blob []byte, sig *signature.Signature) error {
+	switch {
+	case !tx.IsSponsored():
+		if sig != nil {
+			return fmt.Errorf("%w: unexpected fee payer signature", ErrInvalidFeePayer)
+		}
+		return nil
+	case sig == nil:
+		return fmt.Errorf("%w: missing fee payer signature", ErrInvalidFeePayer)
+	case !sig.PublicKey.Equal(*tx.Fee.Payer):
+		return fmt.Errorf("%w: signed by %s instead of %s", ErrInvalidFeePayer, sig.PublicKey, tx.Fee.Payer)
+	case !sig.Verify(FeePayerSignatureContext, blob):
+		return ErrInvalidFeePayer
+	default:
+		return nil
+	}
+}
+
+// signFeePayer signs the given transaction blob as the fee payer.
+func signFeePayer(signer signature.Signer, blob []byte) (*signature.Signature, error) {
+	var tx Transaction
+	if err := cbor.Unmarshal(blob, &tx); err != nil {
+		return nil, fmt.Errorf("transaction: malformed signed blob: %w", err)
+	}
+	if !tx.IsSponsored() {
+		return nil, fmt.Errorf("transaction: transaction is not sponsored")
+	}
+	if !tx.Fee.Payer.Equal(signer.Public()) {
+		return nil, fmt.Errorf("transaction: signer is not the fee payer (expected: %s)", tx.Fee.Payer)
+	}
+	return signature.Sign(signer, FeePayerSignatureContext, blob)
+}
+
+// SignFeePayer signs the sponsored transaction using the given fee payer signer.
+func (s *SignedTransaction) SignFeePayer(signer signature.Signer) error {
+	sig, err := signFeePayer(signer, s.Blob)
+	if err != nil {
+		return err
+	}
+	s.FeePayerSignature = sig
+	return nil
+}
+
+// SignFeePayer signs the sponsored multisig transaction using the given fee payer signer.
+func (s *MultiSignedTransaction) SignFeePayer(signer signature.Signer) error {
+	sig, err := signFeePayer(signer, s.Blob)
+	if err != nil {
+		return err
+	}
+	s.FeePayerSignature = sig
+	return nil
+}
@@ -0,0 +1,53 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	memorySigner "github.com/oasisprotocol/oasis-core/go/common/crypto/signature/signers/memory"
+)
+
+func TestSponsoredTransaction(t *testing.T) {
+	require := require.New(t)
+
+	signature.SetChainContext("test: oasis-core tests")
+
+	sender := memorySigner.NewTestSigner("consensus/transaction: sponsored sender")
+	sponsor := memorySigner.NewTestSigner("consensus/transaction: sponsored fee payer")
+	sponsorPk := sponsor.Public()
+
+	tx := NewTransaction(0, &Fee{Gas: 1000, Payer: &sponsorPk}, "test.Sponsored", nil)
+	require.True(tx.IsSponsored())
+
+	sigTx, err := Sign(sender, tx)
+	require.NoError(err, "Sign")
+
+	var opened Transaction
+	require.ErrorIs(sigTx.Open(&opened), ErrInvalidFeePayer, "missing fee payer signature should fail")
+
+	require.Error(sigTx.SignFeePayer(sender), "only the fee payer should be able to sign")
+	require.NoError(sigTx.SignFeePayer(sponsor), "SignFeePayer")
+	require.NoError(sigTx.Open(&opened), "Open")
+	require.EqualValues(sponsorPk, *opened.Fee.Payer)
+
+	// Round-trip through serialization.
+	var decoded SignedTransaction
+	require.NoError(cbor.Unmarshal(cbor.Marshal(sigTx), &decoded), "Unmarshal")
+	require.NoError(decoded.Open(&opened), "Open")
+
+	// Fee payer signatures must not be usable as sender signatures.
+	hijacked := SignedTransaction{Signed: signature.Signed{Blob: sigTx.Blob, Signature: *sigTx.FeePayerSignature}}
+	require.Error(hijacked.Open(&opened), "fee payer signature should not authorize the transaction")
+
+	// Unsponsored transactions must not carry a fee payer signature.
+	plainTx, err := Sign(sender, NewTransaction(0, &Fee{Gas: 1000}, "test.Sponsored", nil))
+	require.NoError(err, "Sign")
+	require.NoError(plainTx.Open(&opened), "Open should not be affected by previously opened transactions")
+	require.False(opened.IsSponsored(), "stale fee payer should not survive Open")
+	require.Error(plainTx.SignFeePayer(sponsor), "unsponsored transaction should not be signed by a fee payer")
+	plainTx.FeePayerSignature = sigTx.FeePayerSignature
+	require.ErrorIs(plainTx.Open(&opened), ErrInvalidFeePayer, "unexpected fee payer signature should fail")
+}
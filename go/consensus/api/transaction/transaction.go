@@ -51,6 +51,10 @@ type Transaction struct {
 	Method MethodName `json:"method"`
 	// Body is the method call body.
 	Body cbor.RawMessage `json:"body,omitempty"`
+
+	// Expiry is an optional expiry after which the transaction can no longer be included in
+	// a block.
+	Expiry *Expiry `json:"expiry,omitempty"`
 }
 
 // PrettyPrintBody writes a pretty-printed representation of transaction's body
@@ -98,6 +102,10 @@ func (t Transaction) PrettyPrint(ctx context.Context, prefix string, w io.Writer
 	} else {
 		fmt.Fprintf(w, "%sFee:   none\n", prefix)
 	}
+	if t.Expiry != nil {
+		fmt.Fprintf(w, "%sExpiry:\n", prefix)
+		t.Expiry.PrettyPrint(ctx, prefix+"  ", w)
+	}
 	if genesisHash, ok := ctx.Value(prettyprint.ContextKeyGenesisHash).(hash.Hash); ok {
 		fmt.Println("Other info:")
 		fmt.Printf("  Genesis document's hash: %s\n", genesisHash)
@@ -130,11 +138,17 @@ func (t *Transaction) PrettyType() (interface{}, error) {
 		Fee:    t.Fee,
 		Method: t.Method,
 		Body:   body,
+		Expiry: t.Expiry,
 	}, nil
 }
 
 // SanityCheck performs a basic sanity check on the transaction.
 func (t *Transaction) SanityCheck() error {
+	if t.Expiry != nil {
+		if err := t.Expiry.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return t.Method.SanityCheck()
 }
 
@@ -162,11 +176,16 @@ type PrettyTransaction struct {
 	Fee    *Fee        `json:"fee,omitempty"`
 	Method MethodName  `json:"method"`
 	Body   interface{} `json:"body,omitempty"`
+	Expiry *Expiry     `json:"expiry,omitempty"`
 }
 
 // SignedTransaction is a signed consensus transaction.
 type SignedTransaction struct {
 	signature.Signed
+
+	// FeePayerSignature is the signature of the fee payer over the blob in case the transaction
+	// is sponsored (see Fee.Payer).
+	FeePayerSignature *signature.Signature `json:"fee_payer_signature,omitempty"`
 }
 
 // Hash returns the cryptographic hash of the encoded transaction.
@@ -187,6 +206,14 @@ func (s SignedTransaction) PrettyPrint(ctx context.Context, prefix string, w io.
 		fmt.Fprintf(w, "%s        [INVALID SIGNATURE]\n", prefix)
 	}
 
+	if s.FeePayerSignature != nil {
+		fmt.Fprintf(w, "%sFee payer: %s\n", prefix, s.FeePayerSignature.PublicKey)
+		fmt.Fprintf(w, "%s           (signature: %s)\n", prefix, s.FeePayerSignature.Signature)
+		if !s.FeePayerSignature.Verify(FeePayerSignatureContext, s.Blob) {
+			fmt.Fprintf(w, "%s           [INVALID SIGNATURE]\n", prefix)
+		}
+	}
+
 	// Display the blob even if signature verification failed as it may
 	// be useful to look into it regardless.
 	var tx Transaction
@@ -206,12 +233,37 @@ func (s SignedTransaction) PrettyType() (interface{}, error) {
 	if err := cbor.Unmarshal(s.Blob, &tx); err != nil {
 		return nil, fmt.Errorf("malformed signed blob: %w", err)
 	}
-	return signature.NewPrettySigned(s.Signed, tx)
+	ps, err := signature.NewPrettySigned(s.Signed, tx)
+	if err != nil || s.FeePayerSignature == nil {
+		return ps, err
+	}
+	return &PrettySignedTransaction{
+		Body:              ps.Body,
+		Signature:         ps.Signature,
+		FeePayerSignature: s.FeePayerSignature,
+	}, nil
+}
+
+// PrettySignedTransaction is used for pretty-printing sponsored transactions so that the actual
+// content is displayed instead of the binary blob.
+//
+// It should only be used for pretty printing.
+type PrettySignedTransaction struct {
+	Body              interface{}          `json:"untrusted_raw_value"`
+	Signature         signature.Signature  `json:"signature"`
+	FeePayerSignature *signature.Signature `json:"fee_payer_signature,omitempty"`
 }
 
 // Open first verifies the blob signature and then unmarshals the blob.
+//
+// In case the transaction is sponsored, the fee payer signature is verified as well.
 func (s *SignedTransaction) Open(tx *Transaction) error { // nolint: interfacer
-	return s.Signed.Open(SignatureContext, tx)
+	// Make sure no stale fields (e.g., the fee payer) survive decoding into a reused transaction.
+	*tx = Transaction{}
+	if err := s.Signed.Open(SignatureContext, tx); err != nil {
+		return err
+	}
+	return verifyFeePayer(tx, s.Blob, s.FeePayerSignature)
 }
 
 // Sign signs a transaction.
@@ -287,6 +339,14 @@ const (
 // MethodMetadata is the method metadata.
 type MethodMetadata struct {
 	Priority MethodPriority
+
+	// AllowMultisig is a flag indicating that the method may be authorized by a multisig account
+	// (see MultiSignedTransaction).
+	AllowMultisig bool
+
+	// AllowBatch is a flag indicating that the method may be called as part of a batch
+	// transaction (see Batch).
+	AllowBatch bool
 }
 
 // MethodMetadataProvider is the method metadata provider interface that can be implemented by
@@ -331,6 +391,16 @@ func (m MethodName) IsCritical() bool {
 	return m.Metadata().Priority == MethodPriorityCritical
 }
 
+// AllowsMultisig returns true if the method may be authorized by a multisig account.
+func (m MethodName) AllowsMultisig() bool {
+	return m.Metadata().AllowMultisig
+}
+
+// AllowsBatch returns true if the method may be called as part of a batch transaction.
+func (m MethodName) AllowsBatch() bool {
+	return m.Metadata().AllowBatch
+}
+
 // NewMethodName creates a new method name.
 //
 // Module and method pair must be unique. If they are not, this method
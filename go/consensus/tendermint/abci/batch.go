@@ -0,0 +1,108 @@
+package abci
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/abci/types"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/errors"
+	eventsAPI "github.com/oasisprotocol/oasis-core/go/consensus/api/events"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
+	"github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
+)
+
+// executeBatch executes all calls of the given batch transaction atomically.
+//
+// Authentication, fee payment and charging gas for the transaction size is done once for the
+// enclosing transaction by the caller, the calls are then executed in order sharing the same
+// gas accountant. The batch call events are emitted under the name of the application handling
+// the given call.
+func (mux *abciMux) executeBatch(ctx *api.Context, tx *transaction.Transaction) error {
+	var batch transaction.Batch
+	if err := cbor.Unmarshal(tx.Body, &batch); err != nil {
+		return fmt.Errorf("%w: malformed batch: %s", transaction.ErrInvalidBatch, err)
+	}
+	if err := batch.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Resolve all method handlers before executing anything. Note that basic validation already
+	// ensures that only methods which allow batching are called.
+	apps := make([]api.Application, 0, len(batch.Calls))
+	for i, call := range batch.Calls {
+		app := mux.appsByMethod[call.Method]
+		if app == nil {
+			return fmt.Errorf("%w: call %d: unknown method: %s", transaction.ErrInvalidBatch, i, call.Method)
+		}
+		apps = append(apps, app)
+	}
+
+	// Execute all calls in a transaction context so that either all or none of them are applied.
+	bctx := ctx.NewTransaction()
+	defer bctx.Close()
+
+	for i, call := range batch.Calls {
+		callTx := &transaction.Transaction{
+			Nonce:  tx.Nonce,
+			Fee:    tx.Fee,
+			Method: call.Method,
+			Body:   call.Body,
+			Expiry: tx.Expiry,
+		}
+
+		ctx.Logger().Debug("dispatching batch call",
+			"app", apps[i].Name(),
+			"index", i,
+			"tx", callTx,
+		)
+
+		ev := &transaction.BatchCallEvent{
+			Index:  uint32(i),
+			Method: call.Method,
+		}
+		gasUsed := ctx.Gas().GasUsed()
+		err := apps[i].ExecuteTx(bctx, callTx)
+		ev.GasUsed = ctx.Gas().GasUsed() - gasUsed
+		if err != nil {
+			ev.Module, ev.Code = errors.Code(err)
+			ev.Message = err.Error()
+
+			// Emit the event for the failed call in the parent context as all events emitted by
+			// the batch are reverted.
+			ctx.EmitEvent(api.NewEventBuilder(apps[i].Name()).TypedAttribute(ev))
+			return err
+		}
+
+		bctx.EmitEvent(api.NewEventBuilder(apps[i].Name()).TypedAttribute(ev))
+	}
+	bctx.Commit()
+
+	return nil
+}
+
+// BatchEventsFromTendermint extracts batch call events from tendermint events.
+//
+// As batch call events are emitted under the name of the application handling the call, events
+// of all applications are considered.
+func BatchEventsFromTendermint(tmEvents []types.Event) ([]*transaction.BatchCallEvent, error) {
+	var events []*transaction.BatchCallEvent
+	for _, tmEv := range tmEvents {
+		for _, pair := range tmEv.GetAttributes() {
+			key := pair.GetKey()
+			val := pair.GetValue()
+
+			// Ignore events that don't relate to batch transactions.
+			if !eventsAPI.IsAttributeKind(key, &transaction.BatchCallEvent{}) {
+				continue
+			}
+
+			var e transaction.BatchCallEvent
+			if err := eventsAPI.DecodeValue(string(val), &e); err != nil {
+				return nil, fmt.Errorf("mux: corrupt BatchCall event: %w", err)
+			}
+			events = append(events, &e)
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,276 @@
+package abci
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/abci/types"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
+	consensus "github.com/oasisprotocol/oasis-core/go/consensus/api"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
+	consensusGenesis "github.com/oasisprotocol/oasis-core/go/consensus/genesis"
+	"github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
+	genesis "github.com/oasisprotocol/oasis-core/go/genesis/api"
+)
+
+const (
+	testBatchAppName = "test_batch"
+
+	testBatchOpSet = transaction.Op("set")
+)
+
+type testBatchSet struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Fail  bool   `json:"fail,omitempty"`
+}
+
+func (ts testBatchSet) MethodMetadata() transaction.MethodMetadata {
+	return transaction.MethodMetadata{AllowBatch: true}
+}
+
+type testBatchNonBatchable struct{}
+
+var (
+	testBatchMethodSet          = transaction.NewMethodName(testBatchAppName, "Set", testBatchSet{})
+	testBatchMethodNonBatchable = transaction.NewMethodName(testBatchAppName, "NonBatchable", testBatchNonBatchable{})
+
+	errTestBatchCall = fmt.Errorf("test batch call failed")
+)
+
+// testBatchApp is an application which sets keys in state, charging gas for each call.
+type testBatchApp struct{}
+
+func (app *testBatchApp) Name() string {
+	return testBatchAppName
+}
+
+func (app *testBatchApp) ID() uint8 {
+	return 0x7f
+}
+
+func (app *testBatchApp) Methods() []transaction.MethodName {
+	return []transaction.MethodName{testBatchMethodSet, testBatchMethodNonBatchable}
+}
+
+func (app *testBatchApp) Blessed() bool {
+	return false
+}
+
+func (app *testBatchApp) Dependencies() []string {
+	return nil
+}
+
+func (app *testBatchApp) QueryFactory() interface{} {
+	return nil
+}
+
+func (app *testBatchApp) OnRegister(api.ApplicationState, api.MessageDispatcher) {
+}
+
+func (app *testBatchApp) OnCleanup() {
+}
+
+func (app *testBatchApp) ExecuteMessage(*api.Context, interface{}, interface{}) (interface{}, error) {
+	return nil, fmt.Errorf("test batch: unsupported message")
+}
+
+func (app *testBatchApp) ExecuteTx(ctx *api.Context, tx *transaction.Transaction) error {
+	if tx.Method != testBatchMethodSet {
+		return nil
+	}
+
+	var set testBatchSet
+	if err := cbor.Unmarshal(tx.Body, &set); err != nil {
+		return err
+	}
+	if err := ctx.Gas().UseGas(1, testBatchOpSet, transaction.Costs{testBatchOpSet: 100}); err != nil {
+		return err
+	}
+	if err := ctx.State().Insert(ctx, []byte(set.Key), []byte(set.Value)); err != nil {
+		return err
+	}
+	if set.Fail {
+		return errTestBatchCall
+	}
+	return nil
+}
+
+func (app *testBatchApp) InitChain(*api.Context, types.RequestInitChain, *genesis.Document) error {
+	return nil
+}
+
+func (app *testBatchApp) BeginBlock(*api.Context, types.RequestBeginBlock) error {
+	return nil
+}
+
+func (app *testBatchApp) EndBlock(*api.Context, types.RequestEndBlock) (types.ResponseEndBlock, error) {
+	return types.ResponseEndBlock{}, nil
+}
+
+// testBatchAuthHandler is a transaction auth handler which counts invocations and charges fees
+// from a single balance.
+type testBatchAuthHandler struct {
+	balance      quantity.Quantity
+	authCount    int
+	postExecuted int
+}
+
+func (h *testBatchAuthHandler) GetSignerNonce(context.Context, *consensus.GetSignerNonceRequest) (uint64, error) {
+	return 0, nil
+}
+
+func (h *testBatchAuthHandler) GetMinGasPrice(context.Context, int64) (*quantity.Quantity, error) {
+	return quantity.NewQuantity(), nil
+}
+
+func (h *testBatchAuthHandler) AuthenticateTx(ctx *api.Context, tx *transaction.Transaction) error {
+	h.authCount++
+	if err := h.balance.Sub(&tx.Fee.Amount); err != nil {
+		return err
+	}
+	ctx.SetGasAccountant(api.NewGasAccountant(tx.Fee.Gas))
+	return nil
+}
+
+func (h *testBatchAuthHandler) PostExecuteTx(*api.Context, *transaction.Transaction) error {
+	h.postExecuted++
+	return nil
+}
+
+func newTestBatchMux(authHandler api.TransactionAuthHandler) *abciMux {
+	app := &testBatchApp{}
+	mux := &abciMux{
+		state: &applicationState{
+			blockParams: &consensusGenesis.Parameters{
+				GasCosts: transaction.Costs{
+					consensusGenesis.GasOpTxByte: 1,
+				},
+			},
+			txAuthHandler: authHandler,
+		},
+		appsByName:   map[string]api.Application{app.Name(): app},
+		appsByMethod: make(map[transaction.MethodName]api.Application),
+	}
+	for _, m := range app.Methods() {
+		mux.appsByMethod[m] = app
+	}
+	return mux
+}
+
+func newTestBatchTx(calls ...testBatchSet) *transaction.Transaction {
+	batchCalls := make([]transaction.BatchCall, 0, len(calls))
+	for _, call := range calls {
+		batchCalls = append(batchCalls, transaction.BatchCall{
+			Method: testBatchMethodSet,
+			Body:   cbor.Marshal(call),
+		})
+	}
+	return transaction.NewBatchTx(0, &transaction.Fee{
+		Amount: *quantity.NewFromUint64(100),
+		Gas:    1_000,
+	}, batchCalls)
+}
+
+func TestBatchExecution(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Unix(1580461674, 0)
+	appState := api.NewMockApplicationState(&api.MockApplicationStateConfig{})
+
+	authHandler := &testBatchAuthHandler{balance: *quantity.NewFromUint64(1_000)}
+	mux := newTestBatchMux(authHandler)
+
+	// Successful batch.
+	ctx := appState.NewContext(api.ContextDeliverTx, now)
+	defer ctx.Close()
+
+	tx := newTestBatchTx(
+		testBatchSet{Key: "a", Value: "1"},
+		testBatchSet{Key: "b", Value: "2"},
+	)
+	err := mux.processTx(ctx, tx, 10)
+	require.NoError(err, "processTx")
+
+	for key, value := range map[string]string{"a": "1", "b": "2"} {
+		v, err := ctx.State().Get(ctx, []byte(key))
+		require.NoError(err, "Get")
+		require.EqualValues(value, v, "all batched calls should be applied")
+	}
+
+	// Fees and gas should be charged once for the whole batch.
+	require.Equal(1, authHandler.authCount, "transaction should be authenticated once")
+	require.Equal(1, authHandler.postExecuted, "post-execute handler should be called once")
+	require.EqualValues(*quantity.NewFromUint64(900), authHandler.balance, "fee should be charged once")
+	require.EqualValues(10+2*100, ctx.Gas().GasUsed(), "gas should be accounted for all calls")
+
+	evs, err := BatchEventsFromTendermint(ctx.GetEvents())
+	require.NoError(err, "BatchEventsFromTendermint")
+	require.Len(evs, 2, "an event should be emitted for each call")
+	for i, ev := range evs {
+		require.EqualValues(i, ev.Index)
+		require.Equal(testBatchMethodSet, ev.Method)
+		require.EqualValues(100, ev.GasUsed)
+		require.True(ev.IsSuccess(), "call should succeed")
+	}
+	require.True(ctx.HasEvent(testBatchAppName, &transaction.BatchCallEvent{}), "events should be emitted under the app name")
+
+	// Failed batch should be reverted.
+	ctx = appState.NewContext(api.ContextDeliverTx, now)
+	defer ctx.Close()
+
+	tx = newTestBatchTx(
+		testBatchSet{Key: "c", Value: "3"},
+		testBatchSet{Key: "d", Value: "4", Fail: true},
+		testBatchSet{Key: "e", Value: "5"},
+	)
+	err = mux.processTx(ctx, tx, 10)
+	require.ErrorIs(err, errTestBatchCall, "processTx should fail")
+
+	for _, key := range []string{"c", "d", "e"} {
+		v, err := ctx.State().Get(ctx, []byte(key))
+		require.NoError(err, "Get")
+		require.Nil(v, "no batched calls should be applied")
+	}
+
+	require.Equal(2, authHandler.authCount, "transaction should be authenticated once")
+	require.Equal(1, authHandler.postExecuted, "post-execute handler should not be called")
+
+	evs, err = BatchEventsFromTendermint(ctx.GetEvents())
+	require.NoError(err, "BatchEventsFromTendermint")
+	require.Len(evs, 1, "only the event of the failed call should be emitted")
+	require.EqualValues(1, evs[0].Index)
+	require.False(evs[0].IsSuccess(), "call should fail")
+	require.Equal(errTestBatchCall.Error(), evs[0].Message)
+
+	// Batches with methods which don't allow batching should be rejected before execution.
+	ctx = appState.NewContext(api.ContextDeliverTx, now)
+	defer ctx.Close()
+
+	tx = newTestBatchTx(testBatchSet{Key: "f", Value: "6"})
+	tx.Body = cbor.Marshal(&transaction.Batch{Calls: []transaction.BatchCall{
+		{Method: testBatchMethodSet, Body: cbor.Marshal(testBatchSet{Key: "f", Value: "6"})},
+		{Method: testBatchMethodNonBatchable},
+	}})
+	err = mux.processTx(ctx, tx, 10)
+	require.ErrorIs(err, transaction.ErrInvalidBatch, "processTx should fail")
+
+	v, err := ctx.State().Get(ctx, []byte("f"))
+	require.NoError(err, "Get")
+	require.Nil(v, "no batched calls should be applied")
+	require.Len(ctx.GetEvents(), 0, "no events should be emitted")
+
+	// Nested batches should be rejected.
+	tx.Body = cbor.Marshal(&transaction.Batch{Calls: []transaction.BatchCall{
+		{Method: transaction.MethodBatch, Body: cbor.Marshal(&transaction.Batch{Calls: []transaction.BatchCall{
+			{Method: testBatchMethodSet, Body: cbor.Marshal(testBatchSet{Key: "f", Value: "6"})},
+		}})},
+	}})
+	err = mux.processTx(ctx, tx, 10)
+	require.ErrorIs(err, transaction.ErrInvalidBatch, "processTx should fail")
+}
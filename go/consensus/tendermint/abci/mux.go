@@ -30,6 +30,7 @@ import (
 	consensusGenesis "github.com/oasisprotocol/oasis-core/go/consensus/genesis"
 	abciState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/abci/state"
 	"github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
+	staking "github.com/oasisprotocol/oasis-core/go/staking/api"
 	storageApi "github.com/oasisprotocol/oasis-core/go/storage/api"
 	"github.com/oasisprotocol/oasis-core/go/storage/mkvs/checkpoint"
 	upgrade "github.com/oasisprotocol/oasis-core/go/upgrade/api"
@@ -504,10 +505,12 @@ func (mux *abciMux) BeginBlock(req types.RequestBeginBlock) types.ResponseBeginB
 	return response
 }
 
-func (mux *abciMux) decodeTx(ctx *api.Context, rawTx []byte) (*transaction.Transaction, *transaction.SignedTransaction, error) {
+// decodeTx decodes and verifies the given transaction envelope and sets the authenticated
+// transaction signer in the passed context.
+func (mux *abciMux) decodeTx(ctx *api.Context, rawTx []byte) (*transaction.Transaction, error) {
 	if mux.state.haltMode {
 		ctx.Logger().Debug("executeTx: in halt, rejecting all transactions")
-		return nil, nil, fmt.Errorf("halt mode, rejecting all transactions")
+		return nil, fmt.Errorf("halt mode, rejecting all transactions")
 	}
 
 	params := mux.state.ConsensusParameters()
@@ -517,38 +520,73 @@ func (mux *abciMux) decodeTx(ctx *api.Context, rawTx []byte) (*transaction.Trans
 		ctx.Logger().Debug("received oversized transaction",
 			"tx_size", len(rawTx),
 		)
-		return nil, nil, consensus.ErrOversizedTx
+		return nil, consensus.ErrOversizedTx
 	}
 
-	// Unmarshal envelope and verify transaction.
-	var sigTx transaction.SignedTransaction
+	// Unmarshal envelope and verify transaction. Since unknown fields are rejected, a multisig
+	// envelope never decodes as a regular signed transaction and vice versa.
+	var (
+		tx         transaction.Transaction
+		sigTx      transaction.SignedTransaction
+		multiSigTx transaction.MultiSignedTransaction
+		isMultisig bool
+	)
 	if err := cbor.Unmarshal(rawTx, &sigTx); err != nil {
-		ctx.Logger().Debug("failed to unmarshal signed transaction",
-			"tx", base64.StdEncoding.EncodeToString(rawTx),
-		)
-		return nil, nil, err
+		if cbor.Unmarshal(rawTx, &multiSigTx) != nil {
+			ctx.Logger().Debug("failed to unmarshal signed transaction",
+				"tx", base64.StdEncoding.EncodeToString(rawTx),
+			)
+			return nil, err
+		}
+		isMultisig = true
 	}
-	var tx transaction.Transaction
-	if err := sigTx.Open(&tx); err != nil {
-		ctx.Logger().Debug("failed to verify transaction signature",
-			"tx", base64.StdEncoding.EncodeToString(rawTx),
-		)
-		return nil, nil, err
+	if isMultisig {
+		if err := multiSigTx.Open(&tx); err != nil {
+			ctx.Logger().Debug("failed to verify multisig transaction signatures",
+				"tx", base64.StdEncoding.EncodeToString(rawTx),
+				"err", err,
+			)
+			return nil, err
+		}
+	} else {
+		if err := sigTx.Open(&tx); err != nil {
+			ctx.Logger().Debug("failed to verify transaction signature",
+				"tx", base64.StdEncoding.EncodeToString(rawTx),
+			)
+			return nil, err
+		}
 	}
 	if err := tx.SanityCheck(); err != nil {
 		ctx.Logger().Debug("bad transaction",
 			"tx", base64.StdEncoding.EncodeToString(rawTx),
 		)
-		return nil, nil, err
+		return nil, err
+	}
+
+	// Set authenticated transaction signer.
+	if isMultisig {
+		if !tx.AllowsMultisig() {
+			ctx.Logger().Debug("method not allowed for multisig accounts",
+				"method", tx.Method,
+			)
+			return nil, transaction.ErrMultisigNotAllowed
+		}
+		ctx.SetTxMultisigAccount(staking.NewMultisigAddress(&multiSigTx.Config))
+	} else {
+		ctx.SetTxSigner(sigTx.Signature.PublicKey)
+	}
+	if tx.IsSponsored() {
+		ctx.SetTxFeePayer(staking.NewAddress(*tx.Fee.Payer))
 	}
 
-	return &tx, &sigTx, nil
+	return &tx, nil
 }
 
 func (mux *abciMux) processTx(ctx *api.Context, tx *transaction.Transaction, txSize int) error {
-	// Lookup method handler.
+	// Lookup method handler. Batch transactions are handled by the multiplexer itself.
+	isBatch := tx.Method == transaction.MethodBatch
 	app := mux.appsByMethod[tx.Method]
-	if app == nil {
+	if app == nil && !isBatch {
 		ctx.Logger().Debug("unknown method",
 			"tx", tx,
 			"method", tx.Method,
@@ -578,14 +616,20 @@ func (mux *abciMux) processTx(ctx *api.Context, tx *transaction.Transaction, txS
 		return err
 	}
 
-	// Route to correct handler.
-	ctx.Logger().Debug("dispatching",
-		"app", app.Name(),
-		"tx", tx,
-	)
+	if isBatch {
+		if err := mux.executeBatch(ctx, tx); err != nil {
+			return err
+		}
+	} else {
+		// Route to correct handler.
+		ctx.Logger().Debug("dispatching",
+			"app", app.Name(),
+			"tx", tx,
+		)
 
-	if err := app.ExecuteTx(ctx, tx); err != nil {
-		return err
+		if err := app.ExecuteTx(ctx, tx); err != nil {
+			return err
+		}
 	}
 
 	//  Pass the transaction through the PostExecuteTx handler if configured.
@@ -604,14 +648,41 @@ func (mux *abciMux) processTx(ctx *api.Context, tx *transaction.Transaction, txS
 	return nil
 }
 
+// checkTxExpiry checks whether the given transaction has expired and can no longer be included in
+// the block that is currently being processed (or, in case of CheckTx, the next block).
+func (mux *abciMux) checkTxExpiry(ctx *api.Context, tx *transaction.Transaction) error {
+	if tx.Expiry == nil {
+		return nil
+	}
+
+	height := ctx.BlockHeight() + 1
+	epoch, err := mux.state.GetCurrentEpoch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current epoch: %w", err)
+	}
+	if tx.Expiry.IsExpired(height, uint64(epoch)) {
+		ctx.Logger().Debug("rejecting expired transaction",
+			"expiry_height", tx.Expiry.Height,
+			"expiry_epoch", tx.Expiry.Epoch,
+			"height", height,
+			"epoch", epoch,
+		)
+		return transaction.ErrTransactionExpired
+	}
+	return nil
+}
+
 func (mux *abciMux) executeTx(ctx *api.Context, rawTx []byte) error {
-	tx, sigTx, err := mux.decodeTx(ctx, rawTx)
+	tx, err := mux.decodeTx(ctx, rawTx)
 	if err != nil {
 		return err
 	}
 
-	// Set authenticated transaction signer.
-	ctx.SetTxSigner(sigTx.Signature.PublicKey)
+	// Reject expired transactions. As the mempool rechecks all transactions after each block,
+	// this also evicts any transactions which expire while waiting in the mempool.
+	if err = mux.checkTxExpiry(ctx, tx); err != nil {
+		return err
+	}
 
 	// If we are in CheckTx mode and there is a pending upgrade in this block, make sure to reject
 	// any transactions before processing as they may potentially query incompatible state.
@@ -649,8 +720,15 @@ func (mux *abciMux) EstimateGas(caller signature.PublicKey, tx *transaction.Tran
 	// Modify transaction to include maximum possible gas in order to estimate the upper limit on
 	// the serialized transaction size. For amount, use a reasonable amount (in theory the actual
 	// amount could be bigger depending on the gas price).
+	//
+	// Preserve the fee payer in case of sponsored transactions as it also affects the size.
+	var feePayer *signature.PublicKey
+	if tx.Fee != nil {
+		feePayer = tx.Fee.Payer
+	}
 	tx.Fee = &transaction.Fee{
-		Gas: transaction.Gas(math.MaxUint64),
+		Gas:   transaction.Gas(math.MaxUint64),
+		Payer: feePayer,
 	}
 	_ = tx.Fee.Amount.FromUint64(math.MaxUint64)
 
@@ -661,6 +739,10 @@ func (mux *abciMux) EstimateGas(caller signature.PublicKey, tx *transaction.Tran
 			// Signature is fixed-size, so we can leave it as default.
 		},
 	}
+	if feePayer != nil {
+		ctx.SetTxFeePayer(staking.NewAddress(*feePayer))
+		mockSignedTx.FeePayerSignature = &signature.Signature{PublicKey: *feePayer}
+	}
 	txSize := len(cbor.Marshal(mockSignedTx))
 
 	// Ignore any errors that occurred during simulation as we only need to estimate gas even if the
@@ -16,8 +16,9 @@ const (
 	// PruneDefault is the default PruneStrategy.
 	PruneDefault = pruneNone
 
-	pruneNone  = "none"
-	pruneKeepN = "keep_n"
+	pruneNone                 = "none"
+	pruneKeepN                = "keep_n"
+	pruneKeepNSinceCheckpoint = "keep_n_since_checkpoint"
 
 	// LogEventABCIPruneDelete is a log event value that signals an ABCI pruning
 	// delete event.
@@ -33,6 +34,14 @@ const (
 
 	// PruneKeepN retains the last N latest versions.
 	PruneKeepN
+
+	// PruneKeepNSinceCheckpoint retains the last N latest versions and all versions since the
+	// checkpoint (e.g., epoch boundary) preceding them. Only a single checkpoint is retained.
+	//
+	// Checkpoint versions are versions at multiples of the checkpoint interval relative to the
+	// initial version. Since the node database only supports pruning the earliest version, older
+	// checkpoints cannot be retained sparsely.
+	PruneKeepNSinceCheckpoint
 )
 
 func (s PruneStrategy) String() string {
@@ -41,6 +50,8 @@ func (s PruneStrategy) String() string {
 		return pruneNone
 	case PruneKeepN:
 		return pruneKeepN
+	case PruneKeepNSinceCheckpoint:
+		return pruneKeepNSinceCheckpoint
 	default:
 		return "[unknown]"
 	}
@@ -52,6 +63,8 @@ func (s *PruneStrategy) FromString(str string) error {
 		*s = PruneNone
 	case pruneKeepN:
 		*s = PruneKeepN
+	case pruneKeepNSinceCheckpoint:
+		*s = PruneKeepNSinceCheckpoint
 	default:
 		return fmt.Errorf("abci/pruner: unknown pruning strategy: '%v'", str)
 	}
@@ -67,6 +80,10 @@ type PruneConfig struct {
 	// NumKept is the number of versions retained when applicable.
 	NumKept uint64
 
+	// CheckpointInterval is the interval (in versions) between checkpoint versions when
+	// applicable, counted from the initial version. This is usually the beacon epoch interval.
+	CheckpointInterval uint64
+
 	// PruneInterval configures the pruning interval.
 	PruneInterval time.Duration
 }
@@ -114,7 +131,9 @@ type genericPruner struct {
 	ndb    nodedb.NodeDB
 
 	earliestVersion     uint64
+	initialVersion      uint64
 	keepN               uint64
+	checkpointInterval  uint64
 	lastRetainedVersion uint64
 
 	handlers []api.StatePruneHandler
@@ -145,6 +164,14 @@ func (p *genericPruner) Prune(ctx context.Context, latestVersion uint64) error {
 	return nil
 }
 
+// isCheckpoint returns true iff the given version is a checkpoint version.
+func (p *genericPruner) isCheckpoint(version uint64) bool {
+	if p.checkpointInterval == 0 || version < p.initialVersion {
+		return false
+	}
+	return (version-p.initialVersion)%p.checkpointInterval == 0
+}
+
 func (p *genericPruner) doPrune(ctx context.Context, latestVersion uint64) error {
 	if latestVersion < p.keepN {
 		return nil
@@ -162,6 +189,13 @@ PruneLoop:
 			p.earliestVersion = i
 			break
 		}
+		// Only the earliest version can be pruned from the node database, so in order to retain
+		// the checkpoint version preceding the retained versions, all versions following it must
+		// be retained as well.
+		if p.isCheckpoint(i) && i+p.checkpointInterval > preserveFrom {
+			p.earliestVersion = i
+			break
+		}
 
 		// Before pruning anything, run all prune handlers. If any of them
 		// fails we abort the prune.
@@ -222,7 +256,7 @@ func (p *genericPruner) RegisterHandler(handler api.StatePruneHandler) {
 	p.handlers = append(p.handlers, handler)
 }
 
-func newStatePruner(cfg *PruneConfig, ndb nodedb.NodeDB) (StatePruner, error) {
+func newStatePruner(cfg *PruneConfig, ndb nodedb.NodeDB, initialVersion uint64) (StatePruner, error) {
 	// The roothash checkCommittees call requires at least 1 previous block
 	// for timekeeping purposes.
 	const minKept = 1
@@ -243,6 +277,21 @@ func newStatePruner(cfg *PruneConfig, ndb nodedb.NodeDB) (StatePruner, error) {
 			ndb:    ndb,
 			keepN:  cfg.NumKept,
 		}
+	case PruneKeepNSinceCheckpoint:
+		if cfg.NumKept < minKept {
+			return nil, fmt.Errorf("abci/pruner: invalid number of versions retained: %v", cfg.NumKept)
+		}
+		if cfg.CheckpointInterval == 0 {
+			return nil, fmt.Errorf("abci/pruner: invalid checkpoint interval: %v", cfg.CheckpointInterval)
+		}
+
+		statePruner = &genericPruner{
+			logger:             logger,
+			ndb:                ndb,
+			initialVersion:     initialVersion,
+			keepN:              cfg.NumKept,
+			checkpointInterval: cfg.CheckpointInterval,
+		}
 	default:
 		return nil, fmt.Errorf("abci/pruner: unsupported pruning strategy: %v", cfg.Strategy)
 	}
@@ -256,6 +305,8 @@ func newStatePruner(cfg *PruneConfig, ndb nodedb.NodeDB) (StatePruner, error) {
 	logger.Debug("ABCI state pruner initialized",
 		"strategy", cfg.Strategy,
 		"num_kept", cfg.NumKept,
+		"checkpoint_interval", cfg.CheckpointInterval,
+		"initial_version", initialVersion,
 	)
 
 	return statePruner, nil
@@ -54,7 +54,7 @@ func TestPruneKeepN(t *testing.T) {
 	pruner, err := newStatePruner(&PruneConfig{
 		Strategy: PruneKeepN,
 		NumKept:  2,
-	}, ndb)
+	}, ndb, 1)
 	require.NoError(err, "newStatePruner failed")
 
 	earliestVersion = ndb.GetEarliestVersion()
@@ -78,3 +78,92 @@ func TestPruneKeepN(t *testing.T) {
 	lastRetainedVersion = pruner.GetLastRetainedVersion()
 	require.EqualValues(9, lastRetainedVersion, "last retained version should be correct")
 }
+
+func TestPruneKeepNSinceCheckpoint(t *testing.T) {
+	require := require.New(t)
+
+	// Create a new random temporary directory under /tmp.
+	dir, err := os.MkdirTemp("", "abci-prune.test.badger")
+	require.NoError(err, "TempDir")
+	defer os.RemoveAll(dir)
+
+	// Create a Badger-backed Node DB.
+	ndb, err := mkvsBadgerDB.New(&mkvsDB.Config{
+		DB:           dir,
+		NoFsync:      true,
+		MaxCacheSize: 16 * 1024 * 1024,
+	})
+	require.NoError(err, "New")
+	tree := mkvs.New(nil, ndb, mkvsNode.RootTypeState)
+
+	// Use an initial version that is not aligned to the checkpoint interval, so checkpoints are
+	// at versions 3, 7, 11, 15 and 19.
+	const initialVersion = 3
+	ctx := context.Background()
+	roots := make(map[uint64]mkvsNode.Root)
+	for i := uint64(initialVersion); i <= 20; i++ {
+		err = tree.Insert(ctx, []byte(fmt.Sprintf("key:%d", i)), []byte(fmt.Sprintf("value:%d", i)))
+		require.NoError(err, "Insert")
+
+		var rootHash hash.Hash
+		_, rootHash, err = tree.Commit(ctx, common.Namespace{}, i)
+		require.NoError(err, "Commit")
+		roots[i] = mkvsNode.Root{Namespace: common.Namespace{}, Version: i, Type: mkvsNode.RootTypeState, Hash: rootHash}
+		err = ndb.Finalize(ctx, []mkvsNode.Root{roots[i]})
+		require.NoError(err, "Finalize")
+	}
+
+	_, err = newStatePruner(&PruneConfig{
+		Strategy: PruneKeepNSinceCheckpoint,
+		NumKept:  2,
+	}, ndb, initialVersion)
+	require.Error(err, "newStatePruner should fail without a checkpoint interval")
+
+	pruner, err := newStatePruner(&PruneConfig{
+		Strategy:           PruneKeepNSinceCheckpoint,
+		NumKept:            2,
+		CheckpointInterval: 4,
+	}, ndb, initialVersion)
+	require.NoError(err, "newStatePruner failed")
+
+	requireCheckpoint := func(version uint64) {
+		require.EqualValues(version, ndb.GetEarliestVersion(), "earliest version should be the checkpoint")
+		require.EqualValues(version, pruner.GetLastRetainedVersion(), "last retained version should be the checkpoint")
+
+		// State at the checkpoint version should remain available.
+		cpTree := mkvs.NewWithRoot(nil, ndb, roots[version])
+		defer cpTree.Close()
+		value, err := cpTree.Get(ctx, []byte(fmt.Sprintf("key:%d", version)))
+		require.NoError(err, "Get")
+		require.EqualValues([]byte(fmt.Sprintf("value:%d", version)), value, "checkpoint state should be available")
+
+		// Only the versions since the checkpoint should survive, including all of the versions
+		// between the checkpoint and the last N versions. Earlier checkpoints are not retained.
+		for v := uint64(initialVersion); v <= 20; v++ {
+			require.Equal(v >= version, ndb.HasRoot(roots[v]), "version %d should survive iff not before the checkpoint", v)
+		}
+	}
+
+	// Version 10 would be the earliest retained version, but the checkpoint preceding it is 7.
+	err = pruner.Prune(ctx, 12)
+	require.NoError(err, "Prune")
+	requireCheckpoint(7)
+
+	// Version 11 is both the earliest retained version and a checkpoint.
+	err = pruner.Prune(ctx, 13)
+	require.NoError(err, "Prune")
+	requireCheckpoint(11)
+
+	// The checkpoint must survive further pruning until the next checkpoint is reached.
+	err = pruner.Prune(ctx, 16)
+	require.NoError(err, "Prune")
+	requireCheckpoint(11)
+
+	err = pruner.Prune(ctx, 20)
+	require.NoError(err, "Prune")
+	requireCheckpoint(15)
+
+	latestVersion, exists := ndb.GetLatestVersion()
+	require.EqualValues(20, latestVersion, "latest version should be correct")
+	require.True(exists, "latest version should exist")
+}
@@ -563,7 +563,7 @@ func newApplicationState(ctx context.Context, upgrader upgrade.Backend, cfg *App
 	checkTxTree := mkvs.NewWithRoot(nil, ndb, *stateRoot, mkvs.WithoutWriteLog())
 
 	// Initialize the state pruner.
-	statePruner, err := newStatePruner(&cfg.Pruning, ndb)
+	statePruner, err := newStatePruner(&cfg.Pruning, ndb, cfg.InitialHeight)
 	if err != nil {
 		return nil, fmt.Errorf("state: failed to create pruner: %w", err)
 	}
@@ -76,6 +76,7 @@ type Context struct { // nolint: maligned
 
 	txSigner      signature.PublicKey
 	callerAddress staking.Address
+	txFeePayer    *staking.Address
 
 	appState      ApplicationState
 	state         mkvs.KeyValueTree
@@ -195,6 +196,27 @@ func (c *Context) SetTxSigner(txSigner signature.PublicKey) {
 		c.txSigner = txSigner
 		// By default, the caller is the transaction signer.
 		c.callerAddress = staking.NewAddress(txSigner)
+		c.txFeePayer = nil
+	default:
+		panic("context: only available in transaction context")
+	}
+}
+
+// SetTxMultisigAccount sets the authenticated multisig account as the transaction signer.
+//
+// Since multisig accounts have no single signing key, the transaction signer public key is
+// left unset and only the caller address is set.
+//
+// This must only be done after verifying the transaction signatures.
+//
+// In case the method is called on a non-transaction context, this method
+// will panic.
+func (c *Context) SetTxMultisigAccount(addr staking.Address) {
+	switch c.mode {
+	case ContextCheckTx, ContextDeliverTx, ContextSimulateTx:
+		c.txSigner = signature.PublicKey{}
+		c.callerAddress = addr
+		c.txFeePayer = nil
 	default:
 		panic("context: only available in transaction context")
 	}
@@ -205,6 +227,32 @@ func (c *Context) CallerAddress() staking.Address {
 	return c.callerAddress
 }
 
+// TxFeePayer returns the address of the account paying the transaction fee.
+//
+// Unless the transaction is sponsored, this is the same as the caller address.
+func (c *Context) TxFeePayer() staking.Address {
+	if c.txFeePayer == nil {
+		return c.callerAddress
+	}
+	return *c.txFeePayer
+}
+
+// SetTxFeePayer sets the authenticated account sponsoring the transaction by paying its fee.
+//
+// This must only be done after verifying the fee payer signature and after setting the
+// transaction signer.
+//
+// In case the method is called on a non-transaction context, this method
+// will panic.
+func (c *Context) SetTxFeePayer(addr staking.Address) {
+	switch c.mode {
+	case ContextCheckTx, ContextDeliverTx, ContextSimulateTx:
+		c.txFeePayer = &addr
+	default:
+		panic("context: only available in transaction context")
+	}
+}
+
 // NewChild creates a new child context that shares state with the current context.
 //
 // If you want isolated state and events use NewTransaction instad.
@@ -217,6 +265,7 @@ func (c *Context) NewChild() *Context {
 		gasAccountant:      c.gasAccountant,
 		txSigner:           c.txSigner,
 		callerAddress:      c.callerAddress,
+		txFeePayer:         c.txFeePayer,
 		appState:           c.appState,
 		state:              c.state,
 		blockHeight:        c.blockHeight,
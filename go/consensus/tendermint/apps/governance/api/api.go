@@ -13,3 +13,15 @@ var MessageChangeParameters = messageKind(0)
 // successful and with error otherwise. Other modules should ignore the message and return a nil
 // response.
 var MessageValidateParameterChanges = messageKind(1)
+
+// MessageUpdateRuntime is the message kind for when the update runtime proposal closes as
+// accepted. The message is the update runtime proposal. The registry application should
+// respond with an empty struct if the runtime descriptor was successfully updated and with
+// error otherwise.
+var MessageUpdateRuntime = messageKind(2)
+
+// MessageValidateRuntimeUpdate is the message kind for when the update runtime proposal's
+// runtime descriptor should be validated. The message is the update runtime proposal. The
+// registry application should respond with an empty struct if validation is successful and
+// with error otherwise.
+var MessageValidateRuntimeUpdate = messageKind(3)
@@ -303,6 +303,35 @@ func (app *governanceApplication) executeProposal(ctx *api.Context, state *gover
 			ctx.Logger().Debug("governance: no module applied change parameters proposal")
 			return governance.ErrInvalidArgument
 		}
+	case proposal.Content.UpdateRuntime != nil:
+		// To not violate the consensus, update runtime proposals should be ignored when
+		// disabled.
+		params, err := state.ConsensusParameters(ctx)
+		if err != nil {
+			ctx.Logger().Error("failed to query consensus parameters",
+				"err", err,
+			)
+			return governance.ErrInvalidArgument
+		}
+		if !params.EnableUpdateRuntimeProposal {
+			ctx.Logger().Debug("update runtime proposals are disabled")
+			return governance.ErrInvalidArgument
+		}
+
+		// Apply the runtime descriptor update through the registry application.
+		res, err := app.md.Publish(ctx, governanceApi.MessageUpdateRuntime, proposal.Content.UpdateRuntime)
+		if err != nil {
+			ctx.Logger().Debug("failed to dispatch update runtime proposal message",
+				"err", err,
+			)
+			return err
+		}
+		if res == nil {
+			ctx.Logger().Debug("governance: no module applied update runtime proposal")
+			return governance.ErrInvalidArgument
+		}
+	case proposal.Content.Signaling != nil:
+		// Signaling proposals have no execution effect, they only record the voting outcome.
 	default:
 		return governance.ErrInvalidArgument
 	}
@@ -379,10 +408,173 @@ func (app *governanceApplication) closeProposal(
 		"validator_entities_pool", validatorEntitiesPool,
 		"votes", votes,
 	)
+	var tally *voteTally
+	if params.EnableDelegatedVoting {
+		tally, err = tallyVotes(ctx, stakingState, validatorEntitiesPool, votes)
+	} else {
+		tally, err = tallyVotesLegacy(ctx, stakingState, validatorEntitiesPool, votes)
+	}
+	if err != nil {
+		return err
+	}
+	proposal.InvalidVotes += tally.invalidVotes
+
+	// Finalize the voting results - convert votes in shares into results in stake.
+	proposal.Results = make(map[governance.Vote]quantity.Quantity)
+	for validator, votes := range tally.shares {
+		validatorPool, ok := validatorEntitiesPool[validator]
+		if !ok {
+			// This should NEVER happen.
+			panic("governance: missing validator pool")
+		}
+		for vote, shares := range votes {
+			// Compute stake from shares.
+			escrow, err := validatorPool.StakeForShares(shares.Clone())
+			if err != nil {
+				ctx.Logger().Error("failed to compute stake from shares for",
+					"share_pool", validatorPool,
+					"validator", validator,
+					"err", err,
+				)
+				return fmt.Errorf("failed to compute stake from shares: %w", err)
+
+			}
+
+			// Add stake to vote.
+			currentVotes := proposal.Results[vote]
+			if err := currentVotes.Add(escrow); err != nil {
+				return fmt.Errorf("failed to add votes: %w", err)
+			}
+			proposal.Results[vote] = currentVotes
+		}
+	}
+
+	ctx.Logger().Debug("close proposal",
+		"total_voting_state", totalVotingStake,
+		"results", proposal.Results,
+		"invalid_votes", proposal.InvalidVotes,
+		"stake_threshold", params.StakeThreshold,
+	)
+	if err := proposal.CloseProposal(totalVotingStake, params.StakeThreshold); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// voteTally is the result of tallying proposal votes against the validator escrow pools.
+type voteTally struct {
+	// shares are the validator escrow pool shares for each vote, by validator.
+	shares map[stakingAPI.Address]map[governance.Vote]quantity.Quantity
+	// voterShares are the validator escrow pool shares voted with, by voter and validator.
+	voterShares map[stakingAPI.Address]map[stakingAPI.Address]quantity.Quantity
+	// invalidVotes is the number of votes cast by voters without delegations to validators.
+	invalidVotes uint64
+}
+
+// tallyVotes tallies the votes using the delegations to each of the validator entities.
+//
+// The validator's vote is applied to all of the shares in its escrow pool, except for the shares
+// of delegators that cast their own vote, which override the vote of the validator.
+func tallyVotes(
+	ctx context.Context,
+	stakingState *stakingState.ImmutableState,
+	validatorEntitiesPool map[stakingAPI.Address]*stakingAPI.SharePool,
+	votes []*governance.VoteEntry,
+) (*voteTally, error) {
+	votesByVoter := make(map[stakingAPI.Address]governance.Vote, len(votes))
+	for _, vote := range votes {
+		votesByVoter[vote.Voter] = vote.Vote
+	}
+
+	tally := &voteTally{
+		shares:      make(map[stakingAPI.Address]map[governance.Vote]quantity.Quantity),
+		voterShares: make(map[stakingAPI.Address]map[stakingAPI.Address]quantity.Quantity),
+	}
+	addVoterShares := func(voter, validator stakingAPI.Address, amount quantity.Quantity) error {
+		if tally.voterShares[voter] == nil {
+			tally.voterShares[voter] = make(map[stakingAPI.Address]quantity.Quantity)
+		}
+		amt := amount.Clone()
+		currShares := tally.voterShares[voter][validator]
+		if err := amt.Add(&currShares); err != nil {
+			return fmt.Errorf("failed to add voter shares: %w", err)
+		}
+		tally.voterShares[voter][validator] = *amt
+		return nil
+	}
+
+	hasValidatorDelegation := make(map[stakingAPI.Address]bool)
+	for validator := range validatorEntitiesPool {
+		voteShares := make(map[governance.Vote]quantity.Quantity)
+		tally.shares[validator] = voteShares
+
+		validatorVote, validatorVoted := votesByVoter[validator]
+		if validatorVoted {
+			// Make sure the validator vote is accounted for even if all delegators override it.
+			if err := addShares(voteShares, validatorVote, *quantity.NewQuantity()); err != nil {
+				return nil, err
+			}
+		}
+
+		delegations, err := stakingState.DelegationsTo(ctx, validator)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch delegations to %s: %w", validator, err)
+		}
+		for delegator, delegation := range delegations {
+			vote, delegatorVoted := votesByVoter[delegator]
+			if delegatorVoted {
+				hasValidatorDelegation[delegator] = true
+			}
+
+			switch {
+			case delegatorVoted:
+				// Delegator vote (also the validator's own vote for its self-delegation).
+				if err = addShares(voteShares, vote, delegation.Shares); err != nil {
+					return nil, err
+				}
+				if err = addVoterShares(delegator, validator, delegation.Shares); err != nil {
+					return nil, err
+				}
+			case validatorVoted:
+				// Delegator didn't vote, the validator votes on its behalf.
+				if err = addShares(voteShares, validatorVote, delegation.Shares); err != nil {
+					return nil, err
+				}
+				if err = addVoterShares(validator, validator, delegation.Shares); err != nil {
+					return nil, err
+				}
+			default:
+				// Neither the delegator nor the validator voted.
+			}
+		}
+	}
+
+	for _, vote := range votes {
+		if !hasValidatorDelegation[vote.Voter] {
+			tally.invalidVotes++
+		}
+	}
+
+	return tally, nil
+}
+
+// tallyVotesLegacy tallies the votes using the outgoing delegations of each of the voters.
+//
+// This is used when delegated voting is disabled and must not be changed as it affects the
+// results of proposals.
+func tallyVotesLegacy(
+	ctx context.Context,
+	stakingState *stakingState.ImmutableState,
+	validatorEntitiesPool map[stakingAPI.Address]*stakingAPI.SharePool,
+	votes []*governance.VoteEntry,
+) (*voteTally, error) {
+	tally := &voteTally{
+		shares: make(map[stakingAPI.Address]map[governance.Vote]quantity.Quantity),
+	}
 	validatorVotes := make(map[stakingAPI.Address]*governance.Vote)
-	validatorVoteShares := make(map[stakingAPI.Address]map[governance.Vote]quantity.Quantity)
 	for validator := range validatorEntitiesPool {
-		validatorVoteShares[validator] = make(map[governance.Vote]quantity.Quantity)
+		tally.shares[validator] = make(map[governance.Vote]quantity.Quantity)
 	}
 
 	// Tally the validator votes.
@@ -393,8 +585,8 @@ func (app *governanceApplication) closeProposal(
 			continue
 		}
 		validatorVotes[vote.Voter] = &vote.Vote
-		if err = addShares(validatorVoteShares[vote.Voter], vote.Vote, escrow.TotalShares); err != nil {
-			return fmt.Errorf("failed to add shares: %w", err)
+		if err := addShares(tally.shares[vote.Voter], vote.Vote, escrow.TotalShares); err != nil {
+			return nil, fmt.Errorf("failed to add shares: %w", err)
 		}
 	}
 
@@ -403,11 +595,7 @@ func (app *governanceApplication) closeProposal(
 		// Fetch outgoing delegations.
 		delegations, err := stakingState.DelegationsFor(ctx, vote.Voter)
 		if err != nil {
-			ctx.Logger().Error("failed to fetch delegations for",
-				"delegator", vote.Voter,
-				"err", err,
-			)
-			return fmt.Errorf("failed to fetch delegations: %w", err)
+			return nil, fmt.Errorf("failed to fetch delegations: %w", err)
 		}
 		var delegationToValidator bool
 		for to, delegation := range delegations {
@@ -423,62 +611,44 @@ func (app *governanceApplication) closeProposal(
 
 			// Deduct shares from the validators shares.
 			if validatorVote != nil {
-				if err := subShares(validatorVoteShares[to], *validatorVote, delegation.Shares); err != nil {
-					return fmt.Errorf("failed to sub votes: %w", err)
+				if err := subShares(tally.shares[to], *validatorVote, delegation.Shares); err != nil {
+					return nil, fmt.Errorf("failed to sub votes: %w", err)
 				}
 			}
 
 			// Add shares to the voters vote.
-			if err := addShares(validatorVoteShares[to], vote.Vote, delegation.Shares); err != nil {
-				return fmt.Errorf("failed to add votes: %w", err)
+			if err := addShares(tally.shares[to], vote.Vote, delegation.Shares); err != nil {
+				return nil, fmt.Errorf("failed to add votes: %w", err)
 			}
 		}
 		if !delegationToValidator {
-			proposal.InvalidVotes++
+			tally.invalidVotes++
 		}
 	}
 
-	// Finalize the voting results - convert votes in shares into results in stake.
-	proposal.Results = make(map[governance.Vote]quantity.Quantity)
-	for validator, votes := range validatorVoteShares {
+	return tally, nil
+}
+
+// votingPower returns the effective voting power of the given voter.
+func (t *voteTally) votingPower(
+	voter stakingAPI.Address,
+	validatorEntitiesPool map[stakingAPI.Address]*stakingAPI.SharePool,
+) (*quantity.Quantity, error) {
+	power := quantity.NewQuantity()
+	for validator, shares := range t.voterShares[voter] {
 		validatorPool, ok := validatorEntitiesPool[validator]
 		if !ok {
-			// This should NEVER happen.
-			panic("governance: missing validator pool")
+			return nil, fmt.Errorf("missing validator pool")
 		}
-		for vote, shares := range votes {
-			// Compute stake from shares.
-			escrow, err := validatorPool.StakeForShares(shares.Clone())
-			if err != nil {
-				ctx.Logger().Error("failed to compute stake from shares for",
-					"share_pool", validatorPool,
-					"validator", validator,
-					"err", err,
-				)
-				return fmt.Errorf("failed to compute stake from shares: %w", err)
-
-			}
-
-			// Add stake to vote.
-			currentVotes := proposal.Results[vote]
-			if err := currentVotes.Add(escrow); err != nil {
-				return fmt.Errorf("failed to add votes: %w", err)
-			}
-			proposal.Results[vote] = currentVotes
+		stake, err := validatorPool.StakeForShares(shares.Clone())
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute stake from shares: %w", err)
+		}
+		if err = power.Add(stake); err != nil {
+			return nil, fmt.Errorf("failed to add voting power: %w", err)
 		}
 	}
-
-	ctx.Logger().Debug("close proposal",
-		"total_voting_state", totalVotingStake,
-		"results", proposal.Results,
-		"invalid_votes", proposal.InvalidVotes,
-		"stake_threshold", params.StakeThreshold,
-	)
-	if err := proposal.CloseProposal(totalVotingStake, params.StakeThreshold); err != nil {
-		return err
-	}
-
-	return nil
+	return power, nil
 }
 
 func addShares(validatorVoteShares map[governance.Vote]quantity.Quantity, vote governance.Vote, amount quantity.Quantity) error {
@@ -11,7 +11,9 @@ import (
 	"github.com/tendermint/tendermint/abci/types"
 
 	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common"
 	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
 	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
 	memorySigner "github.com/oasisprotocol/oasis-core/go/common/crypto/signature/signers/memory"
 	"github.com/oasisprotocol/oasis-core/go/common/entity"
@@ -20,6 +22,7 @@ import (
 	"github.com/oasisprotocol/oasis-core/go/common/version"
 	abciAPI "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
 	governanceState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/governance/state"
+	registryapp "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/registry"
 	registryState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/registry/state"
 	schedulerState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/scheduler/state"
 	stakingState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/staking/state"
@@ -451,6 +454,71 @@ func TestCloseProposal(t *testing.T) {
 	}
 }
 
+func TestTallyVotes(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Unix(1580461674, 0)
+	appState := abciAPI.NewMockApplicationState(&abciAPI.MockApplicationStateConfig{})
+	ctx := appState.NewContext(abciAPI.ContextEndBlock, now)
+	defer ctx.Close()
+
+	stakingState := stakingState.NewMutableState(ctx.State())
+	addr1 := staking.NewAddress(signature.NewPublicKey("aaafffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"))
+	addr2 := staking.NewAddress(signature.NewPublicKey("bbbfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"))
+	addr3 := staking.NewAddress(signature.NewPublicKey("cccfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"))
+	addr4 := staking.NewAddress(signature.NewPublicKey("dddfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"))
+
+	// Validators (addr1, addr3).
+	require.NoError(stakingState.SetDelegation(ctx, addr1, addr1, &staking.Delegation{Shares: *quantity.NewFromUint64(60)}))
+	require.NoError(stakingState.SetDelegation(ctx, addr3, addr3, &staking.Delegation{Shares: *quantity.NewFromUint64(50)}))
+	// Delegator (addr2).
+	require.NoError(stakingState.SetDelegation(ctx, addr2, addr1, &staking.Delegation{Shares: *quantity.NewFromUint64(40)}))
+	require.NoError(stakingState.SetDelegation(ctx, addr2, addr3, &staking.Delegation{Shares: *quantity.NewFromUint64(10)}))
+
+	validatorEntitiesEscrow := map[staking.Address]*staking.SharePool{
+		addr1: {
+			Balance:     *quantity.NewFromUint64(200),
+			TotalShares: *quantity.NewFromUint64(100),
+		},
+		addr3: {
+			Balance:     *quantity.NewFromUint64(60),
+			TotalShares: *quantity.NewFromUint64(60),
+		},
+	}
+
+	votes := []*governance.VoteEntry{
+		{Voter: addr1, Vote: governance.VoteYes},
+		{Voter: addr2, Vote: governance.VoteNo},
+		{Voter: addr4, Vote: governance.VoteNo},
+	}
+	tally, err := tallyVotes(ctx, stakingState.ImmutableState, validatorEntitiesEscrow, votes)
+	require.NoError(err, "tallyVotes")
+	require.EqualValues(1, tally.invalidVotes, "addr4 vote should be invalid")
+	require.EqualValues(map[staking.Address]map[governance.Vote]quantity.Quantity{
+		addr1: {
+			governance.VoteYes: *quantity.NewFromUint64(60),
+			governance.VoteNo:  *quantity.NewFromUint64(40),
+		},
+		addr3: {
+			governance.VoteNo: *quantity.NewFromUint64(10),
+		},
+	}, tally.shares, "tallied shares should match")
+
+	for _, tc := range []struct {
+		voter         staking.Address
+		expectedPower uint64
+	}{
+		{addr1, 120},     // 60 shares of addr1.
+		{addr2, 80 + 10}, // 40 shares of addr1 + 10 shares of addr3.
+		{addr3, 0},       // Didn't vote.
+		{addr4, 0},       // No delegations to validators.
+	} {
+		power, err := tally.votingPower(tc.voter, validatorEntitiesEscrow)
+		require.NoError(err, "votingPower")
+		require.EqualValues(quantity.NewFromUint64(tc.expectedPower), power, "voting power should match")
+	}
+}
+
 func TestExecuteProposal(t *testing.T) {
 	require := require.New(t)
 	var err error
@@ -604,6 +672,19 @@ func TestExecuteProposal(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"executing signaling proposal should work",
+			&governance.Proposal{
+				ID: 13,
+				Content: governance.ProposalContent{
+					Signaling: &governance.SignalingProposal{
+						Title:       "roadmap",
+						ContentHash: hash.NewFromBytes([]byte("roadmap")),
+					},
+				},
+			},
+			nil,
+		},
 	} {
 		err = app.executeProposal(ctx, state, tc.proposal)
 		if tc.err != nil {
@@ -624,6 +705,206 @@ func TestExecuteProposal(t *testing.T) {
 	}
 }
 
+// testMsgDispatcher dispatches messages to subscribed applications. Messages of kinds without
+// any subscribers are ignored.
+type testMsgDispatcher struct {
+	subscriptions map[interface{}][]abciAPI.MessageSubscriber
+}
+
+// Implements MessageDispatcher.
+func (md *testMsgDispatcher) Subscribe(kind interface{}, ms abciAPI.MessageSubscriber) {
+	if md.subscriptions == nil {
+		md.subscriptions = make(map[interface{}][]abciAPI.MessageSubscriber)
+	}
+	md.subscriptions[kind] = append(md.subscriptions[kind], ms)
+}
+
+// Implements MessageDispatcher.
+func (md *testMsgDispatcher) Publish(ctx *abciAPI.Context, kind, msg interface{}) (interface{}, error) {
+	var result interface{}
+	for _, ms := range md.subscriptions[kind] {
+		resp, err := ms.ExecuteMessage(ctx, kind, msg)
+		if err != nil {
+			return nil, err
+		}
+		if resp != nil {
+			result = resp
+		}
+	}
+	return result, nil
+}
+
+func TestExecuteUpdateRuntimeProposal(t *testing.T) {
+	require := require.New(t)
+	var err error
+
+	now := time.Unix(1580461674, 0)
+	appState := abciAPI.NewMockApplicationState(&abciAPI.MockApplicationStateConfig{
+		CurrentEpoch: 10,
+	})
+	ctx := appState.NewContext(abciAPI.ContextEndBlock, now)
+	defer ctx.Close()
+
+	// Update runtime proposals are applied by the registry application.
+	var md testMsgDispatcher
+	registryapp.New().OnRegister(appState, &md)
+	app := &governanceApplication{
+		state: appState,
+		md:    &md,
+	}
+
+	// Setup governance state.
+	state := governanceState.NewMutableState(ctx.State())
+	err = state.SetConsensusParameters(ctx, &governance.ConsensusParameters{
+		MinProposalDeposit:          *quantity.NewFromUint64(100),
+		StakeThreshold:              90,
+		EnableUpdateRuntimeProposal: true,
+	})
+	require.NoError(err, "setting governance consensus parameters should not error")
+
+	// Setup registry state.
+	regState := registryState.NewMutableState(ctx.State())
+	regParams := &registry.ConsensusParameters{
+		DebugAllowTestRuntimes: true,
+		EnableRuntimeGovernanceModels: map[registry.RuntimeGovernanceModel]bool{
+			registry.GovernanceConsensus: true,
+		},
+	}
+	err = regState.SetConsensusParameters(ctx, regParams)
+	require.NoError(err, "setting registry consensus parameters should not error")
+
+	kmRuntime := &registry.Runtime{
+		Versioned: cbor.NewVersioned(registry.LatestRuntimeDescriptorVersion),
+		ID:        common.NewTestNamespaceFromSeed([]byte("governance update runtime km"), common.NamespaceKeyManager),
+		Kind:      registry.KindKeyManager,
+		AdmissionPolicy: registry.RuntimeAdmissionPolicy{
+			AnyNode: &registry.AnyNodeRuntimeAdmissionPolicy{},
+		},
+		Deployments:     []*registry.VersionInfo{{}},
+		GovernanceModel: registry.GovernanceConsensus,
+	}
+	err = regState.SetRuntime(ctx, kmRuntime, false)
+	require.NoError(err, "SetRuntime")
+
+	computeRuntime := &registry.Runtime{
+		Versioned:  cbor.NewVersioned(registry.LatestRuntimeDescriptorVersion),
+		ID:         common.NewTestNamespaceFromSeed([]byte("governance update runtime compute"), 0),
+		Kind:       registry.KindCompute,
+		KeyManager: &kmRuntime.ID,
+		Executor: registry.ExecutorParameters{
+			GroupSize:    1,
+			RoundTimeout: 20,
+		},
+		TxnScheduler: registry.TxnSchedulerParameters{
+			BatchFlushTimeout: 1 * time.Second,
+			MaxBatchSize:      1,
+			MaxBatchSizeBytes: 1024,
+			ProposerTimeout:   20,
+		},
+		AdmissionPolicy: registry.RuntimeAdmissionPolicy{
+			AnyNode: &registry.AnyNodeRuntimeAdmissionPolicy{},
+		},
+		Deployments:     []*registry.VersionInfo{{}},
+		GovernanceModel: registry.GovernanceConsensus,
+	}
+	err = regState.SetRuntime(ctx, computeRuntime, true)
+	require.NoError(err, "SetRuntime")
+
+	updatedKmRuntime := *kmRuntime
+	updatedKmRuntime.Staking = registry.RuntimeStakingParameters{
+		Thresholds: map[staking.ThresholdKind]quantity.Quantity{
+			staking.KindNodeKeyManager: *quantity.NewFromUint64(1000),
+		},
+	}
+	updatedComputeRuntime := *computeRuntime
+	updatedComputeRuntime.Executor.RoundTimeout = 40
+	entityRuntime := *computeRuntime
+	entityRuntime.ID = common.NewTestNamespaceFromSeed([]byte("governance update runtime entity"), 0)
+	entityRuntime.GovernanceModel = registry.GovernanceEntity
+	err = regState.SetRuntime(ctx, &entityRuntime, false)
+	require.NoError(err, "SetRuntime")
+	regParams.EnableRuntimeGovernanceModels[registry.GovernanceEntity] = true
+
+	for _, tc := range []struct {
+		msg                string
+		disableRuntimes    bool
+		disableKeyManagers bool
+		runtime            *registry.Runtime
+		err                error
+	}{
+		{
+			"executing update runtime proposal for a runtime not governed by consensus should fail",
+			false,
+			false,
+			&entityRuntime,
+			registry.ErrForbidden,
+		},
+		{
+			"executing update runtime proposal for a key manager runtime should fail when key manager registration is disabled",
+			false,
+			true,
+			&updatedKmRuntime,
+			registry.ErrForbidden,
+		},
+		{
+			"executing update runtime proposal for a compute runtime should fail when runtime registration is disabled",
+			true,
+			false,
+			&updatedComputeRuntime,
+			registry.ErrForbidden,
+		},
+		{
+			"executing update runtime proposal for a key manager runtime should work",
+			false,
+			false,
+			&updatedKmRuntime,
+			nil,
+		},
+		{
+			"executing update runtime proposal for a compute runtime should work when only key manager registration is disabled",
+			false,
+			true,
+			&updatedComputeRuntime,
+			nil,
+		},
+	} {
+		regParams.DisableRuntimeRegistration = tc.disableRuntimes
+		regParams.DisableKeyManagerRuntimeRegistration = tc.disableKeyManagers
+		err = regState.SetConsensusParameters(ctx, regParams)
+		require.NoError(err, "setting registry consensus parameters should not error")
+
+		proposal := &governance.Proposal{
+			ID: 1,
+			Content: governance.ProposalContent{
+				UpdateRuntime: &governance.UpdateRuntimeProposal{Runtime: *tc.runtime},
+			},
+		}
+		err = app.executeProposal(ctx, state, proposal)
+		if tc.err != nil {
+			// Expected proposal to fail.
+			require.Equal(governance.StateFailed, proposal.State, tc.msg)
+			require.True(errors.Is(err, tc.err),
+				fmt.Sprintf("expected error: %v, got: %v, for: %s", tc.err, err, tc.msg))
+
+			continue
+		}
+		// Expected proposal to pass.
+		require.NoError(err, tc.msg)
+		require.Equal(governance.StatePassed, proposal.State, tc.msg)
+	}
+
+	// Runtime descriptors should be updated.
+	rt, err := regState.Runtime(ctx, kmRuntime.ID)
+	require.NoError(err, "Runtime")
+	require.EqualValues(&updatedKmRuntime, rt, "key manager runtime should be updated")
+
+	_, err = regState.Runtime(ctx, computeRuntime.ID)
+	require.Equal(registry.ErrNoSuchRuntime, err, "compute runtime should remain suspended")
+	rt, err = regState.SuspendedRuntime(ctx, computeRuntime.ID)
+	require.NoError(err, "SuspendedRuntime")
+	require.EqualValues(&updatedComputeRuntime, rt, "compute runtime should be updated")
+}
+
 func TestBeginBlock(t *testing.T) {
 	require := require.New(t)
 	var err error
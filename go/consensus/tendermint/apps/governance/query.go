@@ -2,9 +2,16 @@ package governance
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	consensus "github.com/oasisprotocol/oasis-core/go/consensus/api"
 	abciAPI "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
+	beaconState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/beacon/state"
 	governanceState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/governance/state"
+	schedulerState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/scheduler/state"
+	stakingState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/staking/state"
 	governance "github.com/oasisprotocol/oasis-core/go/governance/api"
 	upgrade "github.com/oasisprotocol/oasis-core/go/upgrade/api"
 )
@@ -31,10 +38,18 @@ func (qf *QueryFactory) QueryAt(ctx context.Context, height int64) (Query, error
 	if err != nil {
 		return nil, err
 	}
-	return &governanceQuerier{state}, nil
+
+	return &governanceQuerier{
+		queryState: qf.state,
+		height:     height,
+		state:      state,
+	}, nil
 }
 
 type governanceQuerier struct {
+	queryState abciAPI.ApplicationQueryState
+	height     int64
+
 	state *governanceState.ImmutableState
 }
 
@@ -51,7 +66,99 @@ func (gq *governanceQuerier) Proposal(ctx context.Context, id uint64) (*governan
 }
 
 func (gq *governanceQuerier) Votes(ctx context.Context, id uint64) ([]*governance.VoteEntry, error) {
-	return gq.state.Votes(ctx, id)
+	votes, err := gq.state.Votes(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(votes) == 0 {
+		return votes, nil
+	}
+
+	// Compute the voting power using the validator set and delegations at the height at which
+	// the proposal has been closed (or the query height for active proposals) as those are the
+	// ones used when tallying the votes.
+	proposal, err := gq.state.Proposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	height := gq.height
+	if proposal.State != governance.StateActive {
+		height, err = gq.closingHeight(ctx, proposal.ClosesAt)
+		switch {
+		case err == nil:
+		case errors.Is(err, consensus.ErrVersionNotFound):
+			// State at the closing height has been pruned, voting power is not available.
+			return votes, nil
+		default:
+			return nil, err
+		}
+	}
+
+	state, err := governanceState.NewImmutableState(ctx, gq.queryState, height)
+	switch {
+	case err == nil:
+	case errors.Is(err, consensus.ErrVersionNotFound):
+		return votes, nil
+	default:
+		return nil, err
+	}
+	params, err := state.ConsensusParameters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.EnableDelegatedVoting {
+		// Voting power is only exposed when votes are tallied using delegations.
+		return votes, nil
+	}
+
+	stakingState, err := stakingState.NewImmutableState(ctx, gq.queryState, height)
+	if err != nil {
+		return nil, err
+	}
+	schedulerState, err := schedulerState.NewImmutableState(ctx, gq.queryState, height)
+	if err != nil {
+		return nil, err
+	}
+	_, validatorEntitiesEscrow, err := validatorsEscrow(ctx, stakingState, schedulerState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute validators escrow: %w", err)
+	}
+	tally, err := tallyVotes(ctx, stakingState, validatorEntitiesEscrow, votes)
+	if err != nil {
+		return nil, err
+	}
+	for _, vote := range votes {
+		if vote.VotingPower, err = tally.votingPower(vote.Voter, validatorEntitiesEscrow); err != nil {
+			return nil, err
+		}
+	}
+	return votes, nil
+}
+
+// closingHeight returns the height at which proposals closing at the given epoch have been closed.
+func (gq *governanceQuerier) closingHeight(ctx context.Context, epoch beacon.EpochTime) (int64, error) {
+	height := gq.height
+	for {
+		state, err := beaconState.NewImmutableState(ctx, gq.queryState, height)
+		if err != nil {
+			return 0, err
+		}
+		current, epochHeight, err := state.GetEpoch(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		switch {
+		case current == epoch:
+			// Proposals are closed at the epoch transition.
+			return epochHeight, nil
+		case current < epoch, epochHeight <= 1:
+			return 0, fmt.Errorf("failed to find height of epoch %d", epoch)
+		}
+
+		// Continue with the previous epoch.
+		height = epochHeight - 1
+	}
 }
 
 func (gq *governanceQuerier) PendingUpgrades(ctx context.Context) ([]*upgrade.Descriptor, error) {
@@ -0,0 +1,119 @@
+package governance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
+	abciAPI "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
+	beaconState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/beacon/state"
+	governanceState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/governance/state"
+	registryState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/registry/state"
+	schedulerState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/scheduler/state"
+	stakingState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/staking/state"
+	governance "github.com/oasisprotocol/oasis-core/go/governance/api"
+	storage "github.com/oasisprotocol/oasis-core/go/storage/api"
+	nodedb "github.com/oasisprotocol/oasis-core/go/storage/mkvs/db/api"
+	badgerDb "github.com/oasisprotocol/oasis-core/go/storage/mkvs/db/badger"
+)
+
+// prunedQueryState is an application query state where all past states have been pruned.
+type prunedQueryState struct {
+	abciAPI.MockApplicationState
+
+	storage storage.LocalBackend
+}
+
+func (qs *prunedQueryState) Storage() storage.LocalBackend {
+	return qs.storage
+}
+
+type prunedStorage struct {
+	storage.LocalBackend
+
+	ndb nodedb.NodeDB
+}
+
+func (s *prunedStorage) NodeDB() nodedb.NodeDB {
+	return s.ndb
+}
+
+func TestVotes(t *testing.T) {
+	require := require.New(t)
+	var err error
+
+	now := time.Unix(1580461674, 0)
+	appState := abciAPI.NewMockApplicationState(&abciAPI.MockApplicationStateConfig{
+		BlockHeight:  10,
+		CurrentEpoch: 5,
+	})
+	ctx := appState.NewContext(abciAPI.ContextEndBlock, now)
+	defer ctx.Close()
+
+	ndb, err := badgerDb.New(&nodedb.Config{
+		Namespace:    common.NewTestNamespaceFromSeed([]byte("governance query test ns"), 0),
+		MaxCacheSize: 16 * 1024 * 1024,
+		NoFsync:      true,
+		MemoryOnly:   true,
+	})
+	require.NoError(err, "badgerDb.New")
+	defer ndb.Close()
+	qs := &prunedQueryState{
+		MockApplicationState: appState,
+		storage:              &prunedStorage{ndb: ndb},
+	}
+
+	// Setup state.
+	err = beaconState.NewMutableState(ctx.State()).SetEpoch(ctx, 5, 8)
+	require.NoError(err, "SetEpoch")
+	registryState := registryState.NewMutableState(ctx.State())
+	stakeState := stakingState.NewMutableState(ctx.State())
+	schedulerState := schedulerState.NewMutableState(ctx.State())
+	_, addresses, _ := initValidatorsEscrowState(t, stakeState, registryState, schedulerState)
+
+	state := governanceState.NewMutableState(ctx.State())
+	err = state.SetConsensusParameters(ctx, &governance.ConsensusParameters{
+		GasCosts:                  governance.DefaultGasCosts,
+		MinProposalDeposit:        *quantity.NewFromUint64(100),
+		StakeThreshold:            90,
+		UpgradeCancelMinEpochDiff: beacon.EpochTime(100),
+		UpgradeMinEpochDiff:       beacon.EpochTime(100),
+		VotingPeriod:              beacon.EpochTime(50),
+		EnableDelegatedVoting:     true,
+	})
+	require.NoError(err, "SetConsensusParameters")
+
+	active := &governance.Proposal{ID: 1, State: governance.StateActive, ClosesAt: 10}
+	err = state.SetActiveProposal(ctx, active)
+	require.NoError(err, "SetActiveProposal")
+	closed := &governance.Proposal{ID: 2, State: governance.StatePassed, ClosesAt: 3}
+	err = state.SetProposal(ctx, closed)
+	require.NoError(err, "SetProposal")
+	for _, id := range []uint64{active.ID, closed.ID} {
+		err = state.SetVote(ctx, id, addresses[0], governance.VoteYes)
+		require.NoError(err, "SetVote")
+	}
+
+	// The current (future) height is served from the ABCI context state.
+	q, err := NewQueryFactory(qs).QueryAt(ctx, ctx.BlockHeight()+1)
+	require.NoError(err, "QueryAt")
+
+	// Voting power of votes for active proposals is computed at the query height.
+	votes, err := q.Votes(ctx, active.ID)
+	require.NoError(err, "Votes")
+	require.Len(votes, 1, "there should be one vote")
+	require.NotNil(votes[0].VotingPower, "voting power should be computed for active proposals")
+	require.False(votes[0].VotingPower.IsZero(), "voting power should not be zero")
+
+	// The state at the closing height of the closed proposal has been pruned.
+	votes, err = q.Votes(ctx, closed.ID)
+	require.NoError(err, "Votes should not fail when the closing height state has been pruned")
+	require.Len(votes, 1, "there should be one vote")
+	require.Equal(addresses[0], votes[0].Voter, "vote voter")
+	require.Equal(governance.VoteYes, votes[0].Vote, "vote")
+	require.Nil(votes[0].VotingPower, "voting power should not be available")
+}
@@ -1,9 +1,12 @@
 package governance
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	"github.com/oasisprotocol/oasis-core/go/common/entity"
 	"github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
 	governanceApi "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/governance/api"
 	governanceState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/governance/state"
@@ -48,6 +51,10 @@ func (app *governanceApplication) submitProposal(
 	if proposalContent.ChangeParameters != nil && !params.EnableChangeParametersProposal {
 		return nil, governance.ErrInvalidArgument
 	}
+	// The same holds for update runtime proposals.
+	if proposalContent.UpdateRuntime != nil && !params.EnableUpdateRuntimeProposal {
+		return nil, governance.ErrInvalidArgument
+	}
 
 	// Charge gas for this transaction.
 	if err = ctx.Gas().UseGas(1, governance.GasOpSubmitProposal, params.GasCosts); err != nil {
@@ -156,6 +163,22 @@ func (app *governanceApplication) submitProposal(
 			ctx.Logger().Debug("governance: no module interested in change parameters proposal")
 			return nil, governance.ErrInvalidArgument
 		}
+	case proposalContent.UpdateRuntime != nil:
+		// Notify the registry application to validate the runtime descriptor update.
+		var res interface{}
+		res, err = app.md.Publish(ctx, governanceApi.MessageValidateRuntimeUpdate, proposalContent.UpdateRuntime)
+		if err != nil {
+			ctx.Logger().Debug("governance: failed to dispatch validate runtime update message",
+				"err", err,
+			)
+			return nil, err
+		}
+		if res == nil {
+			ctx.Logger().Debug("governance: no module interested in update runtime proposal")
+			return nil, governance.ErrInvalidArgument
+		}
+	case proposalContent.Signaling != nil:
+		// Signaling proposals have no execution effect so there is nothing else to validate.
 	default:
 		return nil, governance.ErrInvalidArgument
 	}
@@ -239,20 +262,25 @@ func (app *governanceApplication) castVote(
 		return nil
 	}
 
-	submitterAddr := stakingAPI.NewAddress(ctx.TxSigner())
+	submitterAddr := ctx.CallerAddress()
 	if !submitterAddr.IsValid() {
 		return stakingAPI.ErrForbidden
 	}
 
-	// Query signer entity descriptor.
-	registryState := registryState.NewMutableState(ctx.State())
-	submitterEntity, err := registryState.Entity(ctx, ctx.TxSigner())
-	switch err {
-	case nil:
-	case registryAPI.ErrNoSuchEntity:
-		return governance.ErrNotEligible
-	default:
-		return fmt.Errorf("governance: failed to query entity: %w", err)
+	// Query signer entity descriptor in case the caller is a single-signer account. Multisig
+	// accounts have no single signer and can therefore never have a registered entity, so they
+	// are only eligible as delegators.
+	var submitterEntity *entity.Entity
+	if txSigner := ctx.TxSigner(); stakingAPI.NewAddress(txSigner).Equal(submitterAddr) {
+		registryState := registryState.NewMutableState(ctx.State())
+		submitterEntity, err = registryState.Entity(ctx, txSigner)
+		switch err {
+		case nil:
+		case registryAPI.ErrNoSuchEntity:
+			return governance.ErrNotEligible
+		default:
+			return fmt.Errorf("governance: failed to query entity: %w", err)
+		}
 	}
 
 	// Load current validator sets.
@@ -267,15 +295,18 @@ func (app *governanceApplication) castVote(
 	}
 
 	// Submitter is eligible if any of its nodes are a current validator.
-	var eligible bool
-	for _, nID := range submitterEntity.Nodes {
-		if _, ok := currentValidatorsByNodeID[nID]; ok {
-			eligible = true
-			break
+	var isValidator bool
+	if submitterEntity != nil {
+		for _, nID := range submitterEntity.Nodes {
+			if _, ok := currentValidatorsByNodeID[nID]; ok {
+				isValidator = true
+				break
+			}
 		}
 	}
 	// Or if the submitter is a delegator to a current validator.
-	if !eligible {
+	var delegatedValidators []stakingAPI.Address
+	if !isValidator {
 		// Validators map by entity address.
 		currentValidatorsByEntityAddress := make(map[stakingAPI.Address]*schedulerAPI.Validator, len(currentValidators))
 		for _, v := range currentValidators {
@@ -291,15 +322,18 @@ func (app *governanceApplication) castVote(
 		// Check if submitter delegates to any validator entity.
 		for d := range delegs {
 			if _, ok := currentValidatorsByEntityAddress[d]; ok {
-				eligible = true
-				break
+				delegatedValidators = append(delegatedValidators, d)
 			}
 		}
+		sort.Slice(delegatedValidators, func(i, j int) bool {
+			return bytes.Compare(delegatedValidators[i][:], delegatedValidators[j][:]) < 0
+		})
 	}
+	eligible := isValidator || len(delegatedValidators) > 0
 
 	if !eligible {
 		ctx.Logger().Debug("governance: submitter not eligible to vote",
-			"submitter", ctx.TxSigner(),
+			"submitter", submitterAddr,
 		)
 		return governance.ErrNotEligible
 	}
@@ -336,11 +370,20 @@ func (app *governanceApplication) castVote(
 	}
 
 	// Emit event.
-	ctx.EmitEvent(api.NewEventBuilder(app.Name()).TypedAttribute(&governance.VoteEvent{
-		ID:        proposal.ID,
-		Submitter: submitterAddr,
-		Vote:      proposalVote.Vote,
-	}))
+	if isValidator || !params.EnableDelegatedVoting {
+		ctx.EmitEvent(api.NewEventBuilder(app.Name()).TypedAttribute(&governance.VoteEvent{
+			ID:        proposal.ID,
+			Submitter: submitterAddr,
+			Vote:      proposalVote.Vote,
+		}))
+	} else {
+		ctx.EmitEvent(api.NewEventBuilder(app.Name()).TypedAttribute(&governance.DelegatorVoteEvent{
+			ID:         proposal.ID,
+			Submitter:  submitterAddr,
+			Vote:       proposalVote.Vote,
+			Validators: delegatedValidators,
+		}))
+	}
 
 	return nil
 }
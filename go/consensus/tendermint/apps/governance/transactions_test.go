@@ -8,8 +8,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
 	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	memorySigner "github.com/oasisprotocol/oasis-core/go/common/crypto/signature/signers/memory"
 	"github.com/oasisprotocol/oasis-core/go/common/quantity"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/events"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
 	abciAPI "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
 	governanceState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/governance/state"
 	registryState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/registry/state"
@@ -225,6 +229,32 @@ func TestSubmitProposal(t *testing.T) {
 			},
 			governance.ErrUpgradeAlreadyPending,
 		},
+		{
+			"should fail with invalid signaling proposal",
+			baseConsParams,
+			pk1,
+			&governance.ProposalContent{
+				Signaling: &governance.SignalingProposal{
+					Title: "roadmap",
+				},
+			},
+			func() {},
+			governance.ErrInvalidArgument,
+		},
+		{
+			"should work with valid signaling proposal",
+			baseConsParams,
+			pk1,
+			&governance.ProposalContent{
+				Signaling: &governance.SignalingProposal{
+					Title:       "roadmap",
+					ContentHash: hash.NewFromBytes([]byte("roadmap")),
+					URI:         "https://example.com/roadmap.md",
+				},
+			},
+			func() {},
+			nil,
+		},
 	} {
 		err = state.SetConsensusParameters(ctx, tc.params)
 		require.NoError(err, "setting governance consensus parameters should not error")
@@ -452,4 +482,130 @@ func TestCastVote(t *testing.T) {
 
 		tc.check()
 	}
+
+	// Delegators without a registered entity should not be eligible.
+	unregisteredSigner := memorySigner.NewTestSigner("consensus/tendermint/apps/governance: unregistered delegator")
+	err = stakeState.SetDelegation(ctx, staking.NewAddress(unregisteredSigner.Public()), addresses[0], &staking.Delegation{
+		Shares: *quantity.NewFromUint64(100),
+	})
+	require.NoError(err, "SetDelegation")
+	txCtx := appState.NewContext(abciAPI.ContextDeliverTx, now)
+	defer txCtx.Close()
+	txCtx.SetTxSigner(unregisteredSigner.Public())
+	err = app.castVote(txCtx, state, &governance.ProposalVote{ID: p1.ID, Vote: governance.VoteYes})
+	require.Equal(governance.ErrNotEligible, err, "delegator without a registered entity should not be eligible")
+
+	castDelegatorVote := func() (string, []byte) {
+		txCtx := appState.NewContext(abciAPI.ContextDeliverTx, now)
+		defer txCtx.Close()
+		txCtx.SetTxSigner(signers[numValidators].Public())
+
+		err = app.castVote(txCtx, state, &governance.ProposalVote{ID: p1.ID, Vote: governance.VoteYes})
+		require.NoError(err, "castVote")
+
+		evs := txCtx.GetEvents()
+		require.Len(evs, 1, "casting a vote should emit a single event")
+		require.Len(evs[0].Attributes, 1, "event should have a single attribute")
+		return string(evs[0].Attributes[0].Key), evs[0].Attributes[0].Value
+	}
+
+	// Delegator votes should emit a vote event when delegated voting is disabled.
+	key, _ := castDelegatorVote()
+	require.Equal("vote", key, "delegator vote should emit a vote event when delegated voting is disabled")
+
+	// Delegator votes should emit a delegator vote event when delegated voting is enabled.
+	params.EnableDelegatedVoting = true
+	err = state.SetConsensusParameters(ctx, params)
+	require.NoError(err, "setting governance consensus parameters should not error")
+	key, value := castDelegatorVote()
+	require.Equal("delegator_vote", key, "delegator vote should emit a delegator vote event")
+	var ev governance.DelegatorVoteEvent
+	err = events.DecodeValue(string(value), &ev)
+	require.NoError(err, "malformed delegator vote event")
+	require.EqualValues(addresses[numValidators], ev.Submitter, "event submitter should match")
+	require.EqualValues([]staking.Address{addresses[0]}, ev.Validators, "event validators should match")
+}
+
+func TestCastVoteMultisig(t *testing.T) {
+	require := require.New(t)
+	var err error
+
+	signature.SetChainContext("test: oasis-core tests")
+
+	now := time.Unix(1580461674, 0)
+	appState := abciAPI.NewMockApplicationState(&abciAPI.MockApplicationStateConfig{})
+	ctx := appState.NewContext(abciAPI.ContextEndBlock, now)
+	defer ctx.Close()
+
+	// Setup state.
+	registryState := registryState.NewMutableState(ctx.State())
+	stakeState := stakingState.NewMutableState(ctx.State())
+	schedulerState := schedulerState.NewMutableState(ctx.State())
+	_, addresses, _ := initValidatorsEscrowState(t, stakeState, registryState, schedulerState)
+
+	// Setup governance state.
+	state := governanceState.NewMutableState(ctx.State())
+	app := &governanceApplication{
+		state: appState,
+	}
+	err = state.SetConsensusParameters(ctx, &governance.ConsensusParameters{
+		GasCosts:           governance.DefaultGasCosts,
+		MinProposalDeposit: *quantity.NewFromUint64(100),
+		StakeThreshold:     90,
+		VotingPeriod:       beacon.EpochTime(50),
+	})
+	require.NoError(err, "setting governance consensus parameters should not error")
+	p1 := &governance.Proposal{ID: 1, State: governance.StateActive}
+	err = state.SetActiveProposal(ctx, p1)
+	require.NoError(err, "SetActiveProposal")
+
+	// Prepare a 2-of-2 multisig account.
+	msSigners := []signature.Signer{
+		memorySigner.NewTestSigner("consensus/tendermint/apps/governance: multisig signer: 0"),
+		memorySigner.NewTestSigner("consensus/tendermint/apps/governance: multisig signer: 1"),
+	}
+	cfg := &transaction.MultisigConfig{
+		Signers:   []signature.PublicKey{msSigners[0].Public(), msSigners[1].Public()},
+		Threshold: 2,
+	}
+	msAddr := staking.NewMultisigAddress(cfg)
+
+	castVote := func() error {
+		msTx, txErr := transaction.NewMultiSignedTransaction(cfg, governance.NewCastVoteTx(0, nil, &governance.ProposalVote{
+			ID:   p1.ID,
+			Vote: governance.VoteYes,
+		}))
+		require.NoError(txErr, "NewMultiSignedTransaction")
+		for _, signer := range msSigners {
+			require.NoError(msTx.Sign(signer), "Sign")
+		}
+
+		var tx transaction.Transaction
+		require.NoError(msTx.Open(&tx), "Open")
+		require.True(tx.AllowsMultisig(), "casting votes should allow multisig authorization")
+
+		txCtx := appState.NewContext(abciAPI.ContextDeliverTx, now)
+		defer txCtx.Close()
+		txCtx.SetTxMultisigAccount(msAddr)
+
+		return app.ExecuteTx(txCtx, &tx)
+	}
+
+	// Multisig accounts which don't delegate to any validator should not be eligible.
+	err = castVote()
+	require.Equal(governance.ErrNotEligible, err, "multisig account without delegations should not be eligible")
+
+	// Multisig accounts delegating to a validator should be able to vote.
+	err = stakeState.SetDelegation(ctx, msAddr, addresses[0], &staking.Delegation{
+		Shares: *quantity.NewFromUint64(100),
+	})
+	require.NoError(err, "SetDelegation")
+	err = castVote()
+	require.NoError(err, "multisig delegator should be able to vote")
+
+	votes, err := state.Votes(ctx, p1.ID)
+	require.NoError(err, "Votes()")
+	require.Len(votes, 1, "one vote should exist")
+	require.EqualValues(msAddr, votes[0].Voter, "voter should be the multisig account")
+	require.EqualValues(governance.VoteYes, votes[0].Vote, "vote should match submitted vote")
 }
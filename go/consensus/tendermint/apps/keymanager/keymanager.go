@@ -76,6 +76,12 @@ func (app *keymanagerApplication) ExecuteTx(ctx *tmapi.Context, tx *transaction.
 			return api.ErrInvalidArgument
 		}
 		return app.updatePolicy(ctx, state, &sigPol)
+	case api.MethodPublishMasterSecret:
+		var sigSec api.SignedMasterSecret
+		if err := cbor.Unmarshal(tx.Body, &sigSec); err != nil {
+			return api.ErrInvalidArgument
+		}
+		return app.publishMasterSecret(ctx, state, &sigSec)
 	default:
 		return fmt.Errorf("keymanager: invalid method: %s", tx.Method)
 	}
@@ -164,13 +170,27 @@ func (app *keymanagerApplication) onEpochChange(ctx *tmapi.Context, epoch beacon
 			return fmt.Errorf("failed to query key manager status: %w", err)
 		}
 
-		newStatus := app.generateStatus(ctx, rt, oldStatus, nodes, params, epoch)
+		secret, err := state.MasterSecret(ctx, rt.ID)
+		switch err {
+		case nil:
+		case api.ErrNoSuchMasterSecret:
+			secret = nil
+		default:
+			ctx.Logger().Error("failed to query key manager master secret",
+				"id", rt.ID,
+				"err", err,
+			)
+			return fmt.Errorf("failed to query key manager master secret: %w", err)
+		}
+
+		newStatus := app.generateStatus(ctx, rt, oldStatus, secret, nodes, params, epoch)
 		if forceEmit || !bytes.Equal(cbor.Marshal(oldStatus), cbor.Marshal(newStatus)) {
 			ctx.Logger().Debug("status updated",
 				"id", newStatus.ID,
 				"is_initialized", newStatus.IsInitialized,
 				"is_secure", newStatus.IsSecure,
 				"checksum", hex.EncodeToString(newStatus.Checksum),
+				"generation", newStatus.Generation,
 				"nodes", newStatus.Nodes,
 			)
 
@@ -199,6 +219,7 @@ func (app *keymanagerApplication) generateStatus(
 	ctx *tmapi.Context,
 	kmrt *registry.Runtime,
 	oldStatus *api.Status,
+	secret *api.SignedMasterSecret,
 	nodes []*node.Node,
 	params *registry.ConsensusParameters,
 	epoch beacon.EpochTime,
@@ -208,9 +229,27 @@ func (app *keymanagerApplication) generateStatus(
 		IsInitialized: oldStatus.IsInitialized,
 		IsSecure:      oldStatus.IsSecure,
 		Checksum:      oldStatus.Checksum,
+		Generation:    oldStatus.Generation,
+		RotationEpoch: oldStatus.RotationEpoch,
+		Checksums:     oldStatus.Checksums,
 		Policy:        oldStatus.Policy,
 	}
 
+	// Backfill the per-generation checksums of key managers initialized before
+	// master secret rotation was supported.
+	if status.IsInitialized && len(status.Checksums) == 0 {
+		status.Checksums = [][]byte{status.Checksum}
+	}
+
+	// Checksum of the previous generation of the master secret, if any. Nodes that have not yet
+	// caught up with the latest generation are only tolerated for one rotation interval.
+	var previousChecksum []byte
+	if n := len(status.Checksums); n > 1 {
+		if nextRotationEpoch, ok := status.NextRotationEpoch(); ok && epoch < nextRotationEpoch {
+			previousChecksum = status.Checksums[n-2]
+		}
+	}
+
 	var rawPolicy []byte
 	if status.Policy != nil {
 		rawPolicy = cbor.Marshal(status.Policy)
@@ -292,7 +331,10 @@ func (app *keymanagerApplication) generateStatus(
 				)
 				continue
 			}
-			if !bytes.Equal(initResponse.Checksum, status.Checksum) {
+			// Nodes that have not yet caught up with the latest generation of the master secret
+			// remain members of the committee.
+			isLagging := previousChecksum != nil && bytes.Equal(initResponse.Checksum, previousChecksum)
+			if !bytes.Equal(initResponse.Checksum, status.Checksum) && !isLagging {
 				ctx.Logger().Error("Checksum mismatch for runtime",
 					"id", kmrt.ID,
 					"node_id", n.ID,
@@ -315,11 +357,23 @@ func (app *keymanagerApplication) generateStatus(
 			status.IsSecure = initResponse.IsSecure
 			status.IsInitialized = true
 			status.Checksum = initResponse.Checksum
+			status.Checksums = [][]byte{initResponse.Checksum}
 		}
 
 		status.Nodes = append(status.Nodes, n.ID)
 	}
 
+	// Rotate the master secret in the epoch for which the proposal was published. The proposal
+	// has been signed by the enclave of a committee member, so at least one node holds the new
+	// generation. Nodes that have not caught up yet remain members of the committee for one
+	// rotation interval as they can still serve keys derived from the previous generation.
+	if secret != nil && secret.Secret.Epoch == epoch && secret.Secret.Generation == status.Generation+1 {
+		status.Generation = secret.Secret.Generation
+		status.RotationEpoch = epoch
+		status.Checksum = secret.Secret.Checksum
+		status.Checksums = append(append([][]byte{}, status.Checksums...), secret.Secret.Checksum)
+	}
+
 	return status
 }
 
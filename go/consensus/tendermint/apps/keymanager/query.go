@@ -13,6 +13,7 @@ import (
 type Query interface {
 	Status(context.Context, common.Namespace) (*keymanager.Status, error)
 	Statuses(context.Context) ([]*keymanager.Status, error)
+	MasterSecret(context.Context, common.Namespace) (*keymanager.SignedMasterSecret, error)
 	Genesis(context.Context) (*keymanager.Genesis, error)
 }
 
@@ -42,6 +43,10 @@ func (kq *keymanagerQuerier) Statuses(ctx context.Context) ([]*keymanager.Status
 	return kq.state.Statuses(ctx)
 }
 
+func (kq *keymanagerQuerier) MasterSecret(ctx context.Context, id common.Namespace) (*keymanager.SignedMasterSecret, error) {
+	return kq.state.MasterSecret(ctx, id)
+}
+
 func (app *keymanagerApplication) QueryFactory() interface{} {
 	return &QueryFactory{app.state}
 }
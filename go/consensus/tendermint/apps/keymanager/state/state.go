@@ -16,6 +16,11 @@ import (
 // Value is CBOR-serialized key manager status.
 var statusKeyFmt = keyformat.New(0x70, keyformat.H(&common.Namespace{}))
 
+// masterSecretKeyFmt is the key manager master secret proposal key format.
+//
+// Value is CBOR-serialized signed master secret proposal.
+var masterSecretKeyFmt = keyformat.New(0x71, keyformat.H(&common.Namespace{}))
+
 // ImmutableState is the immutable key manager state wrapper.
 type ImmutableState struct {
 	is *abciAPI.ImmutableState
@@ -72,6 +77,23 @@ func (st *ImmutableState) Status(ctx context.Context, id common.Namespace) (*api
 	return &status, nil
 }
 
+// MasterSecret returns the latest master secret proposal for the given key manager.
+func (st *ImmutableState) MasterSecret(ctx context.Context, id common.Namespace) (*api.SignedMasterSecret, error) {
+	data, err := st.is.Get(ctx, masterSecretKeyFmt.Encode(&id))
+	if err != nil {
+		return nil, abciAPI.UnavailableStateError(err)
+	}
+	if data == nil {
+		return nil, api.ErrNoSuchMasterSecret
+	}
+
+	var secret api.SignedMasterSecret
+	if err := cbor.Unmarshal(data, &secret); err != nil {
+		return nil, abciAPI.UnavailableStateError(err)
+	}
+	return &secret, nil
+}
+
 func NewImmutableState(ctx context.Context, state abciAPI.ApplicationQueryState, version int64) (*ImmutableState, error) {
 	is, err := abciAPI.NewImmutableState(ctx, state, version)
 	if err != nil {
@@ -92,6 +114,12 @@ func (st *MutableState) SetStatus(ctx context.Context, status *api.Status) error
 	return abciAPI.UnavailableStateError(err)
 }
 
+// SetMasterSecret sets the latest master secret proposal for the key manager.
+func (st *MutableState) SetMasterSecret(ctx context.Context, secret *api.SignedMasterSecret) error {
+	err := st.ms.Insert(ctx, masterSecretKeyFmt.Encode(&secret.Secret.ID), cbor.Marshal(secret))
+	return abciAPI.UnavailableStateError(err)
+}
+
 // NewMutableState creates a new mutable key manager state wrapper.
 func NewMutableState(tree mkvs.KeyValueTree) *MutableState {
 	return &MutableState{
@@ -3,6 +3,7 @@ package keymanager
 import (
 	"fmt"
 
+	"github.com/oasisprotocol/oasis-core/go/common/node"
 	tmapi "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
 	keymanagerState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/keymanager/state"
 	registryState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/registry/state"
@@ -82,10 +83,19 @@ func (app *keymanagerApplication) updatePolicy(
 		return err
 	}
 
+	secret, err := state.MasterSecret(ctx, rt.ID)
+	switch err {
+	case nil:
+	case api.ErrNoSuchMasterSecret:
+		secret = nil
+	default:
+		return err
+	}
+
 	nodes, _ := regState.Nodes(ctx)
 	registry.SortNodeList(nodes)
 	oldStatus.Policy = sigPol
-	newStatus := app.generateStatus(ctx, rt, oldStatus, nodes, regParams, epoch)
+	newStatus := app.generateStatus(ctx, rt, oldStatus, secret, nodes, regParams, epoch)
 	if err := state.SetStatus(ctx, newStatus); err != nil {
 		panic(fmt.Errorf("failed to set keymanager status: %w", err))
 	}
@@ -96,3 +106,131 @@ func (app *keymanagerApplication) updatePolicy(
 
 	return nil
 }
+
+func (app *keymanagerApplication) publishMasterSecret(
+	ctx *tmapi.Context,
+	state *keymanagerState.MutableState,
+	sigSec *api.SignedMasterSecret,
+) error {
+	// Validate the proposal.
+	if err := sigSec.Secret.SanityCheck(); err != nil {
+		return fmt.Errorf("%w: %s", api.ErrInvalidArgument, err)
+	}
+
+	// Ensure that the runtime exists and is a key manager.
+	regState := registryState.NewMutableState(ctx.State())
+	rt, err := regState.Runtime(ctx, sigSec.Secret.ID)
+	if err != nil {
+		return err
+	}
+	if rt.Kind != registry.KindKeyManager {
+		return fmt.Errorf("keymanager: runtime is not a key manager: %s", sigSec.Secret.ID)
+	}
+
+	// Ensure that the key manager is initialized and that rotation is due.
+	status, err := state.Status(ctx, rt.ID)
+	if err != nil {
+		return err
+	}
+	if !status.IsInitialized {
+		return fmt.Errorf("%w: key manager not initialized", api.ErrRotationNotAllowed)
+	}
+	if sigSec.Secret.Generation != status.Generation+1 {
+		return fmt.Errorf("%w: invalid generation (expected: %d got: %d)",
+			api.ErrRotationNotAllowed, status.Generation+1, sigSec.Secret.Generation,
+		)
+	}
+
+	epoch, err := app.state.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	if sigSec.Secret.Epoch != epoch+1 {
+		return fmt.Errorf("%w: invalid epoch (expected: %d got: %d)",
+			api.ErrRotationNotAllowed, epoch+1, sigSec.Secret.Epoch,
+		)
+	}
+	rotationEpoch, ok := status.NextRotationEpoch()
+	if !ok {
+		return fmt.Errorf("%w: rotation disabled by policy", api.ErrRotationNotAllowed)
+	}
+	if sigSec.Secret.Epoch < rotationEpoch {
+		return fmt.Errorf("%w: rotation not due until epoch %d", api.ErrRotationNotAllowed, rotationEpoch)
+	}
+
+	// Only one proposal per generation and epoch is accepted.
+	oldSecret, err := state.MasterSecret(ctx, rt.ID)
+	switch err {
+	case nil:
+		if oldSecret.Secret.Generation == sigSec.Secret.Generation && oldSecret.Secret.Epoch == sigSec.Secret.Epoch {
+			return fmt.Errorf("%w: master secret already proposed", api.ErrRotationNotAllowed)
+		}
+	case api.ErrNoSuchMasterSecret:
+	default:
+		return err
+	}
+
+	// Ensure that the tx signer is a member of the key manager committee and
+	// that the proposal was signed by its enclave.
+	signerID := ctx.TxSigner()
+	var isMember bool
+	for _, id := range status.Nodes {
+		if id.Equal(signerID) {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		return fmt.Errorf("keymanager: invalid master secret signer: %s", signerID)
+	}
+	n, err := regState.Node(ctx, signerID)
+	if err != nil {
+		return err
+	}
+	var nodeRt *node.Runtime
+	for _, nrt := range n.Runtimes {
+		if nrt.ID.Equal(&rt.ID) {
+			nodeRt = nrt
+			break
+		}
+	}
+	if nodeRt == nil {
+		return fmt.Errorf("keymanager: node %s does not run key manager %s", signerID, rt.ID)
+	}
+	rak := api.TestPublicKey
+	if nodeRt.Capabilities.TEE != nil && nodeRt.Capabilities.TEE.Hardware != node.TEEHardwareInvalid {
+		rak = nodeRt.Capabilities.TEE.RAK
+	}
+	if err = sigSec.Verify(rak); err != nil {
+		return fmt.Errorf("%w: %s", api.ErrInvalidArgument, err)
+	}
+
+	if ctx.IsCheckOnly() {
+		return nil
+	}
+
+	// Charge gas for this operation.
+	regParams, err := regState.ConsensusParameters(ctx)
+	if err != nil {
+		return err
+	}
+	if err = ctx.Gas().UseGas(1, registry.GasOpUpdateKeyManager, regParams.GasCosts); err != nil {
+		return err
+	}
+
+	// Return early if simulating since this is just estimating gas.
+	if ctx.IsSimulation() {
+		return nil
+	}
+
+	// The proposal becomes active on the next epoch transition.
+	if err = state.SetMasterSecret(ctx, sigSec); err != nil {
+		return fmt.Errorf("keymanager: failed to set master secret: %w", err)
+	}
+
+	ctx.EmitEvent(tmapi.NewEventBuilder(app.Name()).TypedAttribute(&api.MasterSecretPublishedEvent{
+		Secret: sigSec,
+	}))
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/oasisprotocol/oasis-core/go/common/cbor"
 	"github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
+	registryApi "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/registry/api"
 	registryState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/registry/state"
 	governance "github.com/oasisprotocol/oasis-core/go/governance/api"
 	registry "github.com/oasisprotocol/oasis-core/go/registry/api"
@@ -52,3 +53,67 @@ func (app *registryApplication) changeParameters(ctx *api.Context, msg interface
 	// Non-nil response signals that changes are valid and were successfully applied (if required).
 	return struct{}{}, nil
 }
+
+func (app *registryApplication) updateRuntimeByGovernance(ctx *api.Context, msg interface{}, apply bool) (interface{}, error) {
+	proposal, ok := msg.(*governance.UpdateRuntimeProposal)
+	if !ok {
+		return nil, fmt.Errorf("registry: failed to type assert update runtime proposal")
+	}
+	rt := &proposal.Runtime
+
+	state := registryState.NewMutableState(ctx.State())
+	params, err := state.ConsensusParameters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("registry: failed to load consensus parameters: %w", err)
+	}
+
+	epoch, err := app.state.GetEpoch(ctx, ctx.BlockHeight()+1)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the updated descriptor the same way as regular runtime updates.
+	if err = verifyRuntime(ctx, state, params, rt, epoch); err != nil {
+		return nil, err
+	}
+	existingRt, suspended, err := verifyRuntimeStatus(ctx, state, params, rt, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only existing runtimes governed by the consensus layer can be updated via governance.
+	if existingRt == nil {
+		return nil, registry.ErrNoSuchRuntime
+	}
+	if existingRt.GovernanceModel != registry.GovernanceConsensus {
+		ctx.Logger().Debug("UpdateRuntime: runtime is not governed by the consensus layer",
+			"runtime_id", rt.ID,
+		)
+		return nil, registry.ErrForbidden
+	}
+
+	// Apply the update.
+	if apply {
+		if _, err = app.md.Publish(ctx, registryApi.MessageRuntimeUpdated, rt); err != nil {
+			ctx.Logger().Error("UpdateRuntime: failed to dispatch message",
+				"err", err,
+			)
+			return nil, err
+		}
+
+		if err = state.SetRuntime(ctx, rt, suspended); err != nil {
+			return nil, fmt.Errorf("registry: failed to set runtime: %w", err)
+		}
+
+		if !suspended {
+			ctx.Logger().Debug("UpdateRuntime: updated",
+				"runtime", rt,
+			)
+
+			ctx.EmitEvent(api.NewEventBuilder(app.Name()).TypedAttribute(&registry.RuntimeStartedEvent{Runtime: rt}))
+		}
+	}
+
+	// Non-nil response signals that the update is valid and was successfully applied (if required).
+	return struct{}{}, nil
+}
@@ -58,6 +58,8 @@ func (app *registryApplication) OnRegister(state api.ApplicationState, md api.Me
 	md.Subscribe(roothashApi.RuntimeMessageRegistry, app)
 	md.Subscribe(governanceApi.MessageChangeParameters, app)
 	md.Subscribe(governanceApi.MessageValidateParameterChanges, app)
+	md.Subscribe(governanceApi.MessageUpdateRuntime, app)
+	md.Subscribe(governanceApi.MessageValidateRuntimeUpdate, app)
 }
 
 func (app *registryApplication) OnCleanup() {
@@ -89,6 +91,13 @@ func (app *registryApplication) ExecuteMessage(ctx *api.Context, kind, msg inter
 		// A change parameters proposal has just been accepted and closed. Validate and apply
 		// changes.
 		return app.changeParameters(ctx, msg, true)
+	case governanceApi.MessageValidateRuntimeUpdate:
+		// An update runtime proposal is about to be submitted. Validate the update.
+		return app.updateRuntimeByGovernance(ctx, msg, false)
+	case governanceApi.MessageUpdateRuntime:
+		// An update runtime proposal has just been accepted and closed. Validate and apply
+		// the update.
+		return app.updateRuntimeByGovernance(ctx, msg, true)
 	default:
 		return nil, registry.ErrInvalidArgument
 	}
@@ -637,29 +637,15 @@ func (app *registryApplication) registerRuntime( // nolint: gocyclo
 		return nil, err
 	}
 
-	if params.DisableRuntimeRegistration {
-		return nil, registry.ErrForbidden
-	}
-
 	epoch, err := app.state.GetEpoch(ctx, ctx.BlockHeight()+1)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = registry.VerifyRuntime(params, ctx.Logger(), rt, ctx.IsInitChain(), false, epoch); err != nil {
+	if err = verifyRuntime(ctx, state, params, rt, epoch); err != nil {
 		return nil, err
 	}
 
-	if rt.Kind == registry.KindKeyManager && params.DisableKeyManagerRuntimeRegistration {
-		return nil, registry.ErrForbidden
-	}
-
-	if rt.Kind == registry.KindCompute {
-		if err = registry.VerifyRegisterComputeRuntimeArgs(ctx, ctx.Logger(), rt, state); err != nil {
-			return nil, err
-		}
-	}
-
 	if ctx.IsCheckOnly() {
 		return nil, nil
 	}
@@ -674,33 +660,7 @@ func (app *registryApplication) registerRuntime( // nolint: gocyclo
 		return nil, nil
 	}
 
-	// Make sure the runtime doesn't exist yet.
-	var suspended bool
-	existingRt, err := state.Runtime(ctx, rt.ID)
-	switch err {
-	case nil:
-	case registry.ErrNoSuchRuntime:
-		// Make sure the runtime isn't suspended.
-		existingRt, err = state.SuspendedRuntime(ctx, rt.ID)
-		switch err {
-		case nil:
-			suspended = true
-		case registry.ErrNoSuchRuntime:
-		default:
-			return nil, fmt.Errorf("failed to fetch suspended runtime: %w", err)
-		}
-	default:
-		return nil, fmt.Errorf("failed to fetch runtime: %w", err)
-	}
-	// Invoke the right verification logic.
-	switch {
-	case existingRt != nil:
-		// Existing runtime, verify update.
-		err = registry.VerifyRuntimeUpdate(ctx.Logger(), existingRt, rt, epoch, params)
-	default:
-		// New runtime, verify new descriptor.
-		err = registry.VerifyRuntimeNew(ctx.Logger(), rt, epoch, params, ctx.IsInitChain())
-	}
+	existingRt, suspended, err := verifyRuntimeStatus(ctx, state, params, rt, epoch)
 	if err != nil {
 		return nil, err
 	}
@@ -809,6 +769,80 @@ func (app *registryApplication) registerRuntime( // nolint: gocyclo
 	return rt, nil
 }
 
+// verifyRuntime verifies the given runtime descriptor against the registry consensus parameters.
+func verifyRuntime(
+	ctx *api.Context,
+	state *registryState.MutableState,
+	params *registry.ConsensusParameters,
+	rt *registry.Runtime,
+	epoch beacon.EpochTime,
+) error {
+	if params.DisableRuntimeRegistration {
+		return registry.ErrForbidden
+	}
+
+	if err := registry.VerifyRuntime(params, ctx.Logger(), rt, ctx.IsInitChain(), false, epoch); err != nil {
+		return err
+	}
+
+	if rt.Kind == registry.KindKeyManager && params.DisableKeyManagerRuntimeRegistration {
+		return registry.ErrForbidden
+	}
+
+	if rt.Kind == registry.KindCompute {
+		if err := registry.VerifyRegisterComputeRuntimeArgs(ctx, ctx.Logger(), rt, state); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyRuntimeStatus looks up the existing (possibly suspended) runtime with the same identifier
+// and verifies the given descriptor either as an update of the existing runtime or as a new one.
+//
+// Returns the existing runtime (nil in case the runtime doesn't exist yet) and whether it is
+// suspended.
+func verifyRuntimeStatus(
+	ctx *api.Context,
+	state *registryState.MutableState,
+	params *registry.ConsensusParameters,
+	rt *registry.Runtime,
+	epoch beacon.EpochTime,
+) (*registry.Runtime, bool, error) {
+	// Check whether the runtime exists and whether it is suspended.
+	var suspended bool
+	existingRt, err := state.Runtime(ctx, rt.ID)
+	switch err {
+	case nil:
+	case registry.ErrNoSuchRuntime:
+		existingRt, err = state.SuspendedRuntime(ctx, rt.ID)
+		switch err {
+		case nil:
+			suspended = true
+		case registry.ErrNoSuchRuntime:
+		default:
+			return nil, false, fmt.Errorf("failed to fetch suspended runtime: %w", err)
+		}
+	default:
+		return nil, false, fmt.Errorf("failed to fetch runtime: %w", err)
+	}
+	// Invoke the right verification logic.
+	switch {
+	case existingRt != nil:
+		// Existing runtime, verify update.
+		err = registry.VerifyRuntimeUpdate(ctx.Logger(), existingRt, rt, epoch, params)
+	default:
+		// New runtime, verify new descriptor.
+		err = registry.VerifyRuntimeNew(ctx.Logger(), rt, epoch, params, ctx.IsInitChain())
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return existingRt, suspended, nil
+}
+
 func (app *registryApplication) proveFreshness(
 	ctx *api.Context,
 	state *registryState.MutableState,
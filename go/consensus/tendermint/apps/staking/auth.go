@@ -4,11 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
 	consensus "github.com/oasisprotocol/oasis-core/go/consensus/api"
 	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
 	"github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
 	stakingState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/staking/state"
-	staking "github.com/oasisprotocol/oasis-core/go/staking/api"
 )
 
 var _ api.TransactionAuthHandler = (*stakingApplication)(nil)
@@ -27,9 +27,19 @@ func (app *stakingApplication) GetSignerNonce(ctx context.Context, req *consensu
 	return acct.General.Nonce, nil
 }
 
+// Implements api.TransactionAuthHandler.
+func (app *stakingApplication) GetMinGasPrice(ctx context.Context, height int64) (*quantity.Quantity, error) {
+	q, err := app.QueryFactory().(*QueryFactory).QueryAt(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.BaseFee(ctx)
+}
+
 // Implements api.TransactionAuthHandler.
 func (app *stakingApplication) AuthenticateTx(ctx *api.Context, tx *transaction.Transaction) error {
-	return stakingState.AuthenticateAndPayFees(ctx, ctx.TxSigner(), tx.Nonce, tx.Fee)
+	return stakingState.AuthenticateAndPayFees(ctx, ctx.CallerAddress(), ctx.TxFeePayer(), tx.Nonce, tx.Fee)
 }
 
 // Implements api.TransactionAuthHandler.
@@ -50,20 +60,34 @@ func (app *stakingApplication) PostExecuteTx(ctx *api.Context, tx *transaction.T
 		fee = &transaction.Fee{}
 	}
 
-	addr := staking.NewAddress(ctx.TxSigner())
+	addr := ctx.CallerAddress()
+	feePayer := ctx.TxFeePayer()
 
 	account, err := state.Account(ctx, addr)
 	if err != nil {
 		return fmt.Errorf("failed to fetch account state: %w", err)
 	}
 
+	// Fetch the fee payer account unless it is the same as the signer account.
+	payerAccount := account
+	if !feePayer.Equal(addr) {
+		if payerAccount, err = state.Account(ctx, feePayer); err != nil {
+			return fmt.Errorf("failed to fetch fee payer account state: %w", err)
+		}
+	}
+
 	// Deduct fee and increment the nonce.
-	if err := account.General.Balance.Sub(&fee.Amount); err != nil {
+	if err = payerAccount.General.Balance.Sub(&fee.Amount); err != nil {
 		return transaction.ErrInsufficientFeeBalance
 	}
+	if payerAccount != account {
+		if err = state.SetAccount(ctx, feePayer, payerAccount); err != nil {
+			return fmt.Errorf("failed to set fee payer account: %w", err)
+		}
+	}
 
 	account.General.Nonce++
-	if err := state.SetAccount(ctx, addr, account); err != nil {
+	if err = state.SetAccount(ctx, addr, account); err != nil {
 		return fmt.Errorf("failed to set account: %w", err)
 	}
 
@@ -108,6 +108,102 @@ func (app *stakingApplication) disburseFeesP(
 	return nil
 }
 
+// disposeBaseFees burns the base fee portions of the block fees or transfers them into the
+// common pool, depending on the consensus parameters.
+//
+// In case of errors the state may be inconsistent.
+func (app *stakingApplication) disposeBaseFees(
+	ctx *abciAPI.Context,
+	stakeState *stakingState.MutableState,
+	baseFees *quantity.Quantity,
+) error {
+	if baseFees.IsZero() {
+		return nil
+	}
+
+	consensusParameters, err := stakeState.ConsensusParameters(ctx)
+	if err != nil {
+		return fmt.Errorf("ConsensusParameters: %w", err)
+	}
+
+	ctx.Logger().Debug("disposing base fees",
+		"total_amount", baseFees,
+		"burn", consensusParameters.BaseFee.Burn,
+	)
+
+	if consensusParameters.BaseFee.Burn {
+		totalSupply, err := stakeState.TotalSupply(ctx)
+		if err != nil {
+			return fmt.Errorf("TotalSupply: %w", err)
+		}
+		if err = totalSupply.Sub(baseFees); err != nil {
+			return fmt.Errorf("subtract baseFees: %w", err)
+		}
+		if err = stakeState.SetTotalSupply(ctx, totalSupply); err != nil {
+			return fmt.Errorf("failed to set total supply: %w", err)
+		}
+
+		// Emit burn event.
+		ctx.EmitEvent(abciAPI.NewEventBuilder(app.Name()).TypedAttribute(&staking.BurnEvent{
+			Owner:  staking.FeeAccumulatorAddress,
+			Amount: *baseFees,
+		}))
+		return nil
+	}
+
+	commonPool, err := stakeState.CommonPool(ctx)
+	if err != nil {
+		return fmt.Errorf("CommonPool: %w", err)
+	}
+	if err = commonPool.Add(baseFees); err != nil {
+		return fmt.Errorf("add baseFees: %w", err)
+	}
+	if err = stakeState.SetCommonPool(ctx, commonPool); err != nil {
+		return fmt.Errorf("failed to set common pool: %w", err)
+	}
+
+	// Emit transfer event.
+	ctx.EmitEvent(abciAPI.NewEventBuilder(app.Name()).TypedAttribute(&staking.TransferEvent{
+		From:   staking.FeeAccumulatorAddress,
+		To:     staking.CommonPoolAddress,
+		Amount: *baseFees,
+	}))
+	return nil
+}
+
+// updateBaseFee adjusts the base fee for the next block based on the amount of gas used by the
+// current block.
+func (app *stakingApplication) updateBaseFee(ctx *abciAPI.Context, stakeState *stakingState.MutableState) error {
+	consensusParameters, err := stakeState.ConsensusParameters(ctx)
+	if err != nil {
+		return fmt.Errorf("ConsensusParameters: %w", err)
+	}
+	if !consensusParameters.BaseFee.IsEnabled() {
+		return nil
+	}
+
+	baseFee, err := stakeState.BaseFee(ctx)
+	if err != nil {
+		return fmt.Errorf("BaseFee: %w", err)
+	}
+	gasUsed := stakingState.BlockGasUsed(ctx)
+	nextBaseFee, err := consensusParameters.BaseFee.NextBaseFee(baseFee, gasUsed)
+	if err != nil {
+		return fmt.Errorf("NextBaseFee: %w", err)
+	}
+
+	ctx.Logger().Debug("updating base fee",
+		"base_fee", baseFee,
+		"gas_used", gasUsed,
+		"next_base_fee", nextBaseFee,
+	)
+
+	if err = stakeState.SetBaseFee(ctx, nextBaseFee); err != nil {
+		return fmt.Errorf("failed to set base fee: %w", err)
+	}
+	return nil
+}
+
 // disburseFeesVQ disburses persisted fees to the voters and next proposer.
 //
 // In case of errors the state may be inconsistent.
@@ -0,0 +1,68 @@
+package staking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
+	abciAPI "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
+	stakingState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/staking/state"
+	staking "github.com/oasisprotocol/oasis-core/go/staking/api"
+)
+
+func TestUpdateBaseFee(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Unix(1580461674, 0)
+	// Maximum block gas is not limited so the block gas accountant does not do any accounting.
+	appState := abciAPI.NewMockApplicationState(&abciAPI.MockApplicationStateConfig{})
+	ctx := appState.NewContext(abciAPI.ContextEndBlock, now)
+	defer ctx.Close()
+
+	app := &stakingApplication{
+		state: appState,
+	}
+
+	stakeState := stakingState.NewMutableState(ctx.State())
+	err := stakeState.SetConsensusParameters(ctx, &staking.ConsensusParameters{
+		BaseFee: staking.BaseFeeParameters{
+			TargetBlockGas:       1_000,
+			MinBaseFee:           *quantity.NewFromUint64(10),
+			MaxChangeDenominator: 8,
+		},
+	})
+	require.NoError(err, "SetConsensusParameters")
+	err = stakeState.SetBaseFee(ctx, quantity.NewFromUint64(800))
+	require.NoError(err, "SetBaseFee")
+
+	sender := staking.NewAddress(signature.NewPublicKey("aaafffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"))
+	err = stakeState.SetAccount(ctx, sender, &staking.Account{
+		General: staking.GeneralAccount{
+			Balance: *quantity.NewFromUint64(10_000_000),
+		},
+	})
+	require.NoError(err, "SetAccount")
+
+	// Execute a transaction using twice the target block gas.
+	txCtx := appState.NewContext(abciAPI.ContextDeliverTx, now)
+	defer txCtx.Close()
+	err = stakingState.AuthenticateAndPayFees(txCtx, sender, sender, 0, &transaction.Fee{
+		Amount: *quantity.NewFromUint64(2_000_000),
+		Gas:    2_000,
+	})
+	require.NoError(err, "AuthenticateAndPayFees")
+	err = txCtx.Gas().UseGas(1, staking.GasOpTransfer, transaction.Costs{staking.GasOpTransfer: 2_000})
+	require.NoError(err, "UseGas")
+	require.EqualValues(2_000, stakingState.BlockGasUsed(txCtx), "block gas used should be tracked")
+
+	// Full block, base fee should increase by 1/8.
+	err = app.updateBaseFee(ctx, stakeState)
+	require.NoError(err, "updateBaseFee")
+	baseFee, err := stakeState.BaseFee(ctx)
+	require.NoError(err, "BaseFee")
+	require.EqualValues(*quantity.NewFromUint64(900), *baseFee, "base fee should increase")
+}
@@ -285,6 +285,38 @@ func (app *stakingApplication) initDebondingDelegations(ctx *abciAPI.Context, st
 	return nil
 }
 
+func (app *stakingApplication) initRedelegations(ctx *abciAPI.Context, state *stakingState.MutableState, st *staking.Genesis) error {
+	for sourceAddr, delegators := range st.Redelegations {
+		if !sourceAddr.IsValid() {
+			return fmt.Errorf("tendermint/staking: failed to set genesis redelegations from %s: address is invalid",
+				sourceAddr,
+			)
+		}
+		for delegatorAddr, redelegations := range delegators {
+			if !delegatorAddr.IsValid() {
+				return fmt.Errorf(
+					"tendermint/staking: failed to set genesis redelegation by %s from %s: delegator address is invalid",
+					delegatorAddr, sourceAddr,
+				)
+			}
+			for idx, rd := range redelegations {
+				if rd == nil {
+					return fmt.Errorf(
+						"tendermint/staking: genesis redelegation by %s from %s with index %d is nil",
+						delegatorAddr, sourceAddr, idx,
+					)
+				}
+				if err := state.SetRedelegation(ctx, sourceAddr, delegatorAddr, rd); err != nil {
+					return fmt.Errorf("tendermint/staking: failed to set redelegation by %s from %s index %d: %w",
+						delegatorAddr, sourceAddr, idx, err,
+					)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // InitChain initializes the chain from genesis.
 func (app *stakingApplication) InitChain(ctx *abciAPI.Context, request types.RequestInitChain, doc *genesis.Document) error {
 	st := &doc.Staking
@@ -325,6 +357,9 @@ func (app *stakingApplication) InitChain(ctx *abciAPI.Context, request types.Req
 	if err := app.initDebondingDelegations(ctx, state, st); err != nil {
 		return err
 	}
+	if err := app.initRedelegations(ctx, state, st); err != nil {
+		return err
+	}
 
 	ctx.Logger().Debug("InitChain: allocations complete",
 		"common_pool", st.CommonPool,
@@ -381,6 +416,10 @@ func (sq *stakingQuerier) Genesis(ctx context.Context) (*staking.Genesis, error)
 	if err != nil {
 		return nil, err
 	}
+	redelegations, err := sq.state.Redelegations(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	params, err := sq.state.ConsensusParameters(ctx)
 	if err != nil {
@@ -396,6 +435,7 @@ func (sq *stakingQuerier) Genesis(ctx context.Context) (*staking.Genesis, error)
 		Ledger:               ledger,
 		Delegations:          delegations,
 		DebondingDelegations: debondingDelegations,
+		Redelegations:        redelegations,
 	}
 	return &gen, nil
 }
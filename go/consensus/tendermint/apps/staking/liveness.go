@@ -0,0 +1,120 @@
+package staking
+
+import (
+	"encoding/hex"
+
+	"github.com/tendermint/tendermint/abci/types"
+
+	abciAPI "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
+	registryState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/registry/state"
+	stakingState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/staking/state"
+	registry "github.com/oasisprotocol/oasis-core/go/registry/api"
+	staking "github.com/oasisprotocol/oasis-core/go/staking/api"
+)
+
+// updateValidatorLiveness tracks which validators signed the previous block and jails (freezes
+// and optionally slashes) validators which signed too few blocks in the downtime window.
+//
+// Jailed validators can be unfrozen via the registry's unfreeze node transaction after the
+// freeze interval elapses.
+func (app *stakingApplication) updateValidatorLiveness(
+	ctx *abciAPI.Context,
+	regState *registryState.MutableState,
+	stakeState *stakingState.MutableState,
+	lastCommitInfo types.LastCommitInfo,
+) error {
+	params, err := stakeState.ConsensusParameters(ctx)
+	if err != nil {
+		return err
+	}
+	window := params.Downtime.Window
+	if window == 0 {
+		return nil
+	}
+
+	for _, a := range lastCommitInfo.Votes {
+		valAddr := a.Validator.Address
+
+		// Map address to node.
+		node, err := regState.NodeByConsensusAddress(ctx, valAddr)
+		switch err {
+		case nil:
+		case registry.ErrNoSuchNode:
+			ctx.Logger().Warn("failed to get validator node",
+				"err", err,
+				"address", hex.EncodeToString(valAddr),
+			)
+			continue
+		default:
+			return err
+		}
+
+		nodeStatus, err := regState.NodeStatus(ctx, node.ID)
+		switch err {
+		case nil:
+		case registry.ErrNoSuchNode:
+			continue
+		default:
+			return err
+		}
+
+		// Do not track a frozen validator.
+		if nodeStatus.IsFrozen() {
+			continue
+		}
+
+		vl, err := stakeState.ValidatorLiveness(ctx, node.ID)
+		if err != nil {
+			return err
+		}
+		// Start tracking from scratch in case the window has changed or the validator has not
+		// been part of the validator set for some time.
+		if vl == nil || vl.Window != window || vl.LastHeight != ctx.BlockHeight()-1 {
+			vl = stakingState.NewValidatorLiveness(window)
+		}
+		vl.Update(a.SignedLastBlock)
+		vl.LastHeight = ctx.BlockHeight()
+
+		if !vl.IsBelowThreshold(params.Downtime.MinSignedBlocks) {
+			if err = stakeState.SetValidatorLiveness(ctx, node.ID, vl); err != nil {
+				return err
+			}
+			continue
+		}
+
+		ctx.Logger().Warn("validator signed too few blocks, jailing",
+			"node_id", node.ID,
+			"entity_id", node.EntityID,
+			"window", window,
+			"missed", vl.Missed,
+		)
+
+		if err = slashAndFreezeNode(ctx, regState, stakeState, staking.SlashConsensusDowntime, node, nodeStatus); err != nil {
+			return err
+		}
+		if err = stakeState.RemoveValidatorLiveness(ctx, node.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneValidatorLiveness removes the liveness tracking information of validators which are no
+// longer part of the validator set.
+func (app *stakingApplication) pruneValidatorLiveness(ctx *abciAPI.Context, stakeState *stakingState.MutableState) error {
+	livenesses, err := stakeState.ValidatorLivenesses(ctx)
+	if err != nil {
+		return err
+	}
+	for nodeID, vl := range livenesses {
+		// Information that was not updated in the current block would be reset anyway.
+		if vl.LastHeight >= ctx.BlockHeight() {
+			continue
+		}
+		if err = stakeState.RemoveValidatorLiveness(ctx, nodeID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
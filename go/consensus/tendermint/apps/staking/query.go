@@ -16,6 +16,7 @@ type Query interface {
 	CommonPool(context.Context) (*quantity.Quantity, error)
 	LastBlockFees(context.Context) (*quantity.Quantity, error)
 	GovernanceDeposits(context.Context) (*quantity.Quantity, error)
+	BaseFee(context.Context) (*quantity.Quantity, error)
 	Threshold(context.Context, staking.ThresholdKind) (*quantity.Quantity, error)
 	DebondingInterval(context.Context) (beacon.EpochTime, error)
 	Addresses(context.Context) ([]staking.Address, error)
@@ -64,6 +65,10 @@ func (sq *stakingQuerier) GovernanceDeposits(ctx context.Context) (*quantity.Qua
 	return sq.state.GovernanceDeposits(ctx)
 }
 
+func (sq *stakingQuerier) BaseFee(ctx context.Context) (*quantity.Quantity, error) {
+	return sq.state.BaseFee(ctx)
+}
+
 func (sq *stakingQuerier) Threshold(ctx context.Context, kind staking.ThresholdKind) (*quantity.Quantity, error) {
 	thresholds, err := sq.state.Thresholds(ctx)
 	if err != nil {
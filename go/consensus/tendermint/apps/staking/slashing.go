@@ -9,6 +9,7 @@ import (
 	tmcrypto "github.com/tendermint/tendermint/crypto"
 
 	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common/node"
 	abciAPI "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
 	registryState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/registry/state"
 	stakingState "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/staking/state"
@@ -58,6 +59,19 @@ func onEvidenceByzantineConsensus(
 		return nil
 	}
 
+	return slashAndFreezeNode(ctx, regState, stakeState, reason, node, nodeStatus)
+}
+
+// slashAndFreezeNode slashes the entity of the given node and freezes the node according to the
+// slashing configuration for the given reason.
+func slashAndFreezeNode(
+	ctx *abciAPI.Context,
+	regState *registryState.MutableState,
+	stakeState *stakingState.MutableState,
+	reason staking.SlashReason,
+	node *node.Node,
+	nodeStatus *registry.NodeStatus,
+) error {
 	// Retrieve the slash procedure.
 	st, err := stakeState.Slashing(ctx)
 	if err != nil {
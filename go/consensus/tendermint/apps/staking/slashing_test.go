@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/abci/types"
 
 	"github.com/oasisprotocol/oasis-core/go/common/cbor"
 	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
@@ -143,3 +144,159 @@ func TestOnEvidenceByzantineConsensus(t *testing.T) {
 	err = onEvidenceByzantineConsensus(ctx, staking.SlashConsensusLightClientAttack, validatorAddress, 1, now, 1)
 	require.NoError(err, "slashing should not fail")
 }
+
+func TestValidatorDowntime(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Unix(1580461674, 0)
+	cfg := &abciAPI.MockApplicationStateConfig{
+		BlockHeight:  1,
+		CurrentEpoch: 42,
+	}
+	appState := abciAPI.NewMockApplicationState(cfg)
+	// Consensus parameters can only be set during InitChain/EndBlock.
+	ctx := appState.NewContext(abciAPI.ContextInitChain, now)
+	defer ctx.Close()
+
+	app := &stakingApplication{
+		state: appState,
+	}
+
+	consensusSigner := memorySigner.NewTestSigner("consensus test signer")
+	consensusID := consensusSigner.Public()
+	validatorAddress := tmcrypto.PublicKeyToTendermint(&consensusID).Address()
+
+	regState := registryState.NewMutableState(ctx.State())
+	stakeState := stakingState.NewMutableState(ctx.State())
+
+	// Add entity.
+	ent, entitySigner, _ := entity.TestEntity()
+	sigEntity, err := entity.SignEntity(entitySigner, registry.RegisterEntitySignatureContext, ent)
+	require.NoError(err, "SignEntity")
+	err = regState.SetEntity(ctx, ent, sigEntity)
+	require.NoError(err, "SetEntity")
+	// Add node.
+	nodeSigner := memorySigner.NewTestSigner("node test signer")
+	nod := &node.Node{
+		Versioned: cbor.NewVersioned(node.LatestNodeDescriptorVersion),
+		ID:        nodeSigner.Public(),
+		EntityID:  ent.ID,
+		Consensus: node.ConsensusInfo{
+			ID: consensusID,
+		},
+	}
+	sigNode, err := node.MultiSignNode([]signature.Signer{nodeSigner}, registry.RegisterNodeSignatureContext, nod)
+	require.NoError(err, "MultiSignNode")
+	err = regState.SetNode(ctx, nil, nod, sigNode)
+	require.NoError(err, "SetNode")
+	err = regState.SetNodeStatus(ctx, nod.ID, &registry.NodeStatus{})
+	require.NoError(err, "SetNodeStatus")
+
+	// Get the validator some stake.
+	addr := staking.NewAddress(ent.ID)
+	err = stakeState.SetAccount(ctx, addr, &staking.Account{
+		Escrow: staking.EscrowAccount{
+			Active: staking.SharePool{
+				Balance:     *quantity.NewFromUint64(200),
+				TotalShares: *quantity.NewFromUint64(200),
+			},
+		},
+	})
+	require.NoError(err, "SetAccount")
+
+	err = stakeState.SetConsensusParameters(ctx, &staking.ConsensusParameters{
+		Slashing: map[staking.SlashReason]staking.Slash{
+			staking.SlashConsensusDowntime: {
+				Amount:         *quantity.NewFromUint64(10),
+				FreezeInterval: 2,
+			},
+		},
+		Downtime: staking.DowntimeParameters{
+			Window:          4,
+			MinSignedBlocks: 3,
+		},
+	})
+	require.NoError(err, "SetConsensusParameters")
+
+	for _, tc := range []struct {
+		signed bool
+		missed uint64
+		jailed bool
+	}{
+		{true, 0, false},
+		{false, 1, false},
+		{true, 1, false},
+		// Window is full, validator signed exactly the minimum number of blocks.
+		{true, 1, false},
+		// Oldest (signed) block is evicted from the window.
+		{true, 1, false},
+		// Oldest (missed) block is evicted from the window.
+		{false, 1, false},
+		// Validator signed only two blocks in the window.
+		{false, 0, true},
+	} {
+		cfg.BlockHeight++
+		appState.UpdateMockApplicationStateConfig(cfg)
+		blockCtx := appState.NewContext(abciAPI.ContextBeginBlock, now)
+
+		err = app.updateValidatorLiveness(blockCtx, regState, stakeState, types.LastCommitInfo{
+			Votes: []types.VoteInfo{
+				{
+					Validator:       types.Validator{Address: validatorAddress, Power: 1},
+					SignedLastBlock: tc.signed,
+				},
+			},
+		})
+		require.NoError(err, "updateValidatorLiveness")
+
+		var vl *stakingState.ValidatorLiveness
+		vl, err = stakeState.ValidatorLiveness(blockCtx, nod.ID)
+		require.NoError(err, "ValidatorLiveness")
+
+		var status *registry.NodeStatus
+		status, err = regState.NodeStatus(blockCtx, nod.ID)
+		require.NoError(err, "NodeStatus")
+		require.Equal(tc.jailed, status.IsFrozen(), "node should only be frozen once jailed (height %d)", cfg.BlockHeight)
+		if !tc.jailed {
+			require.NotNil(vl, "liveness should be tracked")
+			require.EqualValues(tc.missed, vl.Missed, "missed blocks (height %d)", cfg.BlockHeight)
+			blockCtx.Close()
+			continue
+		}
+		require.Nil(vl, "liveness should be reset after jailing")
+		require.EqualValues(44, status.FreezeEndTime, "node should be frozen for the freeze interval")
+		blockCtx.Close()
+	}
+
+	// Entity stake should be slashed.
+	acct, err := stakeState.Account(ctx, addr)
+	require.NoError(err, "Account")
+	require.EqualValues(*quantity.NewFromUint64(190), acct.Escrow.Active.Balance, "entity stake should be slashed")
+
+	// Frozen validators are not tracked.
+	cfg.BlockHeight++
+	appState.UpdateMockApplicationStateConfig(cfg)
+	blockCtx := appState.NewContext(abciAPI.ContextBeginBlock, now)
+	defer blockCtx.Close()
+	err = app.updateValidatorLiveness(blockCtx, regState, stakeState, types.LastCommitInfo{
+		Votes: []types.VoteInfo{
+			{
+				Validator:       types.Validator{Address: validatorAddress, Power: 1},
+				SignedLastBlock: false,
+			},
+		},
+	})
+	require.NoError(err, "updateValidatorLiveness")
+	vl, err := stakeState.ValidatorLiveness(blockCtx, nod.ID)
+	require.NoError(err, "ValidatorLiveness")
+	require.Nil(vl, "frozen validator should not be tracked")
+
+	// Stale liveness information is pruned.
+	err = stakeState.SetValidatorLiveness(blockCtx, nod.ID, &stakingState.ValidatorLiveness{LastHeight: 1})
+	require.NoError(err, "SetValidatorLiveness")
+	err = app.pruneValidatorLiveness(blockCtx, stakeState)
+	require.NoError(err, "pruneValidatorLiveness")
+	vl, err = stakeState.ValidatorLiveness(blockCtx, nod.ID)
+	require.NoError(err, "ValidatorLiveness")
+	require.Nil(vl, "stale liveness should be pruned")
+}
@@ -94,6 +94,11 @@ func (app *stakingApplication) BeginBlock(ctx *api.Context, request types.Reques
 		return fmt.Errorf("staking: failed to update epoch signing info: %w", err)
 	}
 
+	// Track validator liveness for downtime jailing.
+	if err = app.updateValidatorLiveness(ctx, regState, stakeState, request.GetLastCommitInfo()); err != nil {
+		return fmt.Errorf("staking: failed to update validator liveness: %w", err)
+	}
+
 	// Iterate over any submitted evidence of a validator misbehaving. Note that
 	// the actual evidence has already been verified by Tendermint to be valid.
 	for _, evidence := range request.ByzantineValidators {
@@ -206,16 +211,41 @@ func (app *stakingApplication) ExecuteTx(ctx *api.Context, tx *transaction.Trans
 
 		_, err := app.withdraw(ctx, state, &withdraw)
 		return err
+	case staking.MethodVestingTransfer:
+		var xfer staking.VestingTransfer
+		if err := cbor.Unmarshal(tx.Body, &xfer); err != nil {
+			return staking.ErrInvalidArgument
+		}
+
+		_, err := app.vestingTransfer(ctx, state, &xfer)
+		return err
+	case staking.MethodRedelegate:
+		var redelegate staking.Redelegate
+		if err := cbor.Unmarshal(tx.Body, &redelegate); err != nil {
+			return staking.ErrInvalidArgument
+		}
+
+		_, err := app.redelegate(ctx, state, &redelegate)
+		return err
 	default:
 		return staking.ErrInvalidArgument
 	}
 }
 
 func (app *stakingApplication) EndBlock(ctx *api.Context, request types.RequestEndBlock) (types.ResponseEndBlock, error) {
+	stakeState := stakingState.NewMutableState(ctx.State())
+
+	baseFees := stakingState.BlockBaseFees(ctx)
+	if err := app.disposeBaseFees(ctx, stakeState, &baseFees); err != nil {
+		return types.ResponseEndBlock{}, fmt.Errorf("dispose base fees: %w", err)
+	}
 	fees := stakingState.BlockFees(ctx)
-	if err := app.disburseFeesP(ctx, stakingState.NewMutableState(ctx.State()), stakingState.BlockProposer(ctx), &fees); err != nil {
+	if err := app.disburseFeesP(ctx, stakeState, stakingState.BlockProposer(ctx), &fees); err != nil {
 		return types.ResponseEndBlock{}, fmt.Errorf("disburse fees proposer: %w", err)
 	}
+	if err := app.updateBaseFee(ctx, stakeState); err != nil {
+		return types.ResponseEndBlock{}, fmt.Errorf("update base fee: %w", err)
+	}
 
 	if changed, epoch := app.state.EpochChanged(ctx); changed {
 		return types.ResponseEndBlock{}, app.onEpochChange(ctx, epoch)
@@ -272,6 +302,9 @@ func (app *stakingApplication) onEpochChange(ctx *api.Context, epoch beacon.Epoc
 			)
 			return fmt.Errorf("staking/tendermint: failed to redeem debonding shares: %w", err)
 		}
+		if err = delegator.General.UndelegateVesting(stakeAmount); err != nil {
+			return fmt.Errorf("staking/tendermint: failed to track delegated vesting: %w", err)
+		}
 
 		// Update state.
 		if err = state.RemoveFromDebondingQueue(ctx, e.Epoch, e.DelegatorAddr, e.EscrowAddr); err != nil {
@@ -304,6 +337,26 @@ func (app *stakingApplication) onEpochChange(ctx *api.Context, epoch beacon.Epoc
 		}))
 	}
 
+	// Prune redelegations which are no longer exposed to slashing.
+	expiredRedelegationQueue, err := state.ExpiredRedelegationQueue(ctx, epoch)
+	if err != nil {
+		return fmt.Errorf("failed to query expired redelegation queue: %w", err)
+	}
+	for _, e := range expiredRedelegationQueue {
+		rd := staking.Redelegation{
+			To:              e.DestAddr,
+			ExposureEndTime: e.Epoch,
+		}
+		if err = state.SetRedelegation(ctx, e.SourceAddr, e.DelegatorAddr, &rd); err != nil {
+			return fmt.Errorf("failed to remove redelegation: %w", err)
+		}
+	}
+
+	// Prune liveness tracking information of validators no longer in the validator set.
+	if err = app.pruneValidatorLiveness(ctx, state); err != nil {
+		return fmt.Errorf("failed to prune validator liveness: %w", err)
+	}
+
 	// Add signing rewards.
 	if err := app.rewardEpochSigning(ctx, epoch); err != nil {
 		ctx.Logger().Error("failed to add signing rewards",
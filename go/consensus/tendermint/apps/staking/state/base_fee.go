@@ -0,0 +1,46 @@
+package state
+
+import (
+	"context"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
+	abciAPI "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
+)
+
+// BaseFee returns the base fee (the minimum gas price) that transactions in the next block must
+// pay. In case the dynamic base fee is disabled, the base fee is zero.
+func (s *ImmutableState) BaseFee(ctx context.Context) (*quantity.Quantity, error) {
+	params, err := s.ConsensusParameters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.BaseFee.IsEnabled() {
+		return quantity.NewQuantity(), nil
+	}
+
+	value, err := s.is.Get(ctx, baseFeeKeyFmt.Encode())
+	if err != nil {
+		return nil, abciAPI.UnavailableStateError(err)
+	}
+	if value == nil {
+		// Not present means the minimum base fee.
+		return params.BaseFee.MinBaseFee.Clone(), nil
+	}
+
+	var q quantity.Quantity
+	if err = cbor.Unmarshal(value, &q); err != nil {
+		return nil, abciAPI.UnavailableStateError(err)
+	}
+	if q.Cmp(&params.BaseFee.MinBaseFee) < 0 {
+		// The minimum base fee may have been increased since the base fee was last updated.
+		return params.BaseFee.MinBaseFee.Clone(), nil
+	}
+	return &q, nil
+}
+
+// SetBaseFee sets the base fee that transactions in the next block must pay.
+func (s *MutableState) SetBaseFee(ctx context.Context, q *quantity.Quantity) error {
+	err := s.ms.Insert(ctx, baseFeeKeyFmt.Encode(), cbor.Marshal(q))
+	return abciAPI.UnavailableStateError(err)
+}
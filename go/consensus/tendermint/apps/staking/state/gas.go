@@ -21,17 +21,33 @@ func (fak feeAccumulatorKey) NewDefault() interface{} {
 // feeAccumulator is the per-block fee accumulator that gets all fees paid
 // in a block.
 type feeAccumulator struct {
+	// balance is the balance of fees that is disbursed to validators.
 	balance quantity.Quantity
+	// baseFees is the balance of base fee portions of fees.
+	baseFees quantity.Quantity
 }
 
-// AuthenticateAndPayFees authenticates the message signer and makes sure that
-// any gas fees are paid.
+// blockGasKey is the block context key.
+type blockGasKey struct{}
+
+func (bgk blockGasKey) NewDefault() interface{} {
+	// Track gas used by the block independently of the block gas accountant as the latter does
+	// not do any accounting when the maximum block gas is not limited.
+	return abciAPI.NewGasAccountant(transaction.Gas(math.MaxUint64))
+}
+
+// AuthenticateAndPayFees authenticates the message signer account and makes sure
+// that any gas fees are paid.
+//
+// In case the transaction is sponsored, the fees are paid by the given fee payer
+// account while the nonce is still checked against the signer account.
 //
 // This method transfers the fees to the per-block fee accumulator which is
 // persisted at the end of the block.
 func AuthenticateAndPayFees(
 	ctx *abciAPI.Context,
-	signer signature.PublicKey,
+	addr staking.Address,
+	feePayer staking.Address,
 	nonce uint64,
 	fee *transaction.Fee,
 ) error {
@@ -45,11 +61,12 @@ func AuthenticateAndPayFees(
 		return nil
 	}
 
-	// Convert signer's public key to account address.
-	addr := staking.NewAddress(signer)
 	if addr.IsReserved() {
 		return fmt.Errorf("using reserved account address %s is prohibited", addr)
 	}
+	if feePayer.IsReserved() {
+		return fmt.Errorf("using reserved fee payer address %s is prohibited", feePayer)
+	}
 
 	// Fetch account and make sure the nonce is correct.
 	account, err := state.Account(ctx, addr)
@@ -65,11 +82,19 @@ func AuthenticateAndPayFees(
 		return transaction.ErrInvalidNonce
 	}
 
+	// Fetch the fee payer account unless it is the same as the signer account.
+	payerAccount := account
+	if !feePayer.Equal(addr) {
+		if payerAccount, err = state.Account(ctx, feePayer); err != nil {
+			return fmt.Errorf("failed to fetch fee payer account state: %w", err)
+		}
+	}
+
 	if fee == nil {
 		fee = &transaction.Fee{}
 	}
 
-	// Account must have enough to pay fee and maintain minimum balance.
+	// Fee payer account must have enough to pay fee and maintain minimum balance.
 	needed := fee.Amount.Clone()
 	params, err := state.ConsensusParameters(ctx)
 	if err != nil {
@@ -80,16 +105,41 @@ func AuthenticateAndPayFees(
 	}
 
 	// Check against minimum balance plus fee.
-	if account.General.Balance.Cmp(needed) < 0 {
+	if payerAccount.General.Balance.Cmp(needed) < 0 {
 		logger.Error("account balance too low",
-			"account_addr", addr,
-			"account_balance", account.General.Balance,
+			"account_addr", feePayer,
+			"account_balance", payerAccount.General.Balance,
 			"min_transact_balance", params.MinTransactBalance,
 			"fee_amount", fee.Amount,
 		)
 		return staking.ErrBalanceTooLow
 	}
 
+	// Fees can not be paid from the amount locked by a vesting schedule.
+	if payerAccount.General.Vesting != nil && !fee.Amount.IsZero() {
+		remaining := payerAccount.General
+		remaining.Balance = *payerAccount.General.Balance.Clone()
+		_ = remaining.Balance.Sub(&fee.Amount)
+		if err = CheckSpendable(ctx, feePayer, &remaining); err != nil {
+			return err
+		}
+	}
+
+	// Check fee against the current base fee. Unlike the minimum gas price check below, this is
+	// deterministic and done both in CheckTx and DeliverTx.
+	baseFee, err := state.BaseFee(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch base fee: %w", err)
+	}
+	if !baseFee.IsZero() && fee.GasPrice().Cmp(baseFee) < 0 {
+		logger.Debug("gas price below base fee",
+			"account_addr", addr,
+			"gas_price", fee.GasPrice(),
+			"base_fee", baseFee,
+		)
+		return transaction.ErrGasPriceTooLow
+	}
+
 	if ctx.IsCheckOnly() {
 		// Configure gas accountant on the context so that we can report gas wanted.
 		ctx.SetGasAccountant(abciAPI.NewGasAccountant(fee.Gas))
@@ -109,9 +159,30 @@ func AuthenticateAndPayFees(
 
 	// Transfer fee to per-block fee accumulator.
 	feeAcc := ctx.BlockContext().Get(feeAccumulatorKey{}).(*feeAccumulator)
-	if err = quantity.Move(&feeAcc.balance, &account.General.Balance, &fee.Amount); err != nil {
+	if err = quantity.Move(&feeAcc.balance, &payerAccount.General.Balance, &fee.Amount); err != nil {
 		return fmt.Errorf("staking: failed to pay fees: %w", err)
 	}
+	// Separate the base fee portion of the fee (the fee covers it as checked above).
+	//
+	// NOTE: The base fee portion is computed from the gas limit and not from the amount of gas
+	//       actually used as fees are paid upfront, before the transaction is executed.
+	if !baseFee.IsZero() {
+		var gasQ quantity.Quantity
+		if err = gasQ.FromUint64(uint64(fee.Gas)); err != nil {
+			return fmt.Errorf("staking: failed to compute base fee portion: %w", err)
+		}
+		if err = baseFee.Mul(&gasQ); err != nil {
+			return fmt.Errorf("staking: failed to compute base fee portion: %w", err)
+		}
+		if err = quantity.Move(&feeAcc.baseFees, &feeAcc.balance, baseFee); err != nil {
+			return fmt.Errorf("staking: failed to separate base fee portion: %w", err)
+		}
+	}
+	if payerAccount != account {
+		if err = state.SetAccount(ctx, feePayer, payerAccount); err != nil {
+			return fmt.Errorf("failed to set fee payer account: %w", err)
+		}
+	}
 
 	account.General.Nonce++
 	if err := state.SetAccount(ctx, addr, account); err != nil {
@@ -121,7 +192,7 @@ func AuthenticateAndPayFees(
 	// Emit transfer event if fee is non-zero.
 	if !fee.Amount.IsZero() {
 		ctx.EmitEvent(abciAPI.NewEventBuilder(AppName).TypedAttribute(&staking.TransferEvent{
-			From:   addr,
+			From:   feePayer,
 			To:     staking.FeeAccumulatorAddress,
 			Amount: fee.Amount,
 		}))
@@ -131,17 +202,32 @@ func AuthenticateAndPayFees(
 	ctx.SetGasAccountant(abciAPI.NewCompositeGasAccountant(
 		abciAPI.NewGasAccountant(fee.Gas),
 		ctx.BlockContext().Get(abciAPI.GasAccountantKey{}).(abciAPI.GasAccountant),
+		ctx.BlockContext().Get(blockGasKey{}).(abciAPI.GasAccountant),
 	))
 
 	return nil
 }
 
 // BlockFees returns the accumulated fee balance for the current block.
+//
+// The base fee portions of the fees are not included, see BlockBaseFees.
 func BlockFees(ctx *abciAPI.Context) quantity.Quantity {
 	// Fetch accumulated fees in the current block.
 	return ctx.BlockContext().Get(feeAccumulatorKey{}).(*feeAccumulator).balance
 }
 
+// BlockBaseFees returns the accumulated base fee portions of the fees for the current block.
+func BlockBaseFees(ctx *abciAPI.Context) quantity.Quantity {
+	return ctx.BlockContext().Get(feeAccumulatorKey{}).(*feeAccumulator).baseFees
+}
+
+// BlockGasUsed returns the amount of gas used by transactions in the current block.
+//
+// Unlike the block gas accountant, this is tracked even when the maximum block gas is not limited.
+func BlockGasUsed(ctx *abciAPI.Context) transaction.Gas {
+	return ctx.BlockContext().Get(blockGasKey{}).(abciAPI.GasAccountant).GasUsed()
+}
+
 // proposerKey is the block context key.
 type proposerKey struct{}
 
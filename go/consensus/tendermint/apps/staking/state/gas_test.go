@@ -0,0 +1,119 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
+	abciAPI "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
+	staking "github.com/oasisprotocol/oasis-core/go/staking/api"
+)
+
+func TestAuthenticateAndPayFeesSponsored(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Unix(1580461674, 0)
+	appState := abciAPI.NewMockApplicationState(&abciAPI.MockApplicationStateConfig{})
+	ctx := appState.NewContext(abciAPI.ContextDeliverTx, now)
+	defer ctx.Close()
+	ctx.BlockContext().Set(abciAPI.GasAccountantKey{}, abciAPI.NewNopGasAccountant())
+
+	// Prepare state.
+	s := NewMutableState(ctx.State())
+	ctxEB := appState.NewContext(abciAPI.ContextEndBlock, now)
+	defer ctxEB.Close()
+	err := s.SetConsensusParameters(ctxEB, &staking.ConsensusParameters{
+		MinTransactBalance: *quantity.NewFromUint64(10),
+	})
+	require.NoError(err, "SetConsensusParameters")
+
+	// The sender has an empty account, the sponsor pays the fees.
+	sender := staking.NewAddress(signature.NewPublicKey("aaafffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"))
+	sponsor := staking.NewAddress(signature.NewPublicKey("bbbfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"))
+	err = s.SetAccount(ctx, sponsor, &staking.Account{
+		General: staking.GeneralAccount{
+			Balance: *quantity.NewFromUint64(100),
+			Nonce:   7,
+		},
+	})
+	require.NoError(err, "SetAccount")
+
+	fee := &transaction.Fee{Amount: *quantity.NewFromUint64(50), Gas: 1000}
+
+	// Without sponsorship the sender can not pay the fees.
+	err = AuthenticateAndPayFees(ctx, sender, sender, 0, fee)
+	require.ErrorIs(err, staking.ErrBalanceTooLow, "unsponsored transaction should fail")
+
+	// Nonce is checked against the sender.
+	err = AuthenticateAndPayFees(ctx, sender, sponsor, 7, fee)
+	require.ErrorIs(err, transaction.ErrInvalidNonce, "sponsor nonce should not be used")
+
+	err = AuthenticateAndPayFees(ctx, sender, sponsor, 0, fee)
+	require.NoError(err, "AuthenticateAndPayFees")
+
+	senderAcct, err := s.Account(ctx, sender)
+	require.NoError(err, "Account(sender)")
+	require.EqualValues(1, senderAcct.General.Nonce, "sender nonce should be incremented")
+	require.True(senderAcct.General.Balance.IsZero(), "sender balance should not change")
+
+	sponsorAcct, err := s.Account(ctx, sponsor)
+	require.NoError(err, "Account(sponsor)")
+	require.EqualValues(7, sponsorAcct.General.Nonce, "sponsor nonce should not change")
+	require.EqualValues(*quantity.NewFromUint64(50), sponsorAcct.General.Balance, "fee should be charged from the sponsor")
+	require.EqualValues(*quantity.NewFromUint64(50), BlockFees(ctx), "fee should be accumulated")
+
+	// Sponsor must maintain the minimum transact balance.
+	err = AuthenticateAndPayFees(ctx, sender, sponsor, 1, fee)
+	require.ErrorIs(err, staking.ErrBalanceTooLow, "sponsor without enough balance should fail")
+}
+
+func TestAuthenticateAndPayFeesBaseFee(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Unix(1580461674, 0)
+	appState := abciAPI.NewMockApplicationState(&abciAPI.MockApplicationStateConfig{})
+	ctx := appState.NewContext(abciAPI.ContextDeliverTx, now)
+	defer ctx.Close()
+	ctx.BlockContext().Set(abciAPI.GasAccountantKey{}, abciAPI.NewNopGasAccountant())
+
+	// Prepare state.
+	s := NewMutableState(ctx.State())
+	ctxEB := appState.NewContext(abciAPI.ContextEndBlock, now)
+	defer ctxEB.Close()
+	err := s.SetConsensusParameters(ctxEB, &staking.ConsensusParameters{
+		BaseFee: staking.BaseFeeParameters{
+			TargetBlockGas:       1_000,
+			MinBaseFee:           *quantity.NewFromUint64(1),
+			MaxChangeDenominator: 8,
+		},
+	})
+	require.NoError(err, "SetConsensusParameters")
+
+	baseFee, err := s.BaseFee(ctx)
+	require.NoError(err, "BaseFee")
+	require.EqualValues(*quantity.NewFromUint64(1), *baseFee, "initial base fee should be the minimum base fee")
+	err = s.SetBaseFee(ctxEB, quantity.NewFromUint64(2))
+	require.NoError(err, "SetBaseFee")
+
+	sender := staking.NewAddress(signature.NewPublicKey("aaafffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"))
+	err = s.SetAccount(ctx, sender, &staking.Account{
+		General: staking.GeneralAccount{
+			Balance: *quantity.NewFromUint64(1_000),
+		},
+	})
+	require.NoError(err, "SetAccount")
+
+	// Gas price below the base fee should be rejected.
+	err = AuthenticateAndPayFees(ctx, sender, sender, 0, &transaction.Fee{Amount: *quantity.NewFromUint64(100), Gas: 100})
+	require.ErrorIs(err, transaction.ErrGasPriceTooLow, "gas price below base fee should fail")
+
+	// The base fee portion of the fee should be accounted separately.
+	err = AuthenticateAndPayFees(ctx, sender, sender, 0, &transaction.Fee{Amount: *quantity.NewFromUint64(250), Gas: 100})
+	require.NoError(err, "AuthenticateAndPayFees")
+	require.EqualValues(*quantity.NewFromUint64(200), BlockBaseFees(ctx), "base fee portion should be accumulated")
+	require.EqualValues(*quantity.NewFromUint64(50), BlockFees(ctx), "tip should be accumulated")
+}
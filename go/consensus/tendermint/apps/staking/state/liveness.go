@@ -0,0 +1,111 @@
+package state
+
+import (
+	"context"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	abciAPI "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
+)
+
+// ValidatorLiveness is the liveness tracking information of a single validator node over a
+// sliding window of the most recent blocks.
+type ValidatorLiveness struct {
+	// Window is the size of the sliding window.
+	Window uint64 `json:"window"`
+	// Blocks is the number of blocks the validator has been tracked for.
+	Blocks uint64 `json:"blocks"`
+	// Missed is the number of blocks in the window that the validator did not sign.
+	Missed uint64 `json:"missed"`
+	// MissedBitmap is a ring buffer of blocks in the window that the validator did not sign.
+	MissedBitmap []byte `json:"missed_bitmap"`
+	// LastHeight is the height at which the information was last updated.
+	LastHeight int64 `json:"last_height"`
+}
+
+// NewValidatorLiveness creates new empty validator liveness information for the given window.
+func NewValidatorLiveness(window uint64) *ValidatorLiveness {
+	return &ValidatorLiveness{
+		Window:       window,
+		MissedBitmap: make([]byte, (window+7)/8),
+	}
+}
+
+// Update records whether the validator signed the next block, evicting the oldest block from
+// the window when the window is full.
+func (vl *ValidatorLiveness) Update(signed bool) {
+	idx := vl.Blocks % vl.Window
+	byteIdx, mask := idx/8, byte(1)<<(idx%8)
+
+	// Evict the oldest block.
+	if vl.MissedBitmap[byteIdx]&mask != 0 {
+		vl.MissedBitmap[byteIdx] &^= mask
+		vl.Missed--
+	}
+	if !signed {
+		vl.MissedBitmap[byteIdx] |= mask
+		vl.Missed++
+	}
+	vl.Blocks++
+}
+
+// IsBelowThreshold returns true iff the window is full and the validator signed fewer than the
+// given minimum number of blocks in the window.
+func (vl *ValidatorLiveness) IsBelowThreshold(minSignedBlocks uint64) bool {
+	return vl.Blocks >= vl.Window && vl.Window-vl.Missed < minSignedBlocks
+}
+
+// ValidatorLiveness returns the liveness tracking information of the given validator node.
+//
+// In case no information is being tracked, nil is returned.
+func (s *ImmutableState) ValidatorLiveness(ctx context.Context, nodeID signature.PublicKey) (*ValidatorLiveness, error) {
+	value, err := s.is.Get(ctx, validatorLivenessKeyFmt.Encode(&nodeID))
+	if err != nil {
+		return nil, abciAPI.UnavailableStateError(err)
+	}
+	if value == nil {
+		return nil, nil
+	}
+
+	var vl ValidatorLiveness
+	if err = cbor.Unmarshal(value, &vl); err != nil {
+		return nil, abciAPI.UnavailableStateError(err)
+	}
+	return &vl, nil
+}
+
+// ValidatorLivenesses returns the liveness tracking information of all tracked validator nodes.
+func (s *ImmutableState) ValidatorLivenesses(ctx context.Context) (map[signature.PublicKey]*ValidatorLiveness, error) {
+	it := s.is.NewIterator(ctx)
+	defer it.Close()
+
+	livenesses := make(map[signature.PublicKey]*ValidatorLiveness)
+	for it.Seek(validatorLivenessKeyFmt.Encode()); it.Valid(); it.Next() {
+		var nodeID signature.PublicKey
+		if !validatorLivenessKeyFmt.Decode(it.Key(), &nodeID) {
+			break
+		}
+
+		var vl ValidatorLiveness
+		if err := cbor.Unmarshal(it.Value(), &vl); err != nil {
+			return nil, abciAPI.UnavailableStateError(err)
+		}
+		livenesses[nodeID] = &vl
+	}
+	if it.Err() != nil {
+		return nil, abciAPI.UnavailableStateError(it.Err())
+	}
+	return livenesses, nil
+}
+
+// SetValidatorLiveness sets the liveness tracking information of the given validator node.
+func (s *MutableState) SetValidatorLiveness(ctx context.Context, nodeID signature.PublicKey, vl *ValidatorLiveness) error {
+	err := s.ms.Insert(ctx, validatorLivenessKeyFmt.Encode(&nodeID), cbor.Marshal(vl))
+	return abciAPI.UnavailableStateError(err)
+}
+
+// RemoveValidatorLiveness removes the liveness tracking information of the given validator node.
+func (s *MutableState) RemoveValidatorLiveness(ctx context.Context, nodeID signature.PublicKey) error {
+	err := s.ms.Remove(ctx, validatorLivenessKeyFmt.Encode(&nodeID))
+	return abciAPI.UnavailableStateError(err)
+}
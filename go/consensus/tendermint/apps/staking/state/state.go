@@ -73,6 +73,27 @@ var (
 	// Value is CBOR-serialized delegation.
 	delegationKeyReverseFmt = keyformat.New(0x5A, &staking.Address{}, &staking.Address{})
 
+	// redelegationKeyFmt is the key format used for redelegations which are still
+	// exposed to slashing of the source escrow account (source escrow address,
+	// delegator address, destination escrow address, exposure end epoch).
+	//
+	// Value is CBOR-serialized redelegation.
+	redelegationKeyFmt = keyformat.New(0x5B, &staking.Address{}, &staking.Address{}, &staking.Address{}, uint64(0))
+	// redelegationQueueKeyFmt is the redelegation exposure queue key format
+	// (exposure end epoch, source escrow address, delegator address, destination escrow address).
+	//
+	// Value is empty.
+	redelegationQueueKeyFmt = keyformat.New(0x5C, uint64(0), &staking.Address{}, &staking.Address{}, &staking.Address{})
+	// validatorLivenessKeyFmt is the key format used for validator liveness
+	// tracking (node ID).
+	//
+	// Value is CBOR-serialized ValidatorLiveness.
+	validatorLivenessKeyFmt = keyformat.New(0x5D, &signature.PublicKey{})
+	// baseFeeKeyFmt is the key format used for the current base fee.
+	//
+	// Value is a CBOR-serialized quantity.
+	baseFeeKeyFmt = keyformat.New(0x5E)
+
 	logger = logging.GetLogger("tendermint/staking")
 )
 
@@ -473,6 +494,131 @@ func (s *ImmutableState) ExpiredDebondingQueue(ctx context.Context, epoch beacon
 	return entries, nil
 }
 
+func (s *ImmutableState) Redelegations(
+	ctx context.Context,
+) (map[staking.Address]map[staking.Address][]*staking.Redelegation, error) {
+	it := s.is.NewIterator(ctx)
+	defer it.Close()
+
+	redelegations := make(map[staking.Address]map[staking.Address][]*staking.Redelegation)
+	for it.Seek(redelegationKeyFmt.Encode()); it.Valid(); it.Next() {
+		var sourceAddr staking.Address
+		var delegatorAddr staking.Address
+		if !redelegationKeyFmt.Decode(it.Key(), &sourceAddr, &delegatorAddr) {
+			break
+		}
+
+		var rd staking.Redelegation
+		if err := cbor.Unmarshal(it.Value(), &rd); err != nil {
+			return nil, abciAPI.UnavailableStateError(err)
+		}
+
+		if redelegations[sourceAddr] == nil {
+			redelegations[sourceAddr] = make(map[staking.Address][]*staking.Redelegation)
+		}
+		redelegations[sourceAddr][delegatorAddr] = append(redelegations[sourceAddr][delegatorAddr], &rd)
+	}
+	if it.Err() != nil {
+		return nil, abciAPI.UnavailableStateError(it.Err())
+	}
+	return redelegations, nil
+}
+
+type RedelegationEntry struct {
+	DelegatorAddr staking.Address
+	Redelegation  *staking.Redelegation
+}
+
+// RedelegationsFrom returns the redelegations from the given source escrow account which are
+// still exposed to its slashing, ordered by delegator address.
+func (s *ImmutableState) RedelegationsFrom(
+	ctx context.Context,
+	sourceAddr staking.Address,
+) ([]*RedelegationEntry, error) {
+	it := s.is.NewIterator(ctx)
+	defer it.Close()
+
+	var entries []*RedelegationEntry
+	for it.Seek(redelegationKeyFmt.Encode(&sourceAddr)); it.Valid(); it.Next() {
+		var decSourceAddr staking.Address
+		var delegatorAddr staking.Address
+		if !redelegationKeyFmt.Decode(it.Key(), &decSourceAddr, &delegatorAddr) {
+			break
+		}
+		if !decSourceAddr.Equal(sourceAddr) {
+			break
+		}
+
+		var rd staking.Redelegation
+		if err := cbor.Unmarshal(it.Value(), &rd); err != nil {
+			return nil, abciAPI.UnavailableStateError(err)
+		}
+
+		entries = append(entries, &RedelegationEntry{
+			DelegatorAddr: delegatorAddr,
+			Redelegation:  &rd,
+		})
+	}
+	if it.Err() != nil {
+		return nil, abciAPI.UnavailableStateError(it.Err())
+	}
+	return entries, nil
+}
+
+func (s *ImmutableState) Redelegation(
+	ctx context.Context,
+	sourceAddr, delegatorAddr, destAddr staking.Address,
+	epoch beacon.EpochTime,
+) (*staking.Redelegation, error) {
+	value, err := s.is.Get(ctx, redelegationKeyFmt.Encode(&sourceAddr, &delegatorAddr, &destAddr, uint64(epoch)))
+	if err != nil {
+		return nil, abciAPI.UnavailableStateError(err)
+	}
+	if value == nil {
+		return &staking.Redelegation{To: destAddr, ExposureEndTime: epoch}, nil
+	}
+
+	var rd staking.Redelegation
+	if err = cbor.Unmarshal(value, &rd); err != nil {
+		return nil, abciAPI.UnavailableStateError(err)
+	}
+	return &rd, nil
+}
+
+type RedelegationQueueEntry struct {
+	Epoch         beacon.EpochTime
+	SourceAddr    staking.Address
+	DelegatorAddr staking.Address
+	DestAddr      staking.Address
+}
+
+// ExpiredRedelegationQueue returns the redelegations which stopped being exposed to slashing of
+// the source escrow account at or before the given epoch.
+func (s *ImmutableState) ExpiredRedelegationQueue(ctx context.Context, epoch beacon.EpochTime) ([]*RedelegationQueueEntry, error) {
+	it := s.is.NewIterator(ctx)
+	defer it.Close()
+
+	var entries []*RedelegationQueueEntry
+	for it.Seek(redelegationQueueKeyFmt.Encode()); it.Valid(); it.Next() {
+		var decEpoch uint64
+		var sourceAddr, delegatorAddr, destAddr staking.Address
+		if !redelegationQueueKeyFmt.Decode(it.Key(), &decEpoch, &sourceAddr, &delegatorAddr, &destAddr) || decEpoch > uint64(epoch) {
+			break
+		}
+
+		entries = append(entries, &RedelegationQueueEntry{
+			Epoch:         beacon.EpochTime(decEpoch),
+			SourceAddr:    sourceAddr,
+			DelegatorAddr: delegatorAddr,
+			DestAddr:      destAddr,
+		})
+	}
+	if it.Err() != nil {
+		return nil, abciAPI.UnavailableStateError(it.Err())
+	}
+	return entries, nil
+}
+
 func (s *ImmutableState) Slashing(ctx context.Context) (map[staking.SlashReason]staking.Slash, error) {
 	params, err := s.ConsensusParameters(ctx)
 	if err != nil {
@@ -686,6 +832,32 @@ func (s *MutableState) RemoveFromDebondingQueue(
 	return abciAPI.UnavailableStateError(err)
 }
 
+// SetRedelegation sets a redelegation record, adding it to the redelegation exposure queue.
+//
+// If the redelegation amount is zero, the record is removed instead.
+func (s *MutableState) SetRedelegation(
+	ctx context.Context,
+	sourceAddr, delegatorAddr staking.Address,
+	rd *staking.Redelegation,
+) error {
+	key := redelegationKeyFmt.Encode(&sourceAddr, &delegatorAddr, &rd.To, uint64(rd.ExposureEndTime))
+	queueKey := redelegationQueueKeyFmt.Encode(uint64(rd.ExposureEndTime), &sourceAddr, &delegatorAddr, &rd.To)
+
+	if rd.Amount.IsZero() {
+		if err := s.ms.Remove(ctx, queueKey); err != nil {
+			return abciAPI.UnavailableStateError(err)
+		}
+		err := s.ms.Remove(ctx, key)
+		return abciAPI.UnavailableStateError(err)
+	}
+
+	if err := s.ms.Insert(ctx, queueKey, []byte{}); err != nil {
+		return abciAPI.UnavailableStateError(err)
+	}
+	err := s.ms.Insert(ctx, key, cbor.Marshal(rd))
+	return abciAPI.UnavailableStateError(err)
+}
+
 func (s *MutableState) SetLastBlockFees(ctx context.Context, q *quantity.Quantity) error {
 	err := s.ms.Insert(ctx, lastBlockFeesKeyFmt.Encode(), cbor.Marshal(q))
 	return abciAPI.UnavailableStateError(err)
@@ -727,10 +899,129 @@ func slashPool(dst *quantity.Quantity, p *staking.SharePool, amount, total *quan
 	return nil
 }
 
+// exposedRedelegations returns the redelegations from the given account which are
+// still exposed to its slashing.
+func (s *MutableState) exposedRedelegations(ctx *abciAPI.Context, sourceAddr staking.Address) ([]*RedelegationEntry, error) {
+	entries, err := s.RedelegationsFrom(ctx, sourceAddr)
+	if err != nil {
+		return nil, fmt.Errorf("tendermint/staking: failed to query redelegations from %s: %w", sourceAddr, err)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	epoch, err := ctx.AppState().GetCurrentEpoch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("tendermint/staking: failed to get current epoch: %w", err)
+	}
+
+	// Expired redelegations are only pruned on epoch transitions.
+	var exposed []*RedelegationEntry
+	for _, e := range entries {
+		if e.Redelegation.ExposureEndTime > epoch {
+			exposed = append(exposed, e)
+		}
+	}
+	return exposed, nil
+}
+
+// slashRedelegation slashes the delegator's active delegation to the redelegation destination
+// by the same fraction as the source account is being slashed, moving the slashed stake to dst.
+//
+// At most the redelegated amount that is still exposed is slashed. In case the delegator has
+// since reclaimed (part of) the redelegated stake, only the remaining active delegation is slashed.
+func (s *MutableState) slashRedelegation(
+	ctx *abciAPI.Context,
+	dst *quantity.Quantity,
+	sourceAddr staking.Address,
+	e *RedelegationEntry,
+	amount, total *quantity.Quantity,
+) error {
+	rd := e.Redelegation
+
+	// slashAmount = min(amount * rd.Amount / total, rd.Amount)
+	slashAmount := rd.Amount.Clone()
+	if err := slashAmount.Mul(amount); err != nil {
+		return fmt.Errorf("slashAmount.Mul: %w", err)
+	}
+	if err := slashAmount.Quo(total); err != nil {
+		return fmt.Errorf("slashAmount.Quo: %w", err)
+	}
+	if slashAmount.Cmp(&rd.Amount) > 0 {
+		slashAmount = rd.Amount.Clone()
+	}
+	if slashAmount.IsZero() {
+		return nil
+	}
+
+	dest, err := s.Account(ctx, rd.To)
+	if err != nil {
+		return fmt.Errorf("failed to query account %s: %w", rd.To, err)
+	}
+	delegation, err := s.Delegation(ctx, e.DelegatorAddr, rd.To)
+	if err != nil {
+		return fmt.Errorf("failed to query delegation: %w", err)
+	}
+	value, err := dest.Escrow.Active.StakeForShares(&delegation.Shares)
+	if err != nil {
+		return fmt.Errorf("failed to compute delegation value: %w", err)
+	}
+
+	var slashed quantity.Quantity
+	if !value.IsZero() {
+		// Slash the number of shares proportional to the slashed part of the delegation value.
+		shares := delegation.Shares.Clone()
+		if slashAmount.Cmp(value) < 0 {
+			if err = shares.Mul(slashAmount); err != nil {
+				return fmt.Errorf("shares.Mul: %w", err)
+			}
+			if err = shares.Quo(value); err != nil {
+				return fmt.Errorf("shares.Quo: %w", err)
+			}
+		}
+		if err = dest.Escrow.Active.Withdraw(&slashed, &delegation.Shares, shares); err != nil {
+			return fmt.Errorf("failed to withdraw delegation shares: %w", err)
+		}
+
+		if err = s.SetDelegation(ctx, e.DelegatorAddr, rd.To, delegation); err != nil {
+			return fmt.Errorf("failed to set delegation: %w", err)
+		}
+		if err = s.SetAccount(ctx, rd.To, dest); err != nil {
+			return fmt.Errorf("failed to set account: %w", err)
+		}
+	}
+
+	// Reduce the exposed amount so the same stake is not slashed more than it would have been
+	// if it remained in the source account.
+	if err = rd.Amount.Sub(slashAmount); err != nil {
+		return fmt.Errorf("rd.Amount.Sub: %w", err)
+	}
+	if err = s.SetRedelegation(ctx, sourceAddr, e.DelegatorAddr, rd); err != nil {
+		return fmt.Errorf("failed to set redelegation: %w", err)
+	}
+
+	if err = dst.Add(&slashed); err != nil {
+		return fmt.Errorf("dst.Add: %w", err)
+	}
+
+	if !ctx.IsCheckOnly() && !slashed.IsZero() {
+		ctx.EmitEvent(abciAPI.NewEventBuilder(AppName).TypedAttribute(&staking.TakeEscrowEvent{
+			Owner:  rd.To,
+			Amount: slashed,
+		}))
+	}
+
+	return nil
+}
+
 // SlashEscrow slashes the escrow balance and the escrow-but-undergoing-debonding
 // balance of the account, transferring it to the global common pool, returning
 // the amount actually slashed.
 //
+// Stake redelegated away from the account which is still exposed to slashing of
+// the account is slashed from the delegators' active delegations to the
+// redelegation destinations and included in the returned amount.
+//
 // WARNING: This is an internal routine to be used to implement staking policy,
 // and MUST NOT be exposed outside of backend implementations.
 func (s *MutableState) SlashEscrow(
@@ -740,6 +1031,7 @@ func (s *MutableState) SlashEscrow(
 ) (*quantity.Quantity, error) {
 	var activeSlashed quantity.Quantity
 	var debondingSlashed quantity.Quantity
+	var redelegationsSlashed quantity.Quantity
 
 	commonPool, err := s.CommonPool(ctx)
 	if err != nil {
@@ -751,23 +1043,42 @@ func (s *MutableState) SlashEscrow(
 		return nil, fmt.Errorf("tendermint/staking: failed to query account %s: %w", fromAddr, err)
 	}
 
+	redelegations, err := s.exposedRedelegations(ctx, fromAddr)
+	if err != nil {
+		return nil, err
+	}
+
 	// Compute the amount we need to slash each pool. The amount is split
-	// between the pools based on relative total balance.
+	// between the pools based on relative total balance. Redelegated stake
+	// which is still exposed to slashing counts as if it was still escrowed.
 	total := from.Escrow.Active.Balance.Clone()
 	if err = total.Add(&from.Escrow.Debonding.Balance); err != nil {
 		return nil, fmt.Errorf("tendermint/staking: account total balance: %w", err)
 	}
+	for _, e := range redelegations {
+		if err = total.Add(&e.Redelegation.Amount); err != nil {
+			return nil, fmt.Errorf("tendermint/staking: account total balance: %w", err)
+		}
+	}
 	if err = slashPool(&activeSlashed, &from.Escrow.Active, amount, total); err != nil {
 		return nil, fmt.Errorf("tendermint/staking: failed slashing active escrow: %w", err)
 	}
 	if err = slashPool(&debondingSlashed, &from.Escrow.Debonding, amount, total); err != nil {
 		return nil, fmt.Errorf("tendermint/staking: failed slashing debonding escrow: %w", err)
 	}
+	for _, e := range redelegations {
+		if err = s.slashRedelegation(ctx, &redelegationsSlashed, fromAddr, e, amount, total); err != nil {
+			return nil, fmt.Errorf("tendermint/staking: failed slashing redelegation: %w", err)
+		}
+	}
 
 	totalSlashed := activeSlashed.Clone()
 	if err = totalSlashed.Add(&debondingSlashed); err != nil {
 		return nil, fmt.Errorf("tendermint/staking: failed totalling slashed amounts: %w", err)
 	}
+	if err = totalSlashed.Add(&redelegationsSlashed); err != nil {
+		return nil, fmt.Errorf("tendermint/staking: failed totalling slashed amounts: %w", err)
+	}
 	// Nothing was slashed.
 	if totalSlashed.IsZero() {
 		return totalSlashed, nil
@@ -785,9 +1096,11 @@ func (s *MutableState) SlashEscrow(
 	}
 
 	if !ctx.IsCheckOnly() {
+		sourceSlashed := activeSlashed.Clone()
+		_ = sourceSlashed.Add(&debondingSlashed)
 		ctx.EmitEvent(abciAPI.NewEventBuilder(AppName).TypedAttribute(&staking.TakeEscrowEvent{
 			Owner:           fromAddr,
-			Amount:          *totalSlashed,
+			Amount:          *sourceSlashed,
 			DebondingAmount: debondingSlashed,
 		}))
 	}
@@ -813,6 +1126,9 @@ func (s *MutableState) Transfer(ctx *abciAPI.Context, fromAddr, toAddr staking.A
 	if err = quantity.Move(&to.General.Balance, &from.General.Balance, amount); err != nil {
 		return staking.ErrInsufficientBalance
 	}
+	if err = CheckSpendable(ctx, fromAddr, &from.General); err != nil {
+		return err
+	}
 
 	// Check against minimum balance.
 	params, err := s.ConsensusParameters(ctx)
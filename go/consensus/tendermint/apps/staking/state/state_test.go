@@ -419,6 +419,105 @@ func TestRewardAndSlash(t *testing.T) {
 	require.Equal(mustInitQuantityP(t, 9827), commonPool, "reward attenuated - common pool")
 }
 
+func TestSlashRedelegation(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Unix(1580461674, 0)
+	cfg := &abciAPI.MockApplicationStateConfig{CurrentEpoch: 5}
+	appState := abciAPI.NewMockApplicationState(cfg)
+	ctx := appState.NewContext(abciAPI.ContextEndBlock, now)
+	defer ctx.Close()
+
+	s := NewMutableState(ctx.State())
+
+	delegatorAddr := staking.NewAddress(signature.NewPublicKey("1000000000000000000000000000000000000000000000000000000000000000"))
+	srcAddr := staking.NewAddress(signature.NewPublicKey("2000000000000000000000000000000000000000000000000000000000000000"))
+	dstAddr := staking.NewAddress(signature.NewPublicKey("3000000000000000000000000000000000000000000000000000000000000000"))
+
+	// The delegator has 100 base units delegated to the source and 100 base units
+	// redelegated from the source to the destination.
+	balance := mustInitQuantity(t, 100)
+	srcAccount := &staking.Account{}
+	srcDel := &staking.Delegation{}
+	_, err := srcAccount.Escrow.Active.Deposit(&srcDel.Shares, &balance, mustInitQuantityP(t, 100))
+	require.NoError(err, "active escrow deposit")
+	dstAccount := &staking.Account{}
+	dstDel := &staking.Delegation{}
+	balance = mustInitQuantity(t, 100)
+	_, err = dstAccount.Escrow.Active.Deposit(&dstDel.Shares, &balance, mustInitQuantityP(t, 100))
+	require.NoError(err, "active escrow deposit")
+
+	require.NoError(s.SetConsensusParameters(ctx, &staking.ConsensusParameters{}), "SetConsensusParameters")
+	require.NoError(s.SetCommonPool(ctx, mustInitQuantityP(t, 1000)), "SetCommonPool")
+	require.NoError(s.SetAccount(ctx, srcAddr, srcAccount), "SetAccount")
+	require.NoError(s.SetAccount(ctx, dstAddr, dstAccount), "SetAccount")
+	require.NoError(s.SetDelegation(ctx, delegatorAddr, srcAddr, srcDel), "SetDelegation")
+	require.NoError(s.SetDelegation(ctx, delegatorAddr, dstAddr, dstDel), "SetDelegation")
+	require.NoError(s.SetRedelegation(ctx, srcAddr, delegatorAddr, &staking.Redelegation{
+		To:              dstAddr,
+		Amount:          mustInitQuantity(t, 100),
+		ExposureEndTime: 10,
+	}), "SetRedelegation")
+
+	rds, err := s.RedelegationsFrom(ctx, srcAddr)
+	require.NoError(err, "RedelegationsFrom")
+	require.Len(rds, 1, "there should be one redelegation from the source account")
+
+	// Slash 100 base units, split equally between the source and the exposed redelegation.
+	slashed, err := s.SlashEscrow(ctx, srcAddr, mustInitQuantityP(t, 100))
+	require.NoError(err, "SlashEscrow")
+	require.Equal(mustInitQuantityP(t, 100), slashed, "slashed amount should include the redelegation")
+
+	evs := ctx.GetEvents()
+	require.Len(evs, 2, "slashing should emit 2 events")
+	var v staking.TakeEscrowEvent
+	require.NoError(events.DecodeValue(string(evs[0].Attributes[0].Value), &v), "malformed take escrow event")
+	require.Equal(staking.TakeEscrowEvent{Owner: dstAddr, Amount: mustInitQuantity(t, 50)}, v, "redelegation slash event should be correct")
+	require.NoError(events.DecodeValue(string(evs[1].Attributes[0].Value), &v), "malformed take escrow event")
+	require.Equal(staking.TakeEscrowEvent{Owner: srcAddr, Amount: mustInitQuantity(t, 50)}, v, "source slash event should be correct")
+
+	srcAccount, err = s.Account(ctx, srcAddr)
+	require.NoError(err, "Account")
+	require.Equal(mustInitQuantity(t, 50), srcAccount.Escrow.Active.Balance, "source active escrow")
+	dstAccount, err = s.Account(ctx, dstAddr)
+	require.NoError(err, "Account")
+	require.Equal(mustInitQuantity(t, 50), dstAccount.Escrow.Active.Balance, "destination active escrow")
+	dstDel, err = s.Delegation(ctx, delegatorAddr, dstAddr)
+	require.NoError(err, "Delegation")
+	require.Equal(mustInitQuantity(t, 50), dstDel.Shares, "destination delegation shares")
+	rd, err := s.Redelegation(ctx, srcAddr, delegatorAddr, dstAddr, 10)
+	require.NoError(err, "Redelegation")
+	require.Equal(mustInitQuantity(t, 50), rd.Amount, "remaining exposed redelegation amount")
+	commonPool, err := s.CommonPool(ctx)
+	require.NoError(err, "CommonPool")
+	require.Equal(mustInitQuantityP(t, 1100), commonPool, "common pool")
+
+	// After the exposure period ends, only the source is slashed.
+	cfg.CurrentEpoch = 10
+	appState.UpdateMockApplicationStateConfig(cfg)
+	ctx = appState.NewContext(abciAPI.ContextEndBlock, now)
+	defer ctx.Close()
+
+	slashed, err = s.SlashEscrow(ctx, srcAddr, mustInitQuantityP(t, 10))
+	require.NoError(err, "SlashEscrow")
+	require.Equal(mustInitQuantityP(t, 10), slashed, "slashed amount should not include the redelegation")
+	dstAccount, err = s.Account(ctx, dstAddr)
+	require.NoError(err, "Account")
+	require.Equal(mustInitQuantity(t, 50), dstAccount.Escrow.Active.Balance, "destination active escrow after exposure")
+
+	// Expired redelegations should be in the queue.
+	expired, err := s.ExpiredRedelegationQueue(ctx, 10)
+	require.NoError(err, "ExpiredRedelegationQueue")
+	require.Len(expired, 1, "there should be one expired redelegation")
+	require.NoError(s.SetRedelegation(ctx, srcAddr, delegatorAddr, &staking.Redelegation{To: dstAddr, ExposureEndTime: 10}), "SetRedelegation")
+	rds, err = s.RedelegationsFrom(ctx, srcAddr)
+	require.NoError(err, "RedelegationsFrom")
+	require.Empty(rds, "there should be no redelegations after removal")
+	expired, err = s.ExpiredRedelegationQueue(ctx, 10)
+	require.NoError(err, "ExpiredRedelegationQueue")
+	require.Empty(expired, "there should be no expired redelegations after removal")
+}
+
 func TestEpochSigning(t *testing.T) {
 	require := require.New(t)
 
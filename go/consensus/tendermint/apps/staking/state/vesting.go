@@ -0,0 +1,33 @@
+package state
+
+import (
+	"fmt"
+
+	abciAPI "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
+	staking "github.com/oasisprotocol/oasis-core/go/staking/api"
+)
+
+// CheckSpendable makes sure that the general balance of the given account is not lower than the
+// amount which is still locked by the account's vesting schedule (if any) at the current epoch.
+//
+// It should be called after the general balance has been reduced by any operation other than
+// escrowing.
+func CheckSpendable(ctx *abciAPI.Context, addr staking.Address, ga *staking.GeneralAccount) error {
+	if ga.Vesting == nil {
+		return nil
+	}
+
+	epoch, err := ctx.AppState().GetCurrentEpoch(ctx)
+	if err != nil {
+		return fmt.Errorf("tendermint/staking: failed to get current epoch: %w", err)
+	}
+	if err = ga.CheckSpendable(epoch); err != nil {
+		ctx.Logger().Debug("account balance locked by vesting schedule",
+			"account_addr", addr,
+			"account_balance", ga.Balance,
+			"locked", ga.LockedBalance(epoch),
+		)
+		return err
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package staking
 import (
 	"fmt"
 
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
 	"github.com/oasisprotocol/oasis-core/go/common/errors"
 	"github.com/oasisprotocol/oasis-core/go/common/quantity"
 	"github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
@@ -128,6 +129,9 @@ func (app *stakingApplication) transferImpl(
 			)
 			return err
 		}
+		if err = stakingState.CheckSpendable(ctx, fromAddr, &from.General); err != nil {
+			return err
+		}
 
 		// Check against minimum balance.
 		if from.General.Balance.Cmp(&params.MinTransactBalance) < 0 {
@@ -216,6 +220,9 @@ func (app *stakingApplication) burnImpl(
 		)
 		return err
 	}
+	if err = stakingState.CheckSpendable(ctx, fromAddr, &from.General); err != nil {
+		return err
+	}
 
 	// Check against minimum balance.
 	if from.General.Balance.Cmp(&params.MinTransactBalance) < 0 {
@@ -327,6 +334,17 @@ func (app *stakingApplication) addEscrow(ctx *api.Context, state *stakingState.M
 		return nil, err
 	}
 
+	// Track escrowed tokens that are still locked by the vesting schedule.
+	if from.General.Vesting != nil {
+		var epoch beacon.EpochTime
+		if epoch, err = app.state.GetCurrentEpoch(ctx); err != nil {
+			return nil, fmt.Errorf("failed to get current epoch: %w", err)
+		}
+		if err = from.General.DelegateVesting(epoch, &escrow.Amount); err != nil {
+			return nil, fmt.Errorf("failed to track delegated vesting: %w", err)
+		}
+	}
+
 	// Check against minimum balance.
 	if from.General.Balance.Cmp(&params.MinTransactBalance) < 0 {
 		ctx.Logger().Debug("after add escrow account balance too low",
@@ -530,6 +548,168 @@ func (app *stakingApplication) reclaimEscrow(ctx *api.Context, state *stakingSta
 	}, nil
 }
 
+func (app *stakingApplication) redelegate(ctx *api.Context, state *stakingState.MutableState, redelegate *staking.Redelegate) (*staking.RedelegateResult, error) {
+	// No sense if there is nothing to redelegate.
+	if redelegate.Shares.IsZero() || redelegate.From.Equal(redelegate.To) {
+		return nil, staking.ErrInvalidArgument
+	}
+
+	if ctx.IsCheckOnly() {
+		return nil, nil
+	}
+
+	// Charge gas for this transaction.
+	params, err := state.ConsensusParameters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch consensus parameters: %w", err)
+	}
+	if err = ctx.Gas().UseGas(1, staking.GasOpRedelegate, params.GasCosts); err != nil {
+		return nil, err
+	}
+
+	// Return early for simulation as we only need gas accounting.
+	if ctx.IsSimulation() {
+		return nil, nil
+	}
+
+	// Since the source and destination accounts differ, at least one of them is not
+	// the delegator's own account.
+	if params.DisableDelegation {
+		return nil, staking.ErrForbidden
+	}
+
+	delegatorAddr := ctx.CallerAddress()
+	if delegatorAddr.IsReserved() || redelegate.To.IsReserved() {
+		return nil, staking.ErrForbidden
+	}
+
+	from, err := state.Account(ctx, redelegate.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch account: %w", err)
+	}
+	to, err := state.Account(ctx, redelegate.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch account: %w", err)
+	}
+
+	// Fetch delegations.
+	fromDelegation, err := state.Delegation(ctx, delegatorAddr, redelegate.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch delegation: %w", err)
+	}
+	toDelegation, err := state.Delegation(ctx, delegatorAddr, redelegate.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch delegation: %w", err)
+	}
+
+	var baseUnits quantity.Quantity
+	if err = from.Escrow.Active.Withdraw(&baseUnits, &fromDelegation.Shares, &redelegate.Shares); err != nil {
+		ctx.Logger().Debug("Redelegate: failed to redeem escrow shares",
+			"err", err,
+			"delegator", delegatorAddr,
+			"from", redelegate.From,
+			"shares", redelegate.Shares,
+		)
+		return nil, err
+	}
+	stakeAmount := baseUnits.Clone()
+
+	// Check if the redelegated stake is at least the minimum delegation amount.
+	if stakeAmount.IsZero() || stakeAmount.Cmp(&params.MinDelegationAmount) < 0 {
+		return nil, staking.ErrUnderMinDelegationAmount
+	}
+
+	newShares, err := to.Escrow.Active.Deposit(&toDelegation.Shares, &baseUnits, stakeAmount)
+	if err != nil {
+		ctx.Logger().Debug("Redelegate: failed to escrow stake",
+			"err", err,
+			"delegator", delegatorAddr,
+			"to", redelegate.To,
+			"base_units", stakeAmount,
+		)
+		return nil, err
+	}
+
+	if !baseUnits.IsZero() {
+		ctx.Logger().Debug("Redelegate: inconsistency in transferring stake between escrow accounts",
+			"remaining_base_units", baseUnits,
+		)
+		return nil, staking.ErrInvalidArgument
+	}
+
+	// The redelegated stake remains exposed to slashing of the source account for the
+	// exposure period.
+	exposurePeriod := params.RedelegationExposurePeriod
+	if exposurePeriod == 0 {
+		exposurePeriod = params.DebondingInterval
+	}
+	epoch, err := app.state.GetEpoch(ctx, ctx.BlockHeight()+1)
+	if err != nil {
+		return nil, err
+	}
+	exposureEndTime := epoch + exposurePeriod
+
+	if exposurePeriod > 0 {
+		// If a redelegation for the same accounts and exposure end time already exists,
+		// the redelegations are merged.
+		var rd *staking.Redelegation
+		rd, err = state.Redelegation(ctx, redelegate.From, delegatorAddr, redelegate.To, exposureEndTime)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch redelegation: %w", err)
+		}
+		if err = rd.Amount.Add(stakeAmount); err != nil {
+			return nil, fmt.Errorf("failed to add redelegation amount: %w", err)
+		}
+		if err = state.SetRedelegation(ctx, redelegate.From, delegatorAddr, rd); err != nil {
+			return nil, fmt.Errorf("failed to set redelegation: %w", err)
+		}
+	}
+
+	// Commit accounts and delegation descriptors.
+	if err = state.SetAccount(ctx, redelegate.From, from); err != nil {
+		return nil, fmt.Errorf("failed to set account: %w", err)
+	}
+	if err = state.SetAccount(ctx, redelegate.To, to); err != nil {
+		return nil, fmt.Errorf("failed to set account: %w", err)
+	}
+	if err = state.SetDelegation(ctx, delegatorAddr, redelegate.From, fromDelegation); err != nil {
+		return nil, fmt.Errorf("failed to set delegation: %w", err)
+	}
+	if err = state.SetDelegation(ctx, delegatorAddr, redelegate.To, toDelegation); err != nil {
+		return nil, fmt.Errorf("failed to set delegation: %w", err)
+	}
+
+	ctx.Logger().Debug("Redelegate: redelegated stake",
+		"delegator", delegatorAddr,
+		"from", redelegate.From,
+		"to", redelegate.To,
+		"base_units", stakeAmount,
+		"source_shares", redelegate.Shares,
+		"new_shares", newShares,
+		"exposure_end_time", exposureEndTime,
+	)
+
+	ctx.EmitEvent(api.NewEventBuilder(app.Name()).TypedAttribute(&staking.RedelegateEscrowEvent{
+		Owner:           delegatorAddr,
+		From:            redelegate.From,
+		To:              redelegate.To,
+		Amount:          *stakeAmount,
+		SourceShares:    redelegate.Shares,
+		NewShares:       *newShares,
+		ExposureEndTime: exposureEndTime,
+	}))
+
+	return &staking.RedelegateResult{
+		Owner:           delegatorAddr,
+		From:            redelegate.From,
+		To:              redelegate.To,
+		Amount:          *stakeAmount,
+		SourceShares:    redelegate.Shares,
+		NewShares:       *newShares,
+		ExposureEndTime: exposureEndTime,
+	}, nil
+}
+
 func (app *stakingApplication) amendCommissionSchedule(
 	ctx *api.Context,
 	state *stakingState.MutableState,
@@ -755,6 +935,9 @@ func (app *stakingApplication) withdraw(
 	if err = quantity.Move(&to.General.Balance, &from.General.Balance, &withdraw.Amount); err != nil {
 		return nil, staking.ErrInsufficientBalance
 	}
+	if err = stakingState.CheckSpendable(ctx, withdraw.From, &from.General); err != nil {
+		return nil, err
+	}
 
 	// Check against minimum balance.
 	if from.General.Balance.Cmp(&params.MinTransactBalance) < 0 {
@@ -802,3 +985,93 @@ func (app *stakingApplication) withdraw(
 		AmountChange: withdraw.Amount,
 	}, nil
 }
+
+func (app *stakingApplication) vestingTransfer(
+	ctx *api.Context,
+	state *stakingState.MutableState,
+	xfer *staking.VestingTransfer,
+) (*staking.TransferResult, error) {
+	if ctx.IsCheckOnly() {
+		return nil, nil
+	}
+
+	// Charge gas for this transaction.
+	params, err := state.ConsensusParameters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch consensus parameters: %w", err)
+	}
+	if err = ctx.Gas().UseGas(1, staking.GasOpVestingTransfer, params.GasCosts); err != nil {
+		return nil, err
+	}
+
+	// Return early for simulation as we only need gas accounting.
+	if ctx.IsSimulation() {
+		return nil, nil
+	}
+
+	// Vesting transfers are privileged and are only permitted from explicitly allowed accounts.
+	fromAddr := ctx.CallerAddress()
+	if fromAddr.IsReserved() || !params.VestingTransfersFrom[fromAddr] {
+		return nil, staking.ErrForbidden
+	}
+	if xfer.To.IsReserved() || fromAddr.Equal(xfer.To) {
+		return nil, staking.ErrInvalidArgument
+	}
+	if err = xfer.Schedule.ValidateBasic(); err != nil {
+		ctx.Logger().Debug("VestingTransfer: invalid vesting schedule",
+			"err", err,
+			"to", xfer.To,
+		)
+		return nil, staking.ErrInvalidArgument
+	}
+
+	// An account can only have a single vesting schedule which may only be replaced once the
+	// previous schedule has fully vested.
+	epoch, err := app.state.GetCurrentEpoch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current epoch: %w", err)
+	}
+	to, err := state.Account(ctx, xfer.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch account: %w", err)
+	}
+	if to.General.Vesting != nil && !to.General.Vesting.IsFullyVested(epoch) {
+		return nil, staking.ErrForbidden
+	}
+
+	if err = app.transferImpl(ctx, state, params, fromAddr, &staking.Transfer{
+		To:     xfer.To,
+		Amount: xfer.Schedule.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	// Refetch the destination account as it has been updated by the transfer.
+	if to, err = state.Account(ctx, xfer.To); err != nil {
+		return nil, fmt.Errorf("failed to fetch account: %w", err)
+	}
+	schedule := xfer.Schedule
+	to.General.Vesting = &schedule
+	to.General.DelegatedVesting = quantity.Quantity{}
+	if err = state.SetAccount(ctx, xfer.To, to); err != nil {
+		return nil, fmt.Errorf("failed to set account: %w", err)
+	}
+
+	ctx.Logger().Debug("VestingTransfer: executed vesting transfer",
+		"from", fromAddr,
+		"to", xfer.To,
+		"schedule", xfer.Schedule,
+	)
+
+	ctx.EmitEvent(api.NewEventBuilder(app.Name()).TypedAttribute(&staking.TransferEvent{
+		From:   fromAddr,
+		To:     xfer.To,
+		Amount: xfer.Schedule.Amount,
+	}))
+
+	return &staking.TransferResult{
+		From:   fromAddr,
+		To:     xfer.To,
+		Amount: xfer.Schedule.Amount,
+	}, nil
+}
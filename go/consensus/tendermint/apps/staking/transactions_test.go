@@ -1059,3 +1059,176 @@ func TestBurn(t *testing.T) {
 		require.ErrorIs(err, tc.err, tc.msg)
 	}
 }
+
+func TestVestingTransfer(t *testing.T) {
+	require := require.New(t)
+	var err error
+
+	now := time.Unix(1580461674, 0)
+	appState := abciAPI.NewMockApplicationState(&abciAPI.MockApplicationStateConfig{
+		CurrentEpoch: 10,
+	})
+	ctx := appState.NewContext(abciAPI.ContextEndBlock, now)
+	defer ctx.Close()
+
+	stakeState := stakingState.NewMutableState(ctx.State())
+
+	app := &stakingApplication{
+		state: appState,
+	}
+
+	pk1 := signature.NewPublicKey("aaafffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	addr1 := staking.NewAddress(pk1)
+	pk2 := signature.NewPublicKey("bbbfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	addr2 := staking.NewAddress(pk2)
+	pk3 := signature.NewPublicKey("cccfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	addr3 := staking.NewAddress(pk3)
+
+	for _, addr := range []staking.Address{addr1, addr2} {
+		err = stakeState.SetAccount(ctx, addr, &staking.Account{
+			General: staking.GeneralAccount{
+				Balance: *quantity.NewFromUint64(100_000),
+			},
+		})
+		require.NoError(err, "SetAccount")
+	}
+
+	err = stakeState.SetConsensusParameters(ctx, &staking.ConsensusParameters{
+		VestingTransfersFrom: map[staking.Address]bool{
+			addr1: true,
+		},
+	})
+	require.NoError(err, "setting staking consensus parameters should not error")
+
+	schedule := staking.VestingSchedule{
+		Amount: *quantity.NewFromUint64(10_000),
+		Start:  10,
+		Cliff:  15,
+		End:    20,
+	}
+
+	doVestingTransfer := func(signer signature.PublicKey, xfer *staking.VestingTransfer) error {
+		txCtx := appState.NewContext(abciAPI.ContextDeliverTx, now)
+		defer txCtx.Close()
+		txCtx.SetTxSigner(signer)
+
+		_, err = app.vestingTransfer(txCtx, stakeState, xfer)
+		return err
+	}
+	doTransfer := func(signer signature.PublicKey, xfer *staking.Transfer) error {
+		txCtx := appState.NewContext(abciAPI.ContextDeliverTx, now)
+		defer txCtx.Close()
+		txCtx.SetTxSigner(signer)
+
+		_, err = app.transfer(txCtx, stakeState, xfer)
+		return err
+	}
+
+	err = doVestingTransfer(pk2, &staking.VestingTransfer{To: addr3, Schedule: schedule})
+	require.ErrorIs(err, staking.ErrForbidden, "vesting transfer from non-allowed account should fail")
+
+	invalidSchedule := schedule
+	invalidSchedule.Cliff = 25
+	err = doVestingTransfer(pk1, &staking.VestingTransfer{To: addr3, Schedule: invalidSchedule})
+	require.ErrorIs(err, staking.ErrInvalidArgument, "vesting transfer with invalid schedule should fail")
+
+	err = doVestingTransfer(pk1, &staking.VestingTransfer{To: addr3, Schedule: schedule})
+	require.NoError(err, "vesting transfer")
+
+	acct, err := stakeState.Account(ctx, addr3)
+	require.NoError(err, "Account")
+	require.EqualValues(schedule.Amount, acct.General.Balance, "destination balance should be updated")
+	require.NotNil(acct.General.Vesting, "destination should have a vesting schedule")
+	require.EqualValues(schedule, *acct.General.Vesting, "destination vesting schedule should be set")
+
+	err = doVestingTransfer(pk1, &staking.VestingTransfer{To: addr3, Schedule: schedule})
+	require.ErrorIs(err, staking.ErrForbidden, "replacing an active vesting schedule should fail")
+
+	// Before the cliff, nothing can be spent.
+	err = doTransfer(pk3, &staking.Transfer{To: addr2, Amount: *quantity.NewFromUint64(1)})
+	require.ErrorIs(err, staking.ErrLockedByVesting, "transfer of locked amount should fail")
+
+	// After the cliff, the amount unlocks linearly (3000 remains locked at epoch 17).
+	appState.UpdateMockApplicationStateConfig(&abciAPI.MockApplicationStateConfig{CurrentEpoch: 17})
+	err = doTransfer(pk3, &staking.Transfer{To: addr2, Amount: *quantity.NewFromUint64(7_000)})
+	require.NoError(err, "transfer of unlocked amount")
+	err = doTransfer(pk3, &staking.Transfer{To: addr2, Amount: *quantity.NewFromUint64(1)})
+	require.ErrorIs(err, staking.ErrLockedByVesting, "transfer of locked amount should fail")
+
+	// Once fully vested, everything can be spent and the schedule can be replaced.
+	appState.UpdateMockApplicationStateConfig(&abciAPI.MockApplicationStateConfig{CurrentEpoch: 20})
+	err = doTransfer(pk3, &staking.Transfer{To: addr2, Amount: *quantity.NewFromUint64(3_000)})
+	require.NoError(err, "transfer after vesting end")
+	err = doVestingTransfer(pk1, &staking.VestingTransfer{To: addr3, Schedule: schedule})
+	require.NoError(err, "replacing a fully vested schedule")
+}
+
+func TestVestingEscrow(t *testing.T) {
+	require := require.New(t)
+	var err error
+
+	now := time.Unix(1580461674, 0)
+	appState := abciAPI.NewMockApplicationState(&abciAPI.MockApplicationStateConfig{
+		CurrentEpoch: 10,
+	})
+	ctx := appState.NewContext(abciAPI.ContextEndBlock, now)
+	defer ctx.Close()
+
+	stakeState := stakingState.NewMutableState(ctx.State())
+
+	app := &stakingApplication{
+		state: appState,
+	}
+
+	pk1 := signature.NewPublicKey("aaafffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	addr1 := staking.NewAddress(pk1)
+	pk2 := signature.NewPublicKey("bbbfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	addr2 := staking.NewAddress(pk2)
+
+	// The account has 2000 unlocked tokens in addition to 10000 locked tokens.
+	err = stakeState.SetAccount(ctx, addr1, &staking.Account{
+		General: staking.GeneralAccount{
+			Balance: *quantity.NewFromUint64(12_000),
+			Vesting: &staking.VestingSchedule{
+				Amount: *quantity.NewFromUint64(10_000),
+				Start:  10,
+				Cliff:  15,
+				End:    20,
+			},
+		},
+	})
+	require.NoError(err, "SetAccount")
+	err = stakeState.SetConsensusParameters(ctx, &staking.ConsensusParameters{})
+	require.NoError(err, "setting staking consensus parameters should not error")
+
+	doEscrow := func(escrow *staking.Escrow) error {
+		txCtx := appState.NewContext(abciAPI.ContextDeliverTx, now)
+		defer txCtx.Close()
+		txCtx.SetTxSigner(pk1)
+
+		_, err = app.addEscrow(txCtx, stakeState, escrow)
+		return err
+	}
+	doTransfer := func(xfer *staking.Transfer) error {
+		txCtx := appState.NewContext(abciAPI.ContextDeliverTx, now)
+		defer txCtx.Close()
+		txCtx.SetTxSigner(pk1)
+
+		_, err = app.transfer(txCtx, stakeState, xfer)
+		return err
+	}
+
+	// Escrowing locked tokens should be allowed and should be tracked as delegated vesting.
+	err = doEscrow(&staking.Escrow{Account: addr2, Amount: *quantity.NewFromUint64(6_000)})
+	require.NoError(err, "escrowing locked tokens")
+	acct, err := stakeState.Account(ctx, addr1)
+	require.NoError(err, "Account")
+	require.EqualValues(*quantity.NewFromUint64(6_000), acct.General.Balance, "general balance should be reduced")
+	require.EqualValues(*quantity.NewFromUint64(6_000), acct.General.DelegatedVesting, "locked tokens should be escrowed first")
+
+	// Unlocked tokens should remain spendable as escrowed tokens count towards the locked amount.
+	err = doTransfer(&staking.Transfer{To: addr2, Amount: *quantity.NewFromUint64(2_000)})
+	require.NoError(err, "transfer of unlocked amount after escrowing locked tokens")
+	err = doTransfer(&staking.Transfer{To: addr2, Amount: *quantity.NewFromUint64(1)})
+	require.ErrorIs(err, staking.ErrLockedByVesting, "transfer of locked amount should fail")
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/oasisprotocol/oasis-core/go/common/identity"
 	"github.com/oasisprotocol/oasis-core/go/common/pubsub"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
 	consensusAPI "github.com/oasisprotocol/oasis-core/go/consensus/api"
 	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
 	"github.com/oasisprotocol/oasis-core/go/consensus/tendermint/abci"
@@ -128,6 +129,11 @@ func (srv *archiveService) GetSignerNonce(ctx context.Context, req *consensusAPI
 	return 0, consensusAPI.ErrUnsupported
 }
 
+// Implements consensusAPI.Backend.
+func (srv *archiveService) GetMinGasPrice(ctx context.Context, height int64) (*quantity.Quantity, error) {
+	return nil, consensusAPI.ErrUnsupported
+}
+
 // Implements consensusAPI.Backend.
 func (srv *archiveService) WatchBlocks(ctx context.Context) (<-chan *consensusAPI.Block, pubsub.ClosableSubscription, error) {
 	ctx, sub := pubsub.NewContextSubscription(ctx)
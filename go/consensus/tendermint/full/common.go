@@ -3,6 +3,7 @@ package full
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/oasisprotocol/oasis-core/go/common/logging"
 	"github.com/oasisprotocol/oasis-core/go/common/node"
 	"github.com/oasisprotocol/oasis-core/go/common/pubsub"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
 	cmservice "github.com/oasisprotocol/oasis-core/go/common/service"
 	"github.com/oasisprotocol/oasis-core/go/common/version"
 	consensusAPI "github.com/oasisprotocol/oasis-core/go/consensus/api"
@@ -90,6 +92,9 @@ type commonNode struct {
 	stateStore   state.Store
 	dbCloser     *db.Closer
 
+	// txIndex is the optional transaction index.
+	txIndex *txIndex
+
 	state     uint32
 	startedCh chan struct{}
 
@@ -230,8 +235,19 @@ func (n *commonNode) initialize() error {
 	n.serviceClients = append(n.serviceClients, scRegistry)
 	n.svcMgr.RegisterCleanupOnly(n.registry, "registry backend")
 
+	var rewardsIndexDB tmdb.DB
+	if viper.GetBool(CfgRewardsIndexEnabled) {
+		if rewardsIndexDB, err = db.New(filepath.Join(n.dataDir, common.StateDir, tmstaking.RewardsIndexDBName), false); err != nil {
+			n.Logger.Error("initialize: failed to open staking rewards index database",
+				"err", err,
+			)
+			return err
+		}
+		rewardsIndexDB = db.WithCloser(rewardsIndexDB, n.dbCloser)
+	}
+
 	var scStaking tmstaking.ServiceClient
-	if scStaking, err = tmstaking.New(n.ctx, n.parentNode); err != nil {
+	if scStaking, err = tmstaking.New(n.ctx, n.parentNode, rewardsIndexDB); err != nil {
 		n.Logger.Error("staking: failed to initialize staking backend",
 			"err", err,
 		)
@@ -501,6 +517,11 @@ func (n *commonNode) GetSignerNonce(ctx context.Context, req *consensusAPI.GetSi
 	return n.mux.TransactionAuthHandler().GetSignerNonce(ctx, req)
 }
 
+// Implements consensusAPI.Backend.
+func (n *commonNode) GetMinGasPrice(ctx context.Context, height int64) (*quantity.Quantity, error) {
+	return n.mux.TransactionAuthHandler().GetMinGasPrice(ctx, height)
+}
+
 // Implements consensusAPI.Backend.
 func (n *commonNode) GetTendermintBlock(ctx context.Context, height int64) (*tmtypes.Block, error) {
 	if err := n.ensureStarted(ctx); err != nil {
@@ -734,6 +755,15 @@ func (n *commonNode) GetTransactionsWithResults(ctx context.Context, height int6
 			result.Events = append(result.Events, &results.Event{Governance: e})
 		}
 
+		// Transaction batch call events.
+		batchEvents, err := abci.BatchEventsFromTendermint(rs.Events)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range batchEvents {
+			result.Events = append(result.Events, &results.Event{BatchCall: e})
+		}
+
 		txsWithResults.Results = append(txsWithResults.Results, result)
 	}
 	return &txsWithResults, nil
@@ -887,6 +917,11 @@ func (n *commonNode) SubmitTxNoWait(ctx context.Context, tx *transaction.SignedT
 	return consensusAPI.ErrUnsupported
 }
 
+// Implements consensusAPI.Backend.
+func (n *commonNode) SubmitMultisigTx(ctx context.Context, tx *transaction.MultiSignedTransaction) error {
+	return consensusAPI.ErrUnsupported
+}
+
 // Implements consensusAPI.Backend.
 func (n *commonNode) SubmitTxWithProof(ctx context.Context, tx *transaction.SignedTransaction) (*transaction.Proof, error) {
 	return nil, consensusAPI.ErrUnsupported
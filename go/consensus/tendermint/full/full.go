@@ -67,6 +67,9 @@ const (
 	CfgABCIPruneNumKept = "consensus.tendermint.abci.prune.num_kept"
 	// CfgABCIPruneInterval configures the ABCI state pruning interval.
 	CfgABCIPruneInterval = "consensus.tendermint.abci.prune.interval"
+	// CfgABCIPruneCheckpointInterval configures the interval between retained ABCI state
+	// checkpoint versions if pruning is enabled. If not set, the epoch interval is used.
+	CfgABCIPruneCheckpointInterval = "consensus.tendermint.abci.prune.checkpoint_interval"
 
 	// CfgCheckpointerDisabled disables the ABCI state checkpointer.
 	CfgCheckpointerDisabled = "consensus.tendermint.checkpointer.disabled"
@@ -111,6 +114,15 @@ const (
 
 	// CfgHaltHeight is the block height at which the local node should be shutdown.
 	CfgHaltHeight = "consensus.tendermint.halt_height"
+
+	// CfgTxIndexEnabled enables the transaction index.
+	CfgTxIndexEnabled = "consensus.tendermint.tx_index.enabled"
+	// CfgTxIndexNumKept configures the number of heights for which transactions are kept in the
+	// transaction index (0 = keep all).
+	CfgTxIndexNumKept = "consensus.tendermint.tx_index.num_kept"
+
+	// CfgRewardsIndexEnabled enables the staking escrow rewards index.
+	CfgRewardsIndexEnabled = "consensus.tendermint.rewards_index.enabled"
 )
 
 const (
@@ -201,6 +213,10 @@ func (t *fullService) Start() error {
 		go t.syncWorker()
 		// Start block notifier.
 		go t.blockNotifierWorker()
+		// Optionally start transaction indexer.
+		if t.txIndex != nil {
+			go t.txIndexWorker()
+		}
 		// Optionally start metrics updater.
 		if cmmetrics.Enabled() {
 			go t.metrics()
@@ -247,7 +263,7 @@ func (t *fullService) Mode() consensusAPI.Mode {
 
 // Implements consensusAPI.Backend.
 func (t *fullService) SubmitTx(ctx context.Context, tx *transaction.SignedTransaction) error {
-	if _, err := t.submitTx(ctx, tx); err != nil {
+	if _, err := t.submitTx(ctx, cbor.Marshal(tx)); err != nil {
 		return err
 	}
 	return nil
@@ -258,9 +274,17 @@ func (t *fullService) SubmitTxNoWait(ctx context.Context, tx *transaction.Signed
 	return t.broadcastTxRaw(cbor.Marshal(tx))
 }
 
+// Implements consensusAPI.Backend.
+func (t *fullService) SubmitMultisigTx(ctx context.Context, tx *transaction.MultiSignedTransaction) error {
+	if _, err := t.submitTx(ctx, cbor.Marshal(tx)); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Implements consensusAPI.Backend.
 func (t *fullService) SubmitTxWithProof(ctx context.Context, tx *transaction.SignedTransaction) (*transaction.Proof, error) {
-	data, err := t.submitTx(ctx, tx)
+	data, err := t.submitTx(ctx, cbor.Marshal(tx))
 	if err != nil {
 		return nil, err
 	}
@@ -289,9 +313,8 @@ func (t *fullService) SubmitTxWithProof(ctx context.Context, tx *transaction.Sig
 	}, nil
 }
 
-func (t *fullService) submitTx(ctx context.Context, tx *transaction.SignedTransaction) (*tmtypes.EventDataTx, error) {
+func (t *fullService) submitTx(ctx context.Context, data []byte) (*tmtypes.EventDataTx, error) {
 	// Subscribe to the transaction being included in a block.
-	data := cbor.Marshal(tx)
 	query := tmtypes.EventQueryTxFor(data)
 	subID := t.newSubscriberID()
 	txSub, err := t.subscribe(subID, query)
@@ -584,6 +607,26 @@ func (t *fullService) WatchTendermintBlocks() (<-chan *tmtypes.Block, *pubsub.Su
 	return typedCh, sub, nil
 }
 
+// epochInterval returns the epoch interval (in blocks) configured by the beacon consensus
+// parameters or zero if the interval is not known.
+func epochInterval(params *beaconAPI.ConsensusParameters) uint64 {
+	var interval int64
+	switch params.Backend {
+	case beaconAPI.BackendInsecure:
+		if params.InsecureParameters != nil {
+			interval = params.InsecureParameters.Interval
+		}
+	case beaconAPI.BackendVRF:
+		if params.VRFParameters != nil {
+			interval = params.VRFParameters.Interval
+		}
+	}
+	if interval < 0 {
+		return 0
+	}
+	return uint64(interval)
+}
+
 func (t *fullService) lazyInit() error { // nolint: gocyclo
 	if t.initialized() {
 		return nil
@@ -599,6 +642,10 @@ func (t *fullService) lazyInit() error { // nolint: gocyclo
 	}
 	pruneCfg.NumKept = viper.GetUint64(CfgABCIPruneNumKept)
 	pruneCfg.PruneInterval = viper.GetDuration(CfgABCIPruneInterval)
+	pruneCfg.CheckpointInterval = viper.GetUint64(CfgABCIPruneCheckpointInterval)
+	if pruneCfg.CheckpointInterval == 0 {
+		pruneCfg.CheckpointInterval = epochInterval(&t.genesis.Beacon.Parameters)
+	}
 	const minPruneInterval = 1 * time.Second
 	if pruneCfg.PruneInterval < minPruneInterval {
 		pruneCfg.PruneInterval = minPruneInterval
@@ -660,6 +707,9 @@ func (t *fullService) lazyInit() error { // nolint: gocyclo
 	tenderConfig.Consensus.CreateEmptyBlocksInterval = emptyBlockInterval
 	tenderConfig.Consensus.DebugUnsafeReplayRecoverCorruptedWAL = viper.GetBool(CfgDebugUnsafeReplayRecoverCorruptedWAL) && cmflags.DebugDontBlameOasis()
 	tenderConfig.Mempool.Version = tmconfig.MempoolV1
+	// Always recheck transactions after each block so that invalidated and expired transactions
+	// get evicted from the mempool.
+	tenderConfig.Mempool.Recheck = true
 	tenderConfig.Instrumentation.Prometheus = true
 	tenderConfig.Instrumentation.PrometheusListenAddr = ""
 	tenderConfig.TxIndex.Indexer = "null"
@@ -728,6 +778,17 @@ func (t *fullService) lazyInit() error { // nolint: gocyclo
 		return err
 	}
 
+	if viper.GetBool(CfgTxIndexEnabled) {
+		txIndexDB, derr := db.New(filepath.Join(tenderConfig.DBDir(), txIndexDBName), false)
+		if derr != nil {
+			t.Logger.Error("failed to open transaction index database",
+				"err", derr,
+			)
+			return derr
+		}
+		t.txIndex = newTxIndex(db.WithCloser(txIndexDB, t.dbCloser), viper.GetUint64(CfgTxIndexNumKept))
+	}
+
 	// HACK: Wrap the provider so we can extract the state database handle. This is required because
 	// Tendermint does not expose a way to access the state database and we need it to bypass some
 	// stupid things like pagination on the in-process "client".
@@ -1034,6 +1095,7 @@ func init() {
 	Flags.String(CfgABCIPruneStrategy, abci.PruneDefault, "ABCI state pruning strategy")
 	Flags.Uint64(CfgABCIPruneNumKept, 3600, "ABCI state versions kept (when applicable)")
 	Flags.Duration(CfgABCIPruneInterval, 2*time.Minute, "ABCI state pruning interval")
+	Flags.Uint64(CfgABCIPruneCheckpointInterval, 0, "ABCI state checkpoint interval (when applicable, 0 = epoch interval)")
 	Flags.Bool(CfgCheckpointerDisabled, false, "Disable the ABCI state checkpointer")
 	Flags.Duration(CfgCheckpointerCheckInterval, 1*time.Minute, "ABCI state checkpointer check interval")
 	Flags.StringSlice(CfgSentryUpstreamAddress, []string{}, "Tendermint nodes for which we act as sentry of the form pubkey@IP:port")
@@ -1049,6 +1111,10 @@ func init() {
 
 	Flags.Uint64(CfgHaltHeight, 0, "height at which to force-shutdown the node (in blocks)")
 
+	Flags.Bool(CfgTxIndexEnabled, false, "enable transaction index")
+	Flags.Uint64(CfgTxIndexNumKept, 0, "number of heights kept in the transaction index (0 = keep all)")
+	Flags.Bool(CfgRewardsIndexEnabled, false, "enable staking escrow rewards index")
+
 	// State sync.
 	Flags.Bool(CfgConsensusStateSyncEnabled, false, "enable state sync")
 	Flags.Duration(CfgConsensusStateSyncTrustPeriod, 24*time.Hour, "state sync: light client trust period")
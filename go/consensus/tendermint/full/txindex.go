@@ -0,0 +1,419 @@
+package full
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
+	"github.com/oasisprotocol/oasis-core/go/common/logging"
+	consensusAPI "github.com/oasisprotocol/oasis-core/go/consensus/api"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
+	staking "github.com/oasisprotocol/oasis-core/go/staking/api"
+)
+
+const txIndexDBName = "tx-index"
+
+var (
+	// txIndexKeyTx is the key prefix for indexed transactions.
+	//
+	// Key format is: 0x01 <hash (hash.Hash)> <height (uint64)> <index (uint32)>
+	// Value is the CBOR-serialized consensusAPI.IndexedTransaction.
+	//
+	// The position is part of the key as the same transaction (e.g., a malformed one) can be
+	// included in multiple blocks.
+	txIndexKeyTx = []byte{0x01}
+	// txIndexKeySigner is the key prefix for the signer index.
+	//
+	// Key format is: 0x02 <signer (staking.Address)> <height (uint64)> <index (uint32)>
+	// Value is the transaction hash.
+	txIndexKeySigner = []byte{0x02}
+	// txIndexKeyHeight is the key prefix for the height index, used for pruning.
+	//
+	// Key format is: 0x03 <height (uint64)> <index (uint32)>
+	// Value is the transaction hash.
+	txIndexKeyHeight = []byte{0x03}
+	// txIndexKeyLastHeight is the key under which the last indexed height is stored.
+	//
+	// Value is the CBOR-serialized last indexed height.
+	txIndexKeyLastHeight = []byte{0x04}
+)
+
+// txIndex is an index of consensus transactions by hash and by signer.
+type txIndex struct {
+	logger *logging.Logger
+
+	db      tmdb.DB
+	numKept uint64
+}
+
+func txIndexTxPrefix(txHash hash.Hash) []byte {
+	return append(append([]byte{}, txIndexKeyTx...), txHash[:]...)
+}
+
+func txIndexTxKey(txHash hash.Hash, height int64, index uint32) []byte {
+	return txIndexPositionKey(txIndexTxPrefix(txHash), height, index)
+}
+
+func txIndexPositionKey(prefix []byte, height int64, index uint32) []byte {
+	key := make([]byte, len(prefix)+12)
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], uint64(height))
+	binary.BigEndian.PutUint32(key[len(prefix)+8:], index)
+	return key
+}
+
+// txIndexPosition returns the height and index encoded at the end of a position key.
+func txIndexPosition(key []byte) (int64, uint32, error) {
+	if len(key) < 12 {
+		return 0, 0, fmt.Errorf("tx index: malformed position key")
+	}
+	pos := key[len(key)-12:]
+	return int64(binary.BigEndian.Uint64(pos)), binary.BigEndian.Uint32(pos[8:]), nil
+}
+
+func txIndexSignerPrefix(signer staking.Address) []byte {
+	rawSigner, _ := signer.MarshalBinary()
+	return append(append([]byte{}, txIndexKeySigner...), rawSigner...)
+}
+
+// prefixEnd returns the smallest key which is greater than all keys with the given prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// txSignerAddress returns the address of the account that signed the given raw transaction or nil
+// in case the transaction envelope is malformed.
+func txSignerAddress(rawTx []byte) *staking.Address {
+	var (
+		sigTx      transaction.SignedTransaction
+		multiSigTx transaction.MultiSignedTransaction
+		signer     staking.Address
+	)
+	switch {
+	case cbor.Unmarshal(rawTx, &sigTx) == nil:
+		signer = staking.NewAddress(sigTx.Signature.PublicKey)
+	case cbor.Unmarshal(rawTx, &multiSigTx) == nil:
+		signer = staking.NewMultisigAddress(&multiSigTx.Config)
+	default:
+		return nil
+	}
+	return &signer
+}
+
+// lastHeight returns the last indexed height or zero if nothing has been indexed yet.
+func (idx *txIndex) lastHeight() (int64, error) {
+	raw, err := idx.db.Get(txIndexKeyLastHeight)
+	if err != nil {
+		return 0, fmt.Errorf("tx index: failed to get last indexed height: %w", err)
+	}
+	if raw == nil {
+		return 0, nil
+	}
+	var height int64
+	if err = cbor.Unmarshal(raw, &height); err != nil {
+		return 0, fmt.Errorf("tx index: malformed last indexed height: %w", err)
+	}
+	return height, nil
+}
+
+// indexBlock indexes all transactions in the given block and prunes any transactions that are
+// no longer retained.
+func (idx *txIndex) indexBlock(height int64, txs *consensusAPI.TransactionsWithResults) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	for i, rawTx := range txs.Transactions {
+		itx := &consensusAPI.IndexedTransaction{
+			Height:      height,
+			Index:       uint32(i),
+			Hash:        hash.NewFromBytes(rawTx),
+			Transaction: rawTx,
+		}
+		if i < len(txs.Results) {
+			itx.Result = txs.Results[i]
+		}
+
+		itx.Signer = txSignerAddress(rawTx)
+
+		if err := batch.Set(txIndexTxKey(itx.Hash, height, itx.Index), cbor.Marshal(itx)); err != nil {
+			return err
+		}
+		if err := batch.Set(txIndexPositionKey(txIndexKeyHeight, height, itx.Index), itx.Hash[:]); err != nil {
+			return err
+		}
+		if itx.Signer != nil {
+			if err := batch.Set(txIndexPositionKey(txIndexSignerPrefix(*itx.Signer), height, itx.Index), itx.Hash[:]); err != nil {
+				return err
+			}
+		}
+	}
+	if err := batch.Set(txIndexKeyLastHeight, cbor.Marshal(height)); err != nil {
+		return err
+	}
+	if err := idx.prune(batch, height); err != nil {
+		return err
+	}
+
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("tx index: failed to write batch: %w", err)
+	}
+	return nil
+}
+
+// prune removes all transactions that should no longer be retained at the given height.
+func (idx *txIndex) prune(batch tmdb.Batch, height int64) error {
+	if idx.numKept == 0 || uint64(height) <= idx.numKept {
+		return nil
+	}
+	pruneBefore := height - int64(idx.numKept) + 1
+
+	it, err := idx.db.Iterator(txIndexKeyHeight, txIndexPositionKey(txIndexKeyHeight, pruneBefore, 0))
+	if err != nil {
+		return fmt.Errorf("tx index: failed to create iterator: %w", err)
+	}
+	defer it.Close()
+
+	var numPruned int
+	for ; it.Valid(); it.Next() {
+		var txHash hash.Hash
+		if err = txHash.UnmarshalBinary(it.Value()); err != nil {
+			return fmt.Errorf("tx index: malformed height index entry: %w", err)
+		}
+		height, index, err := txIndexPosition(it.Key())
+		if err != nil {
+			return err
+		}
+
+		itx, err := idx.getAt(txHash, height, index)
+		switch {
+		case err == nil:
+			if itx.Signer == nil {
+				break
+			}
+			if err = batch.Delete(txIndexPositionKey(txIndexSignerPrefix(*itx.Signer), itx.Height, itx.Index)); err != nil {
+				return err
+			}
+		case errors.Is(err, consensusAPI.ErrTransactionNotFound):
+		default:
+			return err
+		}
+		if err = batch.Delete(txIndexTxKey(txHash, height, index)); err != nil {
+			return err
+		}
+		if err = batch.Delete(append([]byte{}, it.Key()...)); err != nil {
+			return err
+		}
+		numPruned++
+	}
+	if err = it.Error(); err != nil {
+		return fmt.Errorf("tx index: failed to iterate: %w", err)
+	}
+
+	if numPruned > 0 {
+		idx.logger.Debug("pruned transactions",
+			"num_pruned", numPruned,
+			"prune_before", pruneBefore,
+		)
+	}
+	return nil
+}
+
+// get returns the most recently included transaction with the given hash.
+func (idx *txIndex) get(txHash hash.Hash) (*consensusAPI.IndexedTransaction, error) {
+	prefix := txIndexTxPrefix(txHash)
+	it, err := idx.db.ReverseIterator(prefix, prefixEnd(prefix))
+	if err != nil {
+		return nil, fmt.Errorf("tx index: failed to create iterator: %w", err)
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		if err = it.Error(); err != nil {
+			return nil, fmt.Errorf("tx index: failed to iterate: %w", err)
+		}
+		return nil, consensusAPI.ErrTransactionNotFound
+	}
+	return decodeIndexedTransaction(it.Value())
+}
+
+// getAt returns the transaction with the given hash included at the given position.
+func (idx *txIndex) getAt(txHash hash.Hash, height int64, index uint32) (*consensusAPI.IndexedTransaction, error) {
+	raw, err := idx.db.Get(txIndexTxKey(txHash, height, index))
+	if err != nil {
+		return nil, fmt.Errorf("tx index: failed to get transaction: %w", err)
+	}
+	if raw == nil {
+		return nil, consensusAPI.ErrTransactionNotFound
+	}
+	return decodeIndexedTransaction(raw)
+}
+
+func decodeIndexedTransaction(raw []byte) (*consensusAPI.IndexedTransaction, error) {
+	var itx consensusAPI.IndexedTransaction
+	if err := cbor.Unmarshal(raw, &itx); err != nil {
+		return nil, fmt.Errorf("tx index: malformed transaction: %w", err)
+	}
+	return &itx, nil
+}
+
+func (idx *txIndex) getBySigner(req *consensusAPI.GetTransactionsBySignerRequest) (*consensusAPI.GetTransactionsBySignerResponse, error) {
+	limit := req.Limit
+	if limit == 0 || limit > consensusAPI.MaxTransactionsBySignerLimit {
+		limit = consensusAPI.MaxTransactionsBySignerLimit
+	}
+
+	prefix := txIndexSignerPrefix(req.Signer)
+	end := prefixEnd(prefix)
+	if req.Cursor != nil {
+		end = txIndexPositionKey(prefix, req.Cursor.Height, req.Cursor.Index)
+	}
+	it, err := idx.db.ReverseIterator(prefix, end)
+	if err != nil {
+		return nil, fmt.Errorf("tx index: failed to create iterator: %w", err)
+	}
+	defer it.Close()
+
+	var rsp consensusAPI.GetTransactionsBySignerResponse
+	for ; it.Valid(); it.Next() {
+		if uint32(len(rsp.Transactions)) >= limit {
+			last := rsp.Transactions[len(rsp.Transactions)-1]
+			rsp.NextCursor = &consensusAPI.TransactionCursor{
+				Height: last.Height,
+				Index:  last.Index,
+			}
+			break
+		}
+
+		var txHash hash.Hash
+		if err = txHash.UnmarshalBinary(it.Value()); err != nil {
+			return nil, fmt.Errorf("tx index: malformed signer index entry: %w", err)
+		}
+		height, index, err := txIndexPosition(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		itx, err := idx.getAt(txHash, height, index)
+		if err != nil {
+			return nil, err
+		}
+		rsp.Transactions = append(rsp.Transactions, itx)
+	}
+	if err = it.Error(); err != nil {
+		return nil, fmt.Errorf("tx index: failed to iterate: %w", err)
+	}
+	return &rsp, nil
+}
+
+func newTxIndex(db tmdb.DB, numKept uint64) *txIndex {
+	return &txIndex{
+		logger:  logging.GetLogger("consensus/tendermint/txindex"),
+		db:      db,
+		numKept: numKept,
+	}
+}
+
+// Implements consensusAPI.Backend.
+func (n *commonNode) GetTransactionByHash(ctx context.Context, txHash hash.Hash) (*consensusAPI.IndexedTransaction, error) {
+	if n.txIndex == nil {
+		return nil, consensusAPI.ErrUnsupported
+	}
+	if err := n.ensureStarted(ctx); err != nil {
+		return nil, err
+	}
+	return n.txIndex.get(txHash)
+}
+
+// Implements consensusAPI.Backend.
+func (n *commonNode) GetTransactionsBySigner(
+	ctx context.Context,
+	req *consensusAPI.GetTransactionsBySignerRequest,
+) (*consensusAPI.GetTransactionsBySignerResponse, error) {
+	if n.txIndex == nil {
+		return nil, consensusAPI.ErrUnsupported
+	}
+	if err := n.ensureStarted(ctx); err != nil {
+		return nil, err
+	}
+	return n.txIndex.getBySigner(req)
+}
+
+// txIndexWorker indexes transactions in all newly finalized blocks.
+func (t *fullService) txIndexWorker() {
+	ch, sub, err := t.WatchTendermintBlocks()
+	if err != nil {
+		t.Logger.Error("failed to watch blocks for transaction indexing",
+			"err", err,
+		)
+		return
+	}
+	defer sub.Close()
+
+	for {
+		var blk *tmtypes.Block
+		select {
+		case <-t.node.Quit():
+			return
+		case blk = <-ch:
+		}
+
+		if err = t.indexTransactions(blk.Height); err != nil {
+			t.Logger.Error("failed to index transactions",
+				"err", err,
+				"height", blk.Height,
+			)
+		}
+	}
+}
+
+// indexTransactions indexes transactions in all blocks up to and including the given height that
+// have not yet been indexed.
+func (t *fullService) indexTransactions(height int64) error {
+	lastHeight, err := t.txIndex.lastHeight()
+	if err != nil {
+		return err
+	}
+	if lastHeight >= height {
+		return nil
+	}
+
+	// Catch up with any blocks missed since the last indexed height (e.g., while the node was
+	// stopped or before the index was enabled), but never index blocks that are no longer
+	// retained or that would be pruned immediately.
+	startHeight := lastHeight + 1
+	lastRetained, err := t.GetLastRetainedVersion(t.ctx)
+	if err != nil {
+		return err
+	}
+	if startHeight < lastRetained {
+		startHeight = lastRetained
+	}
+	if numKept := t.txIndex.numKept; numKept > 0 && uint64(height) > numKept {
+		if minHeight := height - int64(numKept) + 1; startHeight < minHeight {
+			startHeight = minHeight
+		}
+	}
+
+	for h := startHeight; h <= height; h++ {
+		txs, err := t.GetTransactionsWithResults(t.ctx, h)
+		if err != nil {
+			return fmt.Errorf("failed to get transactions at height %d: %w", h, err)
+		}
+		if err = t.txIndex.indexBlock(h, txs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
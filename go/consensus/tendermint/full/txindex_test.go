@@ -0,0 +1,103 @@
+package full
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	memorySigner "github.com/oasisprotocol/oasis-core/go/common/crypto/signature/signers/memory"
+	consensusAPI "github.com/oasisprotocol/oasis-core/go/consensus/api"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction/results"
+	staking "github.com/oasisprotocol/oasis-core/go/staking/api"
+)
+
+func TestTxIndex(t *testing.T) {
+	require := require.New(t)
+
+	signer := memorySigner.NewTestSigner("consensus/tendermint/full: tx index test")
+	signerAddr := staking.NewAddress(signer.Public())
+
+	newTx := func(nonce uint64) []byte {
+		// The index does not verify signatures so there is no need to actually sign.
+		tx := transaction.NewTransaction(nonce, nil, "test.Method", nil)
+		return cbor.Marshal(&transaction.SignedTransaction{Signed: signature.Signed{
+			Blob:      cbor.Marshal(tx),
+			Signature: signature.Signature{PublicKey: signer.Public()},
+		}})
+	}
+
+	idx := newTxIndex(tmdb.NewMemDB(), 3)
+	lastHeight, err := idx.lastHeight()
+	require.NoError(err, "lastHeight")
+	require.EqualValues(0, lastHeight, "nothing should be indexed")
+
+	var txHashes []hash.Hash
+	for height := int64(1); height <= 4; height++ {
+		txs := &consensusAPI.TransactionsWithResults{}
+		for i := 0; i < 2; i++ {
+			rawTx := newTx(uint64(height)*2 + uint64(i))
+			txs.Transactions = append(txs.Transactions, rawTx)
+			txs.Results = append(txs.Results, &results.Result{})
+			txHashes = append(txHashes, hash.NewFromBytes(rawTx))
+		}
+		// Also include a malformed transaction which should only be indexed by hash.
+		txs.Transactions = append(txs.Transactions, []byte("malformed"))
+		txs.Results = append(txs.Results, &results.Result{Error: results.Error{Module: "test", Code: 1}})
+
+		err = idx.indexBlock(height, txs)
+		require.NoError(err, "indexBlock")
+	}
+
+	lastHeight, err = idx.lastHeight()
+	require.NoError(err, "lastHeight")
+	require.EqualValues(4, lastHeight, "last indexed height should be correct")
+
+	// Transactions at height 1 should be pruned.
+	_, err = idx.get(txHashes[0])
+	require.ErrorIs(err, consensusAPI.ErrTransactionNotFound, "pruned transaction should not be found")
+
+	itx, err := idx.get(txHashes[7])
+	require.NoError(err, "get")
+	require.EqualValues(4, itx.Height)
+	require.EqualValues(1, itx.Index)
+	require.Equal(&signerAddr, itx.Signer)
+	require.NotNil(itx.Result)
+
+	itx, err = idx.get(hash.NewFromBytes([]byte("malformed")))
+	require.NoError(err, "get malformed")
+	require.EqualValues(4, itx.Height)
+	require.Nil(itx.Signer, "malformed transaction should not have a signer")
+	require.EqualValues("test", itx.Result.Error.Module)
+
+	// Transactions with the same hash included at different heights should not overwrite each other.
+	_, err = idx.getAt(hash.NewFromBytes([]byte("malformed")), 1, 2)
+	require.ErrorIs(err, consensusAPI.ErrTransactionNotFound, "pruned transaction should not be found")
+	itx, err = idx.getAt(hash.NewFromBytes([]byte("malformed")), 2, 2)
+	require.NoError(err, "getAt malformed")
+	require.EqualValues(2, itx.Height)
+
+	// Query transactions by signer with paging.
+	rsp, err := idx.getBySigner(&consensusAPI.GetTransactionsBySignerRequest{Signer: signerAddr, Limit: 4})
+	require.NoError(err, "getBySigner")
+	require.Len(rsp.Transactions, 4)
+	require.Equal(txHashes[7], rsp.Transactions[0].Hash, "transactions should be ordered from the most recent")
+	require.Equal(txHashes[4], rsp.Transactions[3].Hash)
+	require.NotNil(rsp.NextCursor, "there should be more transactions")
+
+	rsp, err = idx.getBySigner(&consensusAPI.GetTransactionsBySignerRequest{Signer: signerAddr, Cursor: rsp.NextCursor, Limit: 4})
+	require.NoError(err, "getBySigner")
+	require.Len(rsp.Transactions, 2)
+	require.Equal(txHashes[3], rsp.Transactions[0].Hash)
+	require.Equal(txHashes[2], rsp.Transactions[1].Hash)
+	require.Nil(rsp.NextCursor, "there should be no more transactions")
+
+	var otherPk signature.PublicKey
+	rsp, err = idx.getBySigner(&consensusAPI.GetTransactionsBySignerRequest{Signer: staking.NewAddress(otherPk)})
+	require.NoError(err, "getBySigner")
+	require.Len(rsp.Transactions, 0, "other signer should have no transactions")
+}
@@ -250,6 +250,16 @@ func EventsFromTendermint(
 
 				evt := &api.Event{Height: height, TxHash: txHash, Vote: &e}
 				events = append(events, evt)
+			case eventsAPI.IsAttributeKind(key, &api.DelegatorVoteEvent{}):
+				// Delegator vote event.
+				var e api.DelegatorVoteEvent
+				if err := eventsAPI.DecodeValue(string(val), &e); err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("governance: corrupt DelegatorVote event: %w", err))
+					continue
+				}
+
+				evt := &api.Event{Height: height, TxHash: txHash, DelegatorVote: &e}
+				events = append(events, evt)
 			default:
 				errs = multierror.Append(errs, fmt.Errorf("governance: unknown event type: key: %s, val: %s", key, val))
 			}
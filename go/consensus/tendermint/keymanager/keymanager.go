@@ -32,8 +32,9 @@ type serviceClient struct {
 
 	logger *logging.Logger
 
-	querier  *app.QueryFactory
-	notifier *pubsub.Broker
+	querier        *app.QueryFactory
+	notifier       *pubsub.Broker
+	secretNotifier *pubsub.Broker
 }
 
 func (sc *serviceClient) GetStatus(ctx context.Context, query *registry.NamespaceQuery) (*api.Status, error) {
@@ -62,6 +63,23 @@ func (sc *serviceClient) WatchStatuses() (<-chan *api.Status, *pubsub.Subscripti
 	return ch, sub
 }
 
+func (sc *serviceClient) GetMasterSecret(ctx context.Context, query *registry.NamespaceQuery) (*api.SignedMasterSecret, error) {
+	q, err := sc.querier.QueryAt(ctx, query.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.MasterSecret(ctx, query.ID)
+}
+
+func (sc *serviceClient) WatchMasterSecrets() (<-chan *api.SignedMasterSecret, *pubsub.Subscription) {
+	sub := sc.secretNotifier.Subscribe()
+	ch := make(chan *api.SignedMasterSecret)
+	sub.Unwrap(ch)
+
+	return ch, sub
+}
+
 func (sc *serviceClient) StateToGenesis(ctx context.Context, height int64) (*api.Genesis, error) {
 	q, err := sc.querier.QueryAt(ctx, height)
 	if err != nil {
@@ -92,6 +110,17 @@ func (sc *serviceClient) DeliverEvent(ctx context.Context, height int64, tx tmty
 				sc.notifier.Broadcast(status)
 			}
 		}
+		if events.IsAttributeKind(pair.GetKey(), &api.MasterSecretPublishedEvent{}) {
+			var event api.MasterSecretPublishedEvent
+			if err := events.DecodeValue(string(pair.GetValue()), &event); err != nil {
+				sc.logger.Error("worker: failed to get master secret from tag",
+					"err", err,
+				)
+				continue
+			}
+
+			sc.secretNotifier.Broadcast(event.Secret)
+		}
 	}
 	return nil
 }
@@ -123,5 +152,7 @@ func New(ctx context.Context, backend tmapi.Backend) (ServiceClient, error) {
 		}
 	})
 
+	sc.secretNotifier = pubsub.NewBroker(false)
+
 	return sc, nil
 }
@@ -59,6 +59,11 @@ type cmdTrackRuntime struct {
 	blockHistory api.BlockHistory
 }
 
+type cmdUntrackRuntime struct {
+	runtimeID common.Namespace
+	doneCh    chan struct{}
+}
+
 type serviceClient struct {
 	tmapi.BaseServiceClient
 	sync.RWMutex
@@ -242,6 +247,31 @@ func (sc *serviceClient) TrackRuntime(ctx context.Context, history api.BlockHist
 	return sc.trackRuntime(ctx, history.RuntimeID(), history)
 }
 
+// Implements api.Backend.
+func (sc *serviceClient) UntrackRuntime(ctx context.Context, runtimeID common.Namespace) error {
+	sc.pruneHandler.untrackRuntime(runtimeID)
+
+	cmd := &cmdUntrackRuntime{
+		runtimeID: runtimeID,
+		doneCh:    make(chan struct{}),
+	}
+
+	select {
+	case sc.cmdCh <- cmd:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	// Wait for the command to be processed so that the block history is no longer used once we
+	// return, allowing the caller to safely close it.
+	select {
+	case <-cmd.doneCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
+
 func (sc *serviceClient) trackRuntime(ctx context.Context, id common.Namespace, history api.BlockHistory) error {
 	cmd := &cmdTrackRuntime{
 		runtimeID:    id,
@@ -549,6 +579,22 @@ func (sc *serviceClient) DeliverCommand(ctx context.Context, height int64, cmd i
 		}
 		// Make sure we reindex again when receiving the first event.
 		tr.reindexDone = false
+	case *cmdUntrackRuntime:
+		// Request to stop tracking a runtime's block history.
+		defer close(c.doneCh)
+
+		tr := sc.trackedRuntime[c.runtimeID]
+		if tr == nil || tr.blockHistory == nil {
+			break
+		}
+
+		sc.logger.Debug("no longer tracking runtime history",
+			"runtime_id", c.runtimeID,
+			"height", height,
+		)
+
+		// Keep tracking the runtime without a block history as there may still be block watchers.
+		tr.blockHistory = nil
 	default:
 		return fmt.Errorf("roothash: unknown command: %T", cmd)
 	}
@@ -820,6 +866,18 @@ func (ph *pruneHandler) trackRuntime(bh api.BlockHistory) {
 	ph.trackedRuntimes = append(ph.trackedRuntimes, bh)
 }
 
+func (ph *pruneHandler) untrackRuntime(runtimeID common.Namespace) {
+	ph.Lock()
+	defer ph.Unlock()
+
+	for i, bh := range ph.trackedRuntimes {
+		if id := bh.RuntimeID(); id.Equal(&runtimeID) {
+			ph.trackedRuntimes = append(ph.trackedRuntimes[:i], ph.trackedRuntimes[i+1:]...)
+			return
+		}
+	}
+}
+
 // Implements api.StatePruneHandler.
 func (ph *pruneHandler) Prune(ctx context.Context, version uint64) error {
 	ph.Lock()
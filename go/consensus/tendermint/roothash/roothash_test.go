@@ -0,0 +1,90 @@
+package roothash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oasisprotocol/oasis-core/go/common"
+	"github.com/oasisprotocol/oasis-core/go/common/logging"
+	"github.com/oasisprotocol/oasis-core/go/roothash/api"
+)
+
+type testBlockHistory struct {
+	api.BlockHistory
+
+	runtimeID  common.Namespace
+	lastHeight int64
+}
+
+func (bh *testBlockHistory) RuntimeID() common.Namespace {
+	return bh.runtimeID
+}
+
+func (bh *testBlockHistory) LastConsensusHeight() (int64, error) {
+	return bh.lastHeight, nil
+}
+
+func TestUntrackRuntime(t *testing.T) {
+	require := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bh1 := &testBlockHistory{
+		runtimeID:  common.NewTestNamespaceFromSeed([]byte("roothash untrack runtime test"), 0),
+		lastHeight: 5,
+	}
+	bh2 := &testBlockHistory{
+		runtimeID:  common.NewTestNamespaceFromSeed([]byte("roothash untrack runtime test"), 1),
+		lastHeight: 20,
+	}
+
+	logger := logging.GetLogger("roothash/test")
+	ph := &pruneHandler{logger: logger}
+	ph.trackRuntime(bh1)
+	ph.trackRuntime(bh2)
+
+	sc := &serviceClient{
+		ctx:    ctx,
+		logger: logger,
+		cmdCh:  make(chan interface{}),
+		trackedRuntime: map[common.Namespace]*trackedRuntime{
+			bh1.runtimeID: {runtimeID: bh1.runtimeID, blockHistory: bh1},
+			bh2.runtimeID: {runtimeID: bh2.runtimeID, blockHistory: bh2},
+		},
+		pruneHandler: ph,
+	}
+	go func() {
+		for {
+			select {
+			case cmd := <-sc.cmdCh:
+				_ = sc.DeliverCommand(ctx, 1, cmd)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	err := ph.Prune(ctx, 10)
+	require.Error(err, "pruning should be prevented by tracked runtimes which are behind")
+
+	err = sc.UntrackRuntime(ctx, bh1.runtimeID)
+	require.NoError(err, "UntrackRuntime")
+	require.Nil(sc.trackedRuntime[bh1.runtimeID].blockHistory, "block history should no longer be used")
+	require.Equal(bh2, sc.trackedRuntime[bh2.runtimeID].blockHistory, "other runtimes should still be tracked")
+
+	err = ph.Prune(ctx, 10)
+	require.NoError(err, "pruning should no longer be prevented by untracked runtimes")
+
+	// Untracking an unknown runtime should be a no-op.
+	err = sc.UntrackRuntime(ctx, common.NewTestNamespaceFromSeed([]byte("roothash untrack runtime test"), 2))
+	require.NoError(err, "UntrackRuntime")
+
+	// Untracking should respect context cancellation.
+	cancelledCtx, cancelCtx := context.WithCancel(context.Background())
+	cancelCtx()
+	err = (&serviceClient{cmdCh: make(chan interface{}), pruneHandler: ph}).UntrackRuntime(cancelledCtx, bh2.runtimeID)
+	require.ErrorIs(err, context.Canceled, "UntrackRuntime should fail on cancelled context")
+}
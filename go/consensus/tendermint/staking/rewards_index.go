@@ -0,0 +1,295 @@
+package staking
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	tmdb "github.com/tendermint/tm-db"
+
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/logging"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
+	"github.com/oasisprotocol/oasis-core/go/staking/api"
+)
+
+// RewardsIndexDBName is the name of the escrow rewards index database.
+const RewardsIndexDBName = "staking-rewards-index"
+
+var (
+	// rewardsIndexKeyRewards is the key prefix for indexed per-epoch escrow rewards.
+	//
+	// Key format is: 0x01 <escrow (api.Address)> <epoch (uint64)>
+	// Value is the CBOR-serialized api.EscrowRewards.
+	rewardsIndexKeyRewards = []byte{0x01}
+	// rewardsIndexKeyLastHeight is the key under which the last indexed height is stored.
+	//
+	// Value is the CBOR-serialized last indexed height.
+	rewardsIndexKeyLastHeight = []byte{0x02}
+)
+
+// rewardsIndex is an index of per-epoch rewards accrued by escrow accounts.
+type rewardsIndex struct {
+	logger *logging.Logger
+
+	db tmdb.DB
+}
+
+// rewardsAccrual is the amount of rewards accrued by an escrow account in a single block.
+type rewardsAccrual struct {
+	rewards    quantity.Quantity
+	commission quantity.Quantity
+}
+
+func rewardsIndexKey(escrow api.Address, epoch beacon.EpochTime) []byte {
+	rawEscrow, _ := escrow.MarshalBinary()
+	key := make([]byte, len(rewardsIndexKeyRewards)+len(rawEscrow)+8)
+	copy(key, rewardsIndexKeyRewards)
+	copy(key[len(rewardsIndexKeyRewards):], rawEscrow)
+	binary.BigEndian.PutUint64(key[len(rewardsIndexKeyRewards)+len(rawEscrow):], uint64(epoch))
+	return key
+}
+
+// collectRewards extracts the rewards accrued by each escrow account from the given block events.
+//
+// Rewards are disbursed from the common pool directly into the escrow account's active pool,
+// emitting an add escrow event with the common pool as the owner. Commission is escrowed by the
+// escrow account owner into its own escrow account, emitting an add escrow event with the same
+// owner and escrow account. As rewards are only disbursed outside of transactions, any such
+// events emitted in transactions are ignored.
+func collectRewards(events []*api.Event) map[api.Address]*rewardsAccrual {
+	accruals := make(map[api.Address]*rewardsAccrual)
+	accrualFor := func(addr api.Address) *rewardsAccrual {
+		acc, ok := accruals[addr]
+		if !ok {
+			acc = &rewardsAccrual{}
+			accruals[addr] = acc
+		}
+		return acc
+	}
+
+	for _, ev := range events {
+		if !ev.TxHash.IsEmpty() || ev.Escrow == nil || ev.Escrow.Add == nil {
+			continue
+		}
+
+		add := ev.Escrow.Add
+		switch {
+		case add.Owner.Equal(api.CommonPoolAddress):
+			_ = accrualFor(add.Escrow).rewards.Add(&add.Amount)
+		case add.Owner.Equal(add.Escrow):
+			_ = accrualFor(add.Escrow).commission.Add(&add.Amount)
+		}
+	}
+	return accruals
+}
+
+// lastHeight returns the last indexed height or zero if nothing has been indexed yet.
+func (idx *rewardsIndex) lastHeight() (int64, error) {
+	raw, err := idx.db.Get(rewardsIndexKeyLastHeight)
+	if err != nil {
+		return 0, fmt.Errorf("rewards index: failed to get last indexed height: %w", err)
+	}
+	if raw == nil {
+		return 0, nil
+	}
+	var height int64
+	if err = cbor.Unmarshal(raw, &height); err != nil {
+		return 0, fmt.Errorf("rewards index: malformed last indexed height: %w", err)
+	}
+	return height, nil
+}
+
+// get returns the indexed rewards of the given escrow account in the given epoch or nil if the
+// account did not receive any rewards in that epoch.
+func (idx *rewardsIndex) get(escrow api.Address, epoch beacon.EpochTime) (*api.EscrowRewards, error) {
+	raw, err := idx.db.Get(rewardsIndexKey(escrow, epoch))
+	if err != nil {
+		return nil, fmt.Errorf("rewards index: failed to get rewards: %w", err)
+	}
+	if raw == nil {
+		return nil, nil
+	}
+	var rewards api.EscrowRewards
+	if err = cbor.Unmarshal(raw, &rewards); err != nil {
+		return nil, fmt.Errorf("rewards index: malformed rewards: %w", err)
+	}
+	return &rewards, nil
+}
+
+// indexBlock stores the updated per-epoch rewards of all escrow accounts that accrued rewards
+// in the block at the given height.
+func (idx *rewardsIndex) indexBlock(height int64, rewards map[api.Address]*api.EscrowRewards) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	for escrow, r := range rewards {
+		if err := batch.Set(rewardsIndexKey(escrow, r.Epoch), cbor.Marshal(r)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set(rewardsIndexKeyLastHeight, cbor.Marshal(height)); err != nil {
+		return err
+	}
+
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("rewards index: failed to write batch: %w", err)
+	}
+	return nil
+}
+
+// query returns the indexed rewards of the given escrow account in the given epoch range.
+func (idx *rewardsIndex) query(query *api.EscrowRewardsQuery) ([]*api.EscrowRewards, error) {
+	if query.ToEpoch < query.FromEpoch {
+		return nil, fmt.Errorf("%w: invalid epoch range", api.ErrInvalidArgument)
+	}
+	if uint64(query.ToEpoch-query.FromEpoch) >= api.MaxEscrowRewardsEpochs {
+		return nil, fmt.Errorf("%w: too many epochs requested (max: %d)", api.ErrInvalidArgument, api.MaxEscrowRewardsEpochs)
+	}
+
+	start := rewardsIndexKey(query.Owner, query.FromEpoch)
+	end := prefixEnd(rewardsIndexKey(query.Owner, query.ToEpoch))
+	it, err := idx.db.Iterator(start, end)
+	if err != nil {
+		return nil, fmt.Errorf("rewards index: failed to create iterator: %w", err)
+	}
+	defer it.Close()
+
+	var result []*api.EscrowRewards
+	for ; it.Valid(); it.Next() {
+		var r api.EscrowRewards
+		if err = cbor.Unmarshal(it.Value(), &r); err != nil {
+			return nil, fmt.Errorf("rewards index: malformed rewards: %w", err)
+		}
+		result = append(result, &r)
+	}
+	if err = it.Error(); err != nil {
+		return nil, fmt.Errorf("rewards index: failed to iterate: %w", err)
+	}
+	return result, nil
+}
+
+// prefixEnd returns the smallest key which is greater than all keys with the given prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+func newRewardsIndex(db tmdb.DB) *rewardsIndex {
+	return &rewardsIndex{
+		logger: logging.GetLogger("staking/tendermint/rewardsindex"),
+		db:     db,
+	}
+}
+
+func (sc *serviceClient) GetEscrowRewards(ctx context.Context, query *api.EscrowRewardsQuery) ([]*api.EscrowRewards, error) {
+	if sc.rewardsIndex == nil {
+		return nil, api.ErrRewardsIndexDisabled
+	}
+	return sc.rewardsIndex.query(query)
+}
+
+// indexRewards indexes rewards in all blocks up to and including the given height that have not
+// yet been indexed.
+func (sc *serviceClient) indexRewards(ctx context.Context, height int64) error {
+	lastHeight, err := sc.rewardsIndex.lastHeight()
+	if err != nil {
+		return err
+	}
+	if lastHeight >= height {
+		return nil
+	}
+
+	// Catch up with any blocks missed since the last indexed height (e.g., while the node was
+	// stopped or before the index was enabled), but never index blocks for which the previous
+	// state is no longer retained as it is needed to determine the share pool state at the start
+	// of the epoch.
+	startHeight := lastHeight + 1
+	lastRetained, err := sc.backend.GetLastRetainedVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if startHeight <= lastRetained {
+		startHeight = lastRetained + 1
+	}
+
+	for h := startHeight; h <= height; h++ {
+		if err = sc.indexRewardsAt(ctx, h); err != nil {
+			return fmt.Errorf("failed to index rewards at height %d: %w", h, err)
+		}
+	}
+	return nil
+}
+
+func (sc *serviceClient) indexRewardsAt(ctx context.Context, height int64) error {
+	events, err := sc.GetEvents(ctx, height)
+	if err != nil {
+		return err
+	}
+	accruals := collectRewards(events)
+	if len(accruals) == 0 {
+		return sc.rewardsIndex.indexBlock(height, nil)
+	}
+
+	epoch, err := sc.backend.Beacon().GetEpoch(ctx, height)
+	if err != nil {
+		return err
+	}
+	prevQ, err := sc.querier.QueryAt(ctx, height-1)
+	if err != nil {
+		return err
+	}
+	q, err := sc.querier.QueryAt(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	rewards := make(map[api.Address]*api.EscrowRewards, len(accruals))
+	for escrow, acc := range accruals {
+		var r *api.EscrowRewards
+		if r, err = sc.rewardsIndex.get(escrow, epoch); err != nil {
+			return err
+		}
+		if r == nil {
+			// First rewards in this epoch, record the share pool state before disbursement.
+			var prevAcct *api.Account
+			if prevAcct, err = prevQ.Account(ctx, escrow); err != nil {
+				return err
+			}
+			r = &api.EscrowRewards{
+				Epoch:       epoch,
+				StartActive: prevAcct.Escrow.Active,
+			}
+		}
+
+		if err = r.Rewards.Add(&acc.rewards); err != nil {
+			return err
+		}
+		if err = r.Commission.Add(&acc.commission); err != nil {
+			return err
+		}
+
+		var acct *api.Account
+		if acct, err = q.Account(ctx, escrow); err != nil {
+			return err
+		}
+		r.EndActive = acct.Escrow.Active
+
+		rewards[escrow] = r
+	}
+
+	sc.rewardsIndex.logger.Debug("indexed rewards",
+		"height", height,
+		"epoch", epoch,
+		"num_accounts", len(rewards),
+	)
+
+	return sc.rewardsIndex.indexBlock(height, rewards)
+}
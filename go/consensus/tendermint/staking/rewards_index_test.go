@@ -0,0 +1,116 @@
+package staking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmdb "github.com/tendermint/tm-db"
+
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
+	memorySigner "github.com/oasisprotocol/oasis-core/go/common/crypto/signature/signers/memory"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
+	"github.com/oasisprotocol/oasis-core/go/staking/api"
+)
+
+func TestCollectRewards(t *testing.T) {
+	require := require.New(t)
+
+	addr1 := api.NewAddress(memorySigner.NewTestSigner("consensus/tendermint/staking: rewards index test 1").Public())
+	addr2 := api.NewAddress(memorySigner.NewTestSigner("consensus/tendermint/staking: rewards index test 2").Public())
+
+	var txHash hash.Hash
+	txHash.Empty()
+	userTxHash := hash.NewFromBytes([]byte("tx"))
+
+	events := []*api.Event{
+		// Reward for the first account, including commission.
+		{TxHash: txHash, Escrow: &api.EscrowEvent{Add: &api.AddEscrowEvent{
+			Owner: api.CommonPoolAddress, Escrow: addr1, Amount: *quantity.NewFromUint64(90),
+		}}},
+		{TxHash: txHash, Transfer: &api.TransferEvent{
+			From: api.CommonPoolAddress, To: addr1, Amount: *quantity.NewFromUint64(10),
+		}},
+		{TxHash: txHash, Escrow: &api.EscrowEvent{Add: &api.AddEscrowEvent{
+			Owner: addr1, Escrow: addr1, Amount: *quantity.NewFromUint64(10),
+		}}},
+		// Reward for the second account, without commission.
+		{TxHash: txHash, Escrow: &api.EscrowEvent{Add: &api.AddEscrowEvent{
+			Owner: api.CommonPoolAddress, Escrow: addr2, Amount: *quantity.NewFromUint64(50),
+		}}},
+		// Regular self-delegation from a transaction should be ignored.
+		{TxHash: userTxHash, Escrow: &api.EscrowEvent{Add: &api.AddEscrowEvent{
+			Owner: addr2, Escrow: addr2, Amount: *quantity.NewFromUint64(1000),
+		}}},
+		// Transfers from the common pool which are not escrowed should be ignored.
+		{TxHash: txHash, Transfer: &api.TransferEvent{
+			From: api.CommonPoolAddress, To: addr2, Amount: *quantity.NewFromUint64(5),
+		}},
+	}
+
+	accruals := collectRewards(events)
+	require.Len(accruals, 2)
+	require.EqualValues(*quantity.NewFromUint64(90), accruals[addr1].rewards)
+	require.EqualValues(*quantity.NewFromUint64(10), accruals[addr1].commission)
+	require.EqualValues(*quantity.NewFromUint64(50), accruals[addr2].rewards)
+	require.True(accruals[addr2].commission.IsZero(), "second account should not have commission")
+}
+
+func TestRewardsIndex(t *testing.T) {
+	require := require.New(t)
+
+	addr := api.NewAddress(memorySigner.NewTestSigner("consensus/tendermint/staking: rewards index test").Public())
+
+	idx := newRewardsIndex(tmdb.NewMemDB())
+	lastHeight, err := idx.lastHeight()
+	require.NoError(err, "lastHeight")
+	require.EqualValues(0, lastHeight, "nothing should be indexed")
+
+	r, err := idx.get(addr, 1)
+	require.NoError(err, "get")
+	require.Nil(r, "missing rewards should be nil")
+
+	for epoch := uint64(1); epoch <= 5; epoch++ {
+		err = idx.indexBlock(int64(epoch*10), map[api.Address]*api.EscrowRewards{
+			addr: {
+				Epoch:       beacon.EpochTime(epoch),
+				Rewards:     *quantity.NewFromUint64(epoch),
+				StartActive: api.SharePool{Balance: *quantity.NewFromUint64(100), TotalShares: *quantity.NewFromUint64(100)},
+				EndActive:   api.SharePool{Balance: *quantity.NewFromUint64(100 + epoch), TotalShares: *quantity.NewFromUint64(100)},
+			},
+		})
+		require.NoError(err, "indexBlock")
+	}
+	// Blocks without rewards should only update the last indexed height.
+	err = idx.indexBlock(55, nil)
+	require.NoError(err, "indexBlock")
+
+	lastHeight, err = idx.lastHeight()
+	require.NoError(err, "lastHeight")
+	require.EqualValues(55, lastHeight, "last indexed height should be correct")
+
+	rewards, err := idx.query(&api.EscrowRewardsQuery{Owner: addr, FromEpoch: 2, ToEpoch: 4})
+	require.NoError(err, "query")
+	require.Len(rewards, 3)
+	for i, er := range rewards {
+		epoch := uint64(i) + 2
+		require.EqualValues(epoch, er.Epoch)
+		require.EqualValues(*quantity.NewFromUint64(epoch), er.Rewards)
+
+		// A delegation of half of the shares should get half of the share price increase.
+		var delReward *quantity.Quantity
+		delReward, err = er.DelegationReward(quantity.NewFromUint64(50))
+		require.NoError(err, "DelegationReward")
+		require.EqualValues(*quantity.NewFromUint64(epoch / 2), *delReward)
+	}
+
+	rewards, err = idx.query(&api.EscrowRewardsQuery{Owner: addr, FromEpoch: 6, ToEpoch: 10})
+	require.NoError(err, "query")
+	require.Empty(rewards, "query outside of indexed epochs should be empty")
+
+	_, err = idx.query(&api.EscrowRewardsQuery{Owner: addr, FromEpoch: 4, ToEpoch: 2})
+	require.ErrorIs(err, api.ErrInvalidArgument, "invalid epoch range should fail")
+
+	_, err = idx.query(&api.EscrowRewardsQuery{Owner: addr, FromEpoch: 0, ToEpoch: api.MaxEscrowRewardsEpochs})
+	require.ErrorIs(err, api.ErrInvalidArgument, "too large epoch range should fail")
+}
@@ -10,12 +10,14 @@ import (
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
 
 	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
 	"github.com/oasisprotocol/oasis-core/go/common/logging"
 	"github.com/oasisprotocol/oasis-core/go/common/pubsub"
 	"github.com/oasisprotocol/oasis-core/go/common/quantity"
 	eventsAPI "github.com/oasisprotocol/oasis-core/go/consensus/api/events"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
 	tmapi "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/api"
 	app "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/apps/staking"
 	"github.com/oasisprotocol/oasis-core/go/staking/api"
@@ -36,6 +38,9 @@ type serviceClient struct {
 	querier *app.QueryFactory
 
 	eventNotifier *pubsub.Broker
+
+	// rewardsIndex is the optional escrow rewards index.
+	rewardsIndex *rewardsIndex
 }
 
 func (sc *serviceClient) TokenSymbol(ctx context.Context) (string, error) {
@@ -286,6 +291,17 @@ func (sc *serviceClient) ServiceDescriptor() tmapi.ServiceDescriptor {
 	return tmapi.NewStaticServiceDescriptor(api.ModuleName, app.EventType, []tmpubsub.Query{app.QueryApp})
 }
 
+// Implements api.ServiceClient.
+func (sc *serviceClient) DeliverBlock(ctx context.Context, height int64) error {
+	if sc.rewardsIndex == nil {
+		return nil
+	}
+	if err := sc.indexRewards(ctx, height); err != nil {
+		return fmt.Errorf("staking: failed to index rewards: %w", err)
+	}
+	return nil
+}
+
 // Implements api.ServiceClient.
 func (sc *serviceClient) DeliverEvent(ctx context.Context, height int64, tx tmtypes.Tx, ev *tmabcitypes.Event) error {
 	events, err := EventsFromTendermint(tx, height, []tmabcitypes.Event{*ev})
@@ -358,6 +374,16 @@ func EventsFromTendermint(
 
 				evt := &api.Event{Height: height, TxHash: txHash, Escrow: &api.EscrowEvent{Reclaim: &e}}
 				events = append(events, evt)
+			case eventsAPI.IsAttributeKind(key, &api.RedelegateEscrowEvent{}):
+				// Redelegate escrow event.
+				var e api.RedelegateEscrowEvent
+				if err := eventsAPI.DecodeValue(string(val), &e); err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("staking: corrupt RedelegateEscrow event: %w", err))
+					continue
+				}
+
+				evt := &api.Event{Height: height, TxHash: txHash, Escrow: &api.EscrowEvent{Redelegate: &e}}
+				events = append(events, evt)
 			case eventsAPI.IsAttributeKind(key, &api.AddEscrowEvent{}):
 				// Add escrow event.
 				var e api.AddEscrowEvent
@@ -398,6 +424,8 @@ func EventsFromTendermint(
 
 				evt := &api.Event{Height: height, TxHash: txHash, AllowanceChange: &e}
 				events = append(events, evt)
+			case eventsAPI.IsAttributeKind(key, &transaction.BatchCallEvent{}):
+				// Batch call events are handled by the multiplexer.
 			default:
 				errs = multierror.Append(errs, fmt.Errorf("staking: unknown event type: key: %s, val: %s", key, val))
 			}
@@ -408,7 +436,9 @@ func EventsFromTendermint(
 }
 
 // New constructs a new tendermint backed staking Backend instance.
-func New(ctx context.Context, backend tmapi.Backend) (ServiceClient, error) {
+//
+// If rewardsIndexDB is not nil, the escrow rewards index is maintained in the given database.
+func New(ctx context.Context, backend tmapi.Backend, rewardsIndexDB tmdb.DB) (ServiceClient, error) {
 	// Initialize and register the tendermint service component.
 	a := app.New()
 	if err := backend.RegisterApplication(a); err != nil {
@@ -420,10 +450,14 @@ func New(ctx context.Context, backend tmapi.Backend) (ServiceClient, error) {
 		return nil, err
 	}
 
-	return &serviceClient{
+	sc := &serviceClient{
 		logger:        logging.GetLogger("staking/tendermint"),
 		backend:       backend,
 		querier:       a.QueryFactory().(*app.QueryFactory),
 		eventNotifier: pubsub.NewBroker(false),
-	}, nil
+	}
+	if rewardsIndexDB != nil {
+		sc.rewardsIndex = newRewardsIndex(rewardsIndexDB)
+	}
+	return sc, nil
 }
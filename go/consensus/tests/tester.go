@@ -112,6 +112,10 @@ func ConsensusImplementationTests(t *testing.T, backend consensus.ClientBackend)
 	require.NoError(err, "GetSignerNonce")
 	require.Equal(uint64(0), nonce, "Nonce should be zero")
 
+	minGasPrice, err := backend.GetMinGasPrice(ctx, consensus.HeightLatest)
+	require.NoError(err, "GetMinGasPrice")
+	require.True(minGasPrice.IsZero(), "minimum gas price should be zero without a base fee")
+
 	// Light client API.
 	shdr, err := backend.GetLightBlock(ctx, blk.Height)
 	require.NoError(err, "GetLightBlock")
@@ -15,6 +15,7 @@ import (
 	p2p "github.com/oasisprotocol/oasis-core/go/p2p/api"
 	registry "github.com/oasisprotocol/oasis-core/go/registry/api"
 	block "github.com/oasisprotocol/oasis-core/go/roothash/api/block"
+	"github.com/oasisprotocol/oasis-core/go/runtime/txpool"
 	storage "github.com/oasisprotocol/oasis-core/go/storage/api"
 	upgrade "github.com/oasisprotocol/oasis-core/go/upgrade/api"
 	commonWorker "github.com/oasisprotocol/oasis-core/go/worker/common/api"
@@ -26,8 +27,18 @@ import (
 // ModuleName is the module name for the controller service.
 const ModuleName = "control"
 
-// ErrNotImplemented is the error raised when the node does not support the required functionality.
-var ErrNotImplemented = errors.New(ModuleName, 1, "control: not implemented")
+var (
+	// ErrNotImplemented is the error raised when the node does not support the required functionality.
+	ErrNotImplemented = errors.New(ModuleName, 1, "control: not implemented")
+
+	// ErrNoTransactionPool is the error raised when the node does not have a transaction pool
+	// for the given runtime.
+	ErrNoTransactionPool = errors.New(ModuleName, 2, "control: no transaction pool for runtime")
+
+	// ErrRuntimeNotSupported is the error raised when the given runtime cannot be added to or
+	// removed from the node.
+	ErrRuntimeNotSupported = errors.New(ModuleName, 3, "control: runtime not supported")
+)
 
 // NodeController is a node controller interface.
 type NodeController interface {
@@ -59,6 +70,32 @@ type NodeController interface {
 
 	// GetStatus returns the current status overview of the node.
 	GetStatus(ctx context.Context) (*Status, error)
+
+	// GetTransactionPool returns a snapshot of the transaction pool contents of the given runtime.
+	GetTransactionPool(ctx context.Context, runtimeID common.Namespace) (*txpool.Snapshot, error)
+
+	// AddRuntime adds support for a new runtime to a running node.
+	//
+	// The runtime's history, storage and host are provisioned and the node re-registers in order
+	// to include the new runtime in its descriptor.
+	AddRuntime(ctx context.Context, req *AddRuntimeRequest) error
+
+	// RemoveRuntime removes support for the given runtime from a running node.
+	//
+	// All runtime workers are stopped, the hosted runtime is terminated and the node re-registers
+	// without the runtime in its descriptor. Local runtime state is retained.
+	RemoveRuntime(ctx context.Context, runtimeID common.Namespace) error
+}
+
+// AddRuntimeRequest is a request to add support for a new runtime to a running node.
+type AddRuntimeRequest struct {
+	// Bundle is the path to the runtime bundle on the node's filesystem.
+	Bundle string `json:"bundle"`
+
+	// Mode is the runtime mode. If empty, the node's configured runtime mode is used.
+	//
+	// Runtimes can currently only be added using the same mode as configured for the node.
+	Mode string `json:"mode,omitempty"`
 }
 
 // Status is the current status overview.
@@ -5,7 +5,9 @@ import (
 
 	"google.golang.org/grpc"
 
+	"github.com/oasisprotocol/oasis-core/go/common"
 	cmnGrpc "github.com/oasisprotocol/oasis-core/go/common/grpc"
+	"github.com/oasisprotocol/oasis-core/go/runtime/txpool"
 	upgradeApi "github.com/oasisprotocol/oasis-core/go/upgrade/api"
 )
 
@@ -29,6 +31,12 @@ var (
 	methodCancelUpgrade = serviceName.NewMethod("CancelUpgrade", nil)
 	// methodGetStatus is the GetStatus method.
 	methodGetStatus = serviceName.NewMethod("GetStatus", nil)
+	// methodGetTransactionPool is the GetTransactionPool method.
+	methodGetTransactionPool = serviceName.NewMethod("GetTransactionPool", common.Namespace{})
+	// methodAddRuntime is the AddRuntime method.
+	methodAddRuntime = serviceName.NewMethod("AddRuntime", AddRuntimeRequest{})
+	// methodRemoveRuntime is the RemoveRuntime method.
+	methodRemoveRuntime = serviceName.NewMethod("RemoveRuntime", common.Namespace{})
 
 	// serviceDesc is the gRPC service descriptor.
 	serviceDesc = grpc.ServiceDesc{
@@ -67,6 +75,18 @@ var (
 				MethodName: methodGetStatus.ShortName(),
 				Handler:    handlerGetStatus,
 			},
+			{
+				MethodName: methodGetTransactionPool.ShortName(),
+				Handler:    handlerGetTransactionPool,
+			},
+			{
+				MethodName: methodAddRuntime.ShortName(),
+				Handler:    handlerAddRuntime,
+			},
+			{
+				MethodName: methodRemoveRuntime.ShortName(),
+				Handler:    handlerRemoveRuntime,
+			},
 		},
 		Streams: []grpc.StreamDesc{},
 	}
@@ -236,6 +256,75 @@ func handlerGetStatus(
 	return interceptor(ctx, nil, info, handler)
 }
 
+func handlerGetTransactionPool(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	var runtimeID common.Namespace
+	if err := dec(&runtimeID); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeController).GetTransactionPool(ctx, runtimeID)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: methodGetTransactionPool.FullName(),
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeController).GetTransactionPool(ctx, req.(common.Namespace))
+	}
+	return interceptor(ctx, runtimeID, info, handler)
+}
+
+func handlerAddRuntime(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	var req AddRuntimeRequest
+	if err := dec(&req); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return nil, srv.(NodeController).AddRuntime(ctx, &req)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: methodAddRuntime.FullName(),
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, srv.(NodeController).AddRuntime(ctx, req.(*AddRuntimeRequest))
+	}
+	return interceptor(ctx, &req, info, handler)
+}
+
+func handlerRemoveRuntime(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	var runtimeID common.Namespace
+	if err := dec(&runtimeID); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return nil, srv.(NodeController).RemoveRuntime(ctx, runtimeID)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: methodRemoveRuntime.FullName(),
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, srv.(NodeController).RemoveRuntime(ctx, req.(common.Namespace))
+	}
+	return interceptor(ctx, runtimeID, info, handler)
+}
+
 // RegisterService registers a new node controller service with the given gRPC server.
 func RegisterService(server *grpc.Server, service NodeController) {
 	server.RegisterService(&serviceDesc, service)
@@ -289,6 +378,22 @@ func (c *nodeControllerClient) GetStatus(ctx context.Context) (*Status, error) {
 	return &rsp, nil
 }
 
+func (c *nodeControllerClient) GetTransactionPool(ctx context.Context, runtimeID common.Namespace) (*txpool.Snapshot, error) {
+	var rsp txpool.Snapshot
+	if err := c.conn.Invoke(ctx, methodGetTransactionPool.FullName(), runtimeID, &rsp); err != nil {
+		return nil, err
+	}
+	return &rsp, nil
+}
+
+func (c *nodeControllerClient) AddRuntime(ctx context.Context, req *AddRuntimeRequest) error {
+	return c.conn.Invoke(ctx, methodAddRuntime.FullName(), req, nil)
+}
+
+func (c *nodeControllerClient) RemoveRuntime(ctx context.Context, runtimeID common.Namespace) error {
+	return c.conn.Invoke(ctx, methodRemoveRuntime.FullName(), runtimeID, nil)
+}
+
 // NewNodeControllerClient creates a new gRPC node controller client service.
 func NewNodeControllerClient(c *grpc.ClientConn) NodeController {
 	return &nodeControllerClient{c}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/url"
 	"reflect"
 
 	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
@@ -16,6 +17,7 @@ import (
 	"github.com/oasisprotocol/oasis-core/go/common/pubsub"
 	"github.com/oasisprotocol/oasis-core/go/common/quantity"
 	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
+	registry "github.com/oasisprotocol/oasis-core/go/registry/api"
 	staking "github.com/oasisprotocol/oasis-core/go/staking/api"
 	upgrade "github.com/oasisprotocol/oasis-core/go/upgrade/api"
 )
@@ -27,6 +29,13 @@ const ModuleName = "governance"
 // ProposalContent.
 const ProposalContentInvalidText = "(invalid)"
 
+const (
+	// MaxSignalingTitleLength is the maximum length of a signaling proposal title.
+	MaxSignalingTitleLength = 256
+	// MaxSignalingURILength is the maximum length of a signaling proposal URI.
+	MaxSignalingURILength = 1024
+)
+
 var (
 	// ErrInvalidArgument is the error returned on malformed argument(s).
 	ErrInvalidArgument = errors.New(ModuleName, 1, "governance: invalid argument")
@@ -58,6 +67,8 @@ var (
 	_ prettyprint.PrettyPrinter = (*UpgradeProposal)(nil)
 	_ prettyprint.PrettyPrinter = (*CancelUpgradeProposal)(nil)
 	_ prettyprint.PrettyPrinter = (*ChangeParametersProposal)(nil)
+	_ prettyprint.PrettyPrinter = (*UpdateRuntimeProposal)(nil)
+	_ prettyprint.PrettyPrinter = (*SignalingProposal)(nil)
 	_ prettyprint.PrettyPrinter = (*ProposalVote)(nil)
 )
 
@@ -66,6 +77,8 @@ type ProposalContent struct {
 	Upgrade          *UpgradeProposal          `json:"upgrade,omitempty"`
 	CancelUpgrade    *CancelUpgradeProposal    `json:"cancel_upgrade,omitempty"`
 	ChangeParameters *ChangeParametersProposal `json:"change_parameters,omitempty"`
+	UpdateRuntime    *UpdateRuntimeProposal    `json:"update_runtime,omitempty"`
+	Signaling        *SignalingProposal        `json:"signaling,omitempty"`
 }
 
 // ValidateBasic performs basic proposal content validity checks.
@@ -91,6 +104,14 @@ func (p *ProposalContent) ValidateBasic() error {
 		if err := p.ChangeParameters.ValidateBasic(); err != nil {
 			return fmt.Errorf("change parameters proposal validation failed: %w", err)
 		}
+	case p.UpdateRuntime != nil:
+		if err := p.UpdateRuntime.ValidateBasic(); err != nil {
+			return fmt.Errorf("update runtime proposal validation failed: %w", err)
+		}
+	case p.Signaling != nil:
+		if err := p.Signaling.ValidateBasic(); err != nil {
+			return fmt.Errorf("signaling proposal validation failed: %w", err)
+		}
 	default:
 		return fmt.Errorf("proposal content has no fields set")
 	}
@@ -118,6 +139,12 @@ func (p *ProposalContent) Equals(other *ProposalContent) bool {
 	if !p.ChangeParameters.Equals(other.ChangeParameters) {
 		return false
 	}
+	if !p.UpdateRuntime.Equals(other.UpdateRuntime) {
+		return false
+	}
+	if !p.Signaling.Equals(other.Signaling) {
+		return false
+	}
 	return true
 }
 
@@ -131,6 +158,12 @@ func (p ProposalContent) PrettyPrint(ctx context.Context, prefix string, w io.Wr
 	case p.CancelUpgrade != nil && p.Upgrade == nil:
 		fmt.Fprintf(w, "%sCancel Upgrade:\n", prefix)
 		p.CancelUpgrade.PrettyPrint(ctx, prefix+"  ", w)
+	case p.UpdateRuntime != nil:
+		fmt.Fprintf(w, "%sUpdate Runtime:\n", prefix)
+		p.UpdateRuntime.PrettyPrint(ctx, prefix+"  ", w)
+	case p.Signaling != nil:
+		fmt.Fprintf(w, "%sSignaling:\n", prefix)
+		p.Signaling.PrettyPrint(ctx, prefix+"  ", w)
 	default:
 		fmt.Fprintf(w, "%s%s\n", prefix, ProposalContentInvalidText)
 	}
@@ -254,6 +287,127 @@ func (p *ChangeParametersProposal) ValidateBasic() error {
 	return nil
 }
 
+// UpdateRuntimeProposal is a runtime descriptor update proposal for runtimes
+// governed by the consensus layer.
+type UpdateRuntimeProposal struct {
+	// Runtime is the updated runtime descriptor.
+	Runtime registry.Runtime `json:"runtime"`
+}
+
+// Equals checks if update runtime proposals are equal.
+func (p *UpdateRuntimeProposal) Equals(other *UpdateRuntimeProposal) bool {
+	if p == other {
+		return true
+	}
+	if p == nil || other == nil {
+		return false
+	}
+	return bytes.Equal(cbor.Marshal(p.Runtime), cbor.Marshal(other.Runtime))
+}
+
+// PrettyPrint writes a pretty-printed representation of UpdateRuntimeProposal to the given
+// writer.
+func (p *UpdateRuntimeProposal) PrettyPrint(ctx context.Context, prefix string, w io.Writer) {
+	fmt.Fprintf(w, "%sRuntime ID: %s\n", prefix, p.Runtime.ID)
+	fmt.Fprintf(w, "%sKind: %s\n", prefix, p.Runtime.Kind)
+	fmt.Fprintf(w, "%sDeployments:\n", prefix)
+	for _, d := range p.Runtime.Deployments {
+		fmt.Fprintf(w, "%s  - Version: %s\n", prefix, d.Version)
+		fmt.Fprintf(w, "%s    Valid From: %d\n", prefix, d.ValidFrom)
+	}
+}
+
+// PrettyType returns a representation of UpdateRuntimeProposal that can be used for pretty
+// printing.
+func (p *UpdateRuntimeProposal) PrettyType() (interface{}, error) {
+	return p, nil
+}
+
+// ValidateBasic performs a basic validation on the update runtime proposal.
+func (p *UpdateRuntimeProposal) ValidateBasic() error {
+	if err := p.Runtime.ValidateBasic(true); err != nil {
+		return fmt.Errorf("invalid runtime descriptor: %w", err)
+	}
+	if p.Runtime.GovernanceModel != registry.GovernanceConsensus {
+		return fmt.Errorf("invalid runtime descriptor: runtime must be governed by the consensus layer")
+	}
+	return nil
+}
+
+// SignalingProposal is a non-binding proposal that has no effect on execution.
+//
+// It can be used to signal community sentiment (e.g., to ratify a roadmap) using the same
+// voting rules as all other proposals. The proposal text itself is kept off-chain and is
+// referenced by its hash and an optional URI.
+type SignalingProposal struct {
+	// Title is a short human readable title of the proposal.
+	Title string `json:"title"`
+	// ContentHash is the hash of the off-chain proposal text.
+	ContentHash hash.Hash `json:"content_hash"`
+	// URI is an optional location of the off-chain proposal text.
+	URI string `json:"uri,omitempty"`
+}
+
+// Equals checks if signaling proposals are equal.
+func (p *SignalingProposal) Equals(other *SignalingProposal) bool {
+	if p == other {
+		return true
+	}
+	if p == nil || other == nil {
+		return false
+	}
+	if p.Title != other.Title {
+		return false
+	}
+	if !p.ContentHash.Equal(&other.ContentHash) {
+		return false
+	}
+	if p.URI != other.URI {
+		return false
+	}
+	return true
+}
+
+// PrettyPrint writes a pretty-printed representation of SignalingProposal to the given writer.
+func (p *SignalingProposal) PrettyPrint(ctx context.Context, prefix string, w io.Writer) {
+	fmt.Fprintf(w, "%sTitle: %s\n", prefix, p.Title)
+	fmt.Fprintf(w, "%sContent Hash: %s\n", prefix, p.ContentHash)
+	if p.URI != "" {
+		fmt.Fprintf(w, "%sURI: %s\n", prefix, p.URI)
+	}
+}
+
+// PrettyType returns a representation of SignalingProposal that can be used for pretty printing.
+func (p *SignalingProposal) PrettyType() (interface{}, error) {
+	return p, nil
+}
+
+// ValidateBasic performs a basic validation on the signaling proposal.
+func (p *SignalingProposal) ValidateBasic() error {
+	if len(p.Title) == 0 {
+		return fmt.Errorf("invalid title: title should not be empty")
+	}
+	if len(p.Title) > MaxSignalingTitleLength {
+		return fmt.Errorf("invalid title: title should not be longer than %d bytes", MaxSignalingTitleLength)
+	}
+	if p.ContentHash.Equal(&hash.Hash{}) || p.ContentHash.IsEmpty() {
+		return fmt.Errorf("invalid content hash: hash should not be empty")
+	}
+	if p.URI != "" {
+		if len(p.URI) > MaxSignalingURILength {
+			return fmt.Errorf("invalid URI: URI should not be longer than %d bytes", MaxSignalingURILength)
+		}
+		u, err := url.Parse(p.URI)
+		if err != nil {
+			return fmt.Errorf("invalid URI: %w", err)
+		}
+		if u.Scheme == "" {
+			return fmt.Errorf("invalid URI: URI should be absolute")
+		}
+	}
+	return nil
+}
+
 // ProposalVote is a vote for a proposal.
 type ProposalVote struct {
 	// ID is the unique identifier of a proposal.
@@ -275,6 +429,11 @@ func (pv ProposalVote) PrettyType() (interface{}, error) {
 	return pv, nil
 }
 
+// MethodMetadata returns the method metadata of ProposalVote.
+func (pv ProposalVote) MethodMetadata() transaction.MethodMetadata {
+	return transaction.MethodMetadata{AllowMultisig: true}
+}
+
 // Backend is a governance implementation.
 type Backend interface {
 	// ActiveProposals returns a list of all proposals that have not yet closed.
@@ -286,7 +445,7 @@ type Backend interface {
 	// Proposal looks up a specific proposal.
 	Proposal(ctx context.Context, query *ProposalQuery) (*Proposal, error)
 
-	// Votes looks up votes for a specific proposal.
+	// Votes looks up votes for a specific proposal together with their effective voting power.
 	Votes(ctx context.Context, query *ProposalQuery) ([]*VoteEntry, error)
 
 	// PendingUpgrades returns a list of all pending upgrades.
@@ -318,6 +477,16 @@ type ProposalQuery struct {
 type VoteEntry struct {
 	Voter staking.Address `json:"voter"`
 	Vote  Vote            `json:"vote"`
+
+	// VotingPower is the effective voting power of the vote, computed from the voter's shares in
+	// the escrow pools of the validator entities at the time the proposal was closed (or at the
+	// query height for active proposals). Validators vote with all of the shares in their escrow
+	// pool except for the shares of delegators that cast their own vote.
+	//
+	// It is only populated when querying votes via the Votes method and delegated voting is
+	// enabled. It is also omitted for closed proposals when the state at the closing height is
+	// no longer available (e.g., because it has been pruned).
+	VotingPower *quantity.Quantity `json:"voting_power,omitempty"`
 }
 
 // Genesis is the initial governance state for use in the genesis block.
@@ -364,6 +533,14 @@ type ConsensusParameters struct {
 
 	// EnableChangeParametersProposal is true iff change parameters proposals are allowed.
 	EnableChangeParametersProposal bool `json:"enable_change_parameters_proposal,omitempty"`
+
+	// EnableUpdateRuntimeProposal is true iff update runtime proposals are allowed.
+	EnableUpdateRuntimeProposal bool `json:"enable_update_runtime_proposal,omitempty"`
+
+	// EnableDelegatedVoting is true iff votes are tallied using the delegations to validator
+	// entities so that delegator votes override the vote of the validator in proportion to their
+	// shares in the validator's escrow pool.
+	EnableDelegatedVoting bool `json:"enable_delegated_voting,omitempty"`
 }
 
 // ConsensusParameterChanges are allowed governance consensus parameter changes.
@@ -389,6 +566,12 @@ type ConsensusParameterChanges struct {
 
 	// EnableChangeParametersProposal is the new enable change parameters proposal flag.
 	EnableChangeParametersProposal *bool `json:"enable_change_parameters_proposal,omitempty"`
+
+	// EnableUpdateRuntimeProposal is the new enable update runtime proposal flag.
+	EnableUpdateRuntimeProposal *bool `json:"enable_update_runtime_proposal,omitempty"`
+
+	// EnableDelegatedVoting is the new enable delegated voting flag.
+	EnableDelegatedVoting *bool `json:"enable_delegated_voting,omitempty"`
 }
 
 // Apply applies changes to the given consensus parameters.
@@ -414,6 +597,12 @@ func (c *ConsensusParameterChanges) Apply(params *ConsensusParameters) error {
 	if c.EnableChangeParametersProposal != nil {
 		params.EnableChangeParametersProposal = *c.EnableChangeParametersProposal
 	}
+	if c.EnableUpdateRuntimeProposal != nil {
+		params.EnableUpdateRuntimeProposal = *c.EnableUpdateRuntimeProposal
+	}
+	if c.EnableDelegatedVoting != nil {
+		params.EnableDelegatedVoting = *c.EnableDelegatedVoting
+	}
 	return nil
 }
 
@@ -426,6 +615,7 @@ type Event struct {
 	ProposalExecuted  *ProposalExecutedEvent  `json:"proposal_executed,omitempty"`
 	ProposalFinalized *ProposalFinalizedEvent `json:"proposal_finalized,omitempty"`
 	Vote              *VoteEvent              `json:"vote,omitempty"`
+	DelegatorVote     *DelegatorVoteEvent     `json:"delegator_vote,omitempty"`
 }
 
 // ProposalSubmittedEvent is the event emitted when a new proposal is submitted.
@@ -480,6 +670,25 @@ func (e *VoteEvent) EventKind() string {
 	return "vote"
 }
 
+// DelegatorVoteEvent is the event emitted when a vote is cast by a delegator which is not a
+// validator itself. Such a vote overrides the vote of the validators for the delegator's shares.
+type DelegatorVoteEvent struct {
+	// ID is the unique identifier of a proposal.
+	ID uint64 `json:"id"`
+	// Submitter is the staking account address of the vote submitter.
+	Submitter staking.Address `json:"submitter"`
+	// Vote is the cast vote.
+	Vote Vote `json:"vote"`
+	// Validators are the staking account addresses of the current validator entities to which
+	// the submitter delegates.
+	Validators []staking.Address `json:"validators"`
+}
+
+// EventKind returns a string representation of this event's kind.
+func (e *DelegatorVoteEvent) EventKind() string {
+	return "delegator_vote"
+}
+
 // NewSubmitProposalTx creates a new submit proposal transaction.
 func NewSubmitProposalTx(nonce uint64, fee *transaction.Fee, proposal *ProposalContent) *transaction.Transaction {
 	return transaction.NewTransaction(nonce, fee, MethodSubmitProposal, proposal)
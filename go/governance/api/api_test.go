@@ -4,13 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
 	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
 	"github.com/oasisprotocol/oasis-core/go/common/version"
+	registry "github.com/oasisprotocol/oasis-core/go/registry/api"
 	upgrade "github.com/oasisprotocol/oasis-core/go/upgrade/api"
 )
 
@@ -61,6 +64,63 @@ func TestValidateBasic(t *testing.T) {
 			},
 			shouldErr: false,
 		},
+		{
+			msg: "update runtime with invalid runtime descriptor should fail",
+			p: &ProposalContent{
+				UpdateRuntime: &UpdateRuntimeProposal{},
+			},
+			shouldErr: true,
+		},
+		{
+			msg: "signaling proposal without title should fail",
+			p: &ProposalContent{
+				Signaling: &SignalingProposal{
+					ContentHash: hash.NewFromBytes([]byte("content")),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			msg: "signaling proposal without content hash should fail",
+			p: &ProposalContent{
+				Signaling: &SignalingProposal{
+					Title: "title",
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			msg: "signaling proposal with relative URI should fail",
+			p: &ProposalContent{
+				Signaling: &SignalingProposal{
+					Title:       "title",
+					ContentHash: hash.NewFromBytes([]byte("content")),
+					URI:         "roadmap.md",
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			msg: "signaling proposal with too long title should fail",
+			p: &ProposalContent{
+				Signaling: &SignalingProposal{
+					Title:       strings.Repeat("a", MaxSignalingTitleLength+1),
+					ContentHash: hash.NewFromBytes([]byte("content")),
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			msg: "valid signaling proposal should not fail",
+			p: &ProposalContent{
+				Signaling: &SignalingProposal{
+					Title:       "title",
+					ContentHash: hash.NewFromBytes([]byte("content")),
+					URI:         "https://example.com/roadmap.md",
+				},
+			},
+			shouldErr: false,
+		},
 	} {
 		err := tc.p.ValidateBasic()
 		if tc.shouldErr {
@@ -138,6 +198,54 @@ func TestProposalContentEquals(t *testing.T) {
 			},
 			equals: false,
 		},
+		{
+			msg: "update runtime proposals should be equal",
+			p1: &ProposalContent{
+				UpdateRuntime: &UpdateRuntimeProposal{
+					Runtime: registry.Runtime{Kind: registry.KindCompute},
+				},
+			},
+			p2: &ProposalContent{
+				UpdateRuntime: &UpdateRuntimeProposal{
+					Runtime: registry.Runtime{Kind: registry.KindCompute},
+				},
+			},
+			equals: true,
+		},
+		{
+			msg: "update runtime proposals should not be equal",
+			p1: &ProposalContent{
+				UpdateRuntime: &UpdateRuntimeProposal{
+					Runtime: registry.Runtime{Kind: registry.KindCompute},
+				},
+			},
+			p2: &ProposalContent{
+				UpdateRuntime: &UpdateRuntimeProposal{
+					Runtime: registry.Runtime{Kind: registry.KindKeyManager},
+				},
+			},
+			equals: false,
+		},
+		{
+			msg: "signaling proposals should be equal",
+			p1: &ProposalContent{
+				Signaling: &SignalingProposal{Title: "test", URI: "https://example.com"},
+			},
+			p2: &ProposalContent{
+				Signaling: &SignalingProposal{Title: "test", URI: "https://example.com"},
+			},
+			equals: true,
+		},
+		{
+			msg: "signaling proposals should not be equal",
+			p1: &ProposalContent{
+				Signaling: &SignalingProposal{Title: "test"},
+			},
+			p2: &ProposalContent{
+				Signaling: &SignalingProposal{Title: "test2"},
+			},
+			equals: false,
+		},
 	} {
 		require.Equal(t, tc.equals, tc.p1.Equals(tc.p2), tc.msg)
 	}
@@ -162,6 +270,20 @@ func TestProposalContentPrettyPrint(t *testing.T) {
 				CancelUpgrade: &CancelUpgradeProposal{ProposalID: 42},
 			},
 		},
+		{
+			expRegex: "^Update Runtime:",
+			p: &ProposalContent{
+				UpdateRuntime: &UpdateRuntimeProposal{
+					Runtime: registry.Runtime{Kind: registry.KindCompute},
+				},
+			},
+		},
+		{
+			expRegex: "^Signaling:",
+			p: &ProposalContent{
+				Signaling: &SignalingProposal{Title: "test"},
+			},
+		},
 		{
 			expRegex: ProposalContentInvalidText,
 			p:        &ProposalContent{},
@@ -41,7 +41,9 @@ func (c *ConsensusParameterChanges) SanityCheck() error {
 		c.StakeThreshold == nil &&
 		c.UpgradeMinEpochDiff == nil &&
 		c.UpgradeCancelMinEpochDiff == nil &&
-		c.EnableChangeParametersProposal == nil {
+		c.EnableChangeParametersProposal == nil &&
+		c.EnableUpdateRuntimeProposal == nil &&
+		c.EnableDelegatedVoting == nil {
 		return fmt.Errorf("consensus parameter changes should not be empty")
 	}
 	return nil
@@ -88,6 +90,10 @@ func SanityCheckProposals(proposals []*Proposal, epoch beacon.EpochTime, governa
 			if p.ClosesAt > epoch {
 				return fmt.Errorf("proposal %v: closed proposal with future closing epoch", p.ID)
 			}
+			// Signaling proposals have no execution effect so they can never fail.
+			if p.Content.Signaling != nil && p.State == StateFailed {
+				return fmt.Errorf("proposal %v: failed signaling proposal", p.ID)
+			}
 		}
 	}
 	// Ensure active proposal deposits matches governance deposit state.
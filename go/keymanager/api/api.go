@@ -2,10 +2,12 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"time"
 
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
 	"github.com/oasisprotocol/oasis-core/go/common"
 	"github.com/oasisprotocol/oasis-core/go/common/cbor"
 	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
@@ -34,9 +36,19 @@ var (
 	// exist.
 	ErrNoSuchStatus = errors.New(ModuleName, 2, "keymanager: no such status")
 
+	// ErrNoSuchMasterSecret is the error returned when a key manager master secret proposal
+	// does not exist.
+	ErrNoSuchMasterSecret = errors.New(ModuleName, 3, "keymanager: no such master secret")
+
+	// ErrRotationNotAllowed is the error returned when a master secret rotation is not allowed.
+	ErrRotationNotAllowed = errors.New(ModuleName, 4, "keymanager: master secret rotation not allowed")
+
 	// MethodUpdatePolicy is the method name for policy updates.
 	MethodUpdatePolicy = transaction.NewMethodName(ModuleName, "UpdatePolicy", SignedPolicySGX{})
 
+	// MethodPublishMasterSecret is the method name for publishing master secret proposals.
+	MethodPublishMasterSecret = transaction.NewMethodName(ModuleName, "PublishMasterSecret", SignedMasterSecret{})
+
 	// TestPublicKey is the insecure hardcoded key manager public key, used
 	// in insecure builds when a RAK is unavailable.
 	TestPublicKey signature.PublicKey
@@ -48,6 +60,7 @@ var (
 	// Methods is the list of all methods supported by the key manager backend.
 	Methods = []transaction.MethodName{
 		MethodUpdatePolicy,
+		MethodPublishMasterSecret,
 	}
 
 	initResponseContext = signature.NewContext("oasis-core/keymanager: init response")
@@ -64,9 +77,20 @@ type Status struct {
 	// IsSecure is true iff the key manager is secure.
 	IsSecure bool `json:"is_secure"`
 
-	// Checksum is the key manager master secret verification checksum.
+	// Checksum is the key manager master secret verification checksum
+	// of the latest generation.
 	Checksum []byte `json:"checksum"`
 
+	// Generation is the generation of the latest master secret.
+	Generation uint64 `json:"generation,omitempty"`
+
+	// RotationEpoch is the epoch of the last master secret rotation.
+	RotationEpoch beacon.EpochTime `json:"rotation_epoch,omitempty"`
+
+	// Checksums are the master secret verification checksums of all
+	// generations, indexed by generation.
+	Checksums [][]byte `json:"checksums,omitempty"`
+
 	// Nodes is the list of currently active key manager node IDs.
 	Nodes []signature.PublicKey `json:"nodes"`
 
@@ -74,6 +98,27 @@ type Status struct {
 	Policy *SignedPolicySGX `json:"policy"`
 }
 
+// GenerationChecksum returns the master secret verification checksum of the given generation.
+func (s *Status) GenerationChecksum(generation uint64) ([]byte, bool) {
+	if generation >= uint64(len(s.Checksums)) {
+		return nil, false
+	}
+	return s.Checksums[generation], true
+}
+
+// NextRotationEpoch returns the earliest epoch in which the next generation of the master
+// secret can become active, and false if master secret rotation is disabled.
+func (s *Status) NextRotationEpoch() (beacon.EpochTime, bool) {
+	if !s.IsInitialized || s.Policy == nil {
+		return beacon.EpochInvalid, false
+	}
+	interval := s.Policy.Policy.MasterSecretRotationInterval
+	if interval == 0 {
+		return beacon.EpochInvalid, false
+	}
+	return s.RotationEpoch + interval, true
+}
+
 // Backend is a key manager management implementation.
 type Backend interface {
 	// GetStatus returns a key manager status by key manager ID.
@@ -88,6 +133,13 @@ type Backend interface {
 	// Upon subscription the current status is sent immediately.
 	WatchStatuses() (<-chan *Status, *pubsub.Subscription)
 
+	// GetMasterSecret returns the latest master secret proposal for the given key manager.
+	GetMasterSecret(context.Context, *registry.NamespaceQuery) (*SignedMasterSecret, error)
+
+	// WatchMasterSecrets returns a channel that produces a stream of master secret proposals
+	// as they are published.
+	WatchMasterSecrets() (<-chan *SignedMasterSecret, *pubsub.Subscription)
+
 	// StateToGenesis returns the genesis state at specified block height.
 	StateToGenesis(context.Context, int64) (*Genesis, error)
 }
@@ -181,6 +233,23 @@ func SanityCheckStatuses(statuses []*Status) error {
 			}
 		}
 
+		// Verify master secret checksums.
+		if len(status.Checksums) > 0 {
+			if uint64(len(status.Checksums)) != status.Generation+1 {
+				return fmt.Errorf("keymanager: sanity check failed: key manager %s has %d checksums for generation %d", status.ID, len(status.Checksums), status.Generation)
+			}
+			for gen, checksum := range status.Checksums {
+				if len(checksum) != ChecksumSize {
+					return fmt.Errorf("keymanager: sanity check failed: key manager %s checksum for generation %d is malformed", status.ID, gen)
+				}
+			}
+			if !bytes.Equal(status.Checksums[status.Generation], status.Checksum) {
+				return fmt.Errorf("keymanager: sanity check failed: key manager %s checksum does not match latest generation", status.ID)
+			}
+		} else if status.Generation != 0 {
+			return fmt.Errorf("keymanager: sanity check failed: key manager %s is missing checksums", status.ID)
+		}
+
 		// Verify SGX policy signatures if the policy exists.
 		if status.Policy != nil {
 			if err := SanityCheckSignedPolicySGX(nil, status.Policy); err != nil {
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common"
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	memorySigner "github.com/oasisprotocol/oasis-core/go/common/crypto/signature/signers/memory"
+)
+
+func TestSanityCheckStatusesChecksums(t *testing.T) {
+	require := require.New(t)
+
+	var id common.Namespace
+	require.NoError(id.UnmarshalHex("c000000000000000ffffffffffffffffffffffffffffffffffffffffffffffff"))
+
+	checksum := func(b byte) []byte {
+		c := make([]byte, ChecksumSize)
+		c[0] = b
+		return c
+	}
+
+	for _, tc := range []struct {
+		msg       string
+		status    *Status
+		shouldErr bool
+	}{
+		{
+			msg:    "status without checksums should pass",
+			status: &Status{ID: id},
+		},
+		{
+			msg: "status with valid checksums should pass",
+			status: &Status{
+				ID:         id,
+				Checksum:   checksum(2),
+				Generation: 1,
+				Checksums:  [][]byte{checksum(1), checksum(2)},
+			},
+		},
+		{
+			msg: "status with missing checksums should fail",
+			status: &Status{
+				ID:         id,
+				Checksum:   checksum(2),
+				Generation: 1,
+			},
+			shouldErr: true,
+		},
+		{
+			msg: "status with too few checksums should fail",
+			status: &Status{
+				ID:         id,
+				Checksum:   checksum(2),
+				Generation: 2,
+				Checksums:  [][]byte{checksum(1), checksum(2)},
+			},
+			shouldErr: true,
+		},
+		{
+			msg: "status with malformed checksum should fail",
+			status: &Status{
+				ID:         id,
+				Checksum:   checksum(2),
+				Generation: 1,
+				Checksums:  [][]byte{{1, 2, 3}, checksum(2)},
+			},
+			shouldErr: true,
+		},
+		{
+			msg: "status with mismatched latest checksum should fail",
+			status: &Status{
+				ID:         id,
+				Checksum:   checksum(3),
+				Generation: 1,
+				Checksums:  [][]byte{checksum(1), checksum(2)},
+			},
+			shouldErr: true,
+		},
+	} {
+		err := SanityCheckStatuses([]*Status{tc.status})
+		if tc.shouldErr {
+			require.Error(err, tc.msg)
+			continue
+		}
+		require.NoError(err, tc.msg)
+	}
+}
+
+func TestStatusNextRotationEpoch(t *testing.T) {
+	require := require.New(t)
+
+	status := &Status{IsInitialized: true, RotationEpoch: 10}
+	_, ok := status.NextRotationEpoch()
+	require.False(ok, "rotation should be disabled without a policy")
+
+	status.Policy = &SignedPolicySGX{}
+	_, ok = status.NextRotationEpoch()
+	require.False(ok, "rotation should be disabled with a zero interval")
+
+	status.Policy.Policy.MasterSecretRotationInterval = 5
+	epoch, ok := status.NextRotationEpoch()
+	require.True(ok, "rotation should be enabled")
+	require.EqualValues(15, epoch)
+
+	status.IsInitialized = false
+	_, ok = status.NextRotationEpoch()
+	require.False(ok, "rotation should be disabled for uninitialized key managers")
+}
+
+func TestSignedMasterSecret(t *testing.T) {
+	require := require.New(t)
+
+	var id common.Namespace
+	require.NoError(id.UnmarshalHex("c000000000000000ffffffffffffffffffffffffffffffffffffffffffffffff"))
+
+	signer := memorySigner.NewTestSigner("keymanager master secret test signer")
+	secret := MasterSecret{
+		ID:         id,
+		Generation: 1,
+		Epoch:      beacon.EpochTime(5),
+		Checksum:   make([]byte, ChecksumSize),
+	}
+	require.NoError(secret.SanityCheck(), "valid proposal should pass sanity check")
+
+	sig, err := signer.ContextSign(masterSecretSignatureContext, cbor.Marshal(secret))
+	require.NoError(err, "ContextSign")
+	sigSec := SignedMasterSecret{
+		Secret:    secret,
+		Signature: sig,
+	}
+	require.NoError(sigSec.Verify(signer.Public()), "signature should verify")
+	require.Error(sigSec.Verify(TestPublicKey), "signature should not verify with a different key")
+
+	sigSec.Secret.Generation = 2
+	require.Error(sigSec.Verify(signer.Public()), "signature should not verify for a modified proposal")
+
+	secret.Generation = 0
+	require.Error(secret.SanityCheck(), "generation zero should fail sanity check")
+	secret.Generation = 1
+	secret.Checksum = []byte{1, 2, 3}
+	require.Error(secret.SanityCheck(), "malformed checksum should fail sanity check")
+}
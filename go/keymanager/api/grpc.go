@@ -17,6 +17,8 @@ var (
 	methodGetStatus = serviceName.NewMethod("GetStatus", registry.NamespaceQuery{})
 	// methodGetStatuses is the GetStatuses method.
 	methodGetStatuses = serviceName.NewMethod("GetStatuses", int64(0))
+	// methodGetMasterSecret is the GetMasterSecret method.
+	methodGetMasterSecret = serviceName.NewMethod("GetMasterSecret", registry.NamespaceQuery{})
 
 	// serviceDesc is the gRPC service descriptor.
 	serviceDesc = grpc.ServiceDesc{
@@ -31,6 +33,10 @@ var (
 				MethodName: methodGetStatuses.ShortName(),
 				Handler:    handlerGetStatuses,
 			},
+			{
+				MethodName: methodGetMasterSecret.ShortName(),
+				Handler:    handlerGetMasterSecret,
+			},
 		},
 	}
 )
@@ -81,6 +87,29 @@ func handlerGetStatuses(
 	return interceptor(ctx, height, info, handler)
 }
 
+func handlerGetMasterSecret(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	var query registry.NamespaceQuery
+	if err := dec(&query); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Backend).GetMasterSecret(ctx, &query)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: methodGetMasterSecret.FullName(),
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Backend).GetMasterSecret(ctx, req.(*registry.NamespaceQuery))
+	}
+	return interceptor(ctx, &query, info, handler)
+}
+
 // RegisterService registers a new keymanager backend service with the given gRPC server.
 func RegisterService(server *grpc.Server, service Backend) {
 	server.RegisterService(&serviceDesc, service)
@@ -107,6 +136,14 @@ func (c *KeymanagerClient) GetStatuses(ctx context.Context, height int64) ([]*St
 	return resp, nil
 }
 
+func (c *KeymanagerClient) GetMasterSecret(ctx context.Context, query *registry.NamespaceQuery) (*SignedMasterSecret, error) {
+	var resp SignedMasterSecret
+	if err := c.conn.Invoke(ctx, methodGetMasterSecret.FullName(), query, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // NewKeymanagerClient creates a new gRPC keymanager client service.
 func NewKeymanagerClient(c *grpc.ClientConn) *KeymanagerClient {
 	return &KeymanagerClient{c}
@@ -3,6 +3,7 @@ package api
 import (
 	"fmt"
 
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
 	"github.com/oasisprotocol/oasis-core/go/common"
 	"github.com/oasisprotocol/oasis-core/go/common/cbor"
 	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
@@ -23,6 +24,10 @@ type PolicySGX struct {
 
 	// Enclaves is the per-key manager enclave ID access control policy.
 	Enclaves map[sgx.EnclaveIdentity]*EnclavePolicySGX `json:"enclaves"`
+
+	// MasterSecretRotationInterval is the minimum number of epochs between two consecutive
+	// master secret generations. Zero disables master secret rotation.
+	MasterSecretRotationInterval beacon.EpochTime `json:"master_secret_rotation_interval,omitempty"`
 }
 
 // EnclavePolicySGX is the per-SGX key manager enclave ID access control policy.
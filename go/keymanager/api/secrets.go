@@ -0,0 +1,79 @@
+package api
+
+import (
+	"fmt"
+
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common"
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
+)
+
+// masterSecretSignatureContext is the context used to sign master secret proposals.
+var masterSecretSignatureContext = signature.NewContext("oasis-core/keymanager: master secret")
+
+// MasterSecret is a proposal for a new generation of the key manager master secret.
+//
+// The secret itself never leaves the key manager enclaves, only its checksum is published
+// so that the consensus layer can track which enclaves have accepted the new generation.
+type MasterSecret struct {
+	// ID is the runtime ID of the key manager.
+	ID common.Namespace `json:"runtime_id"`
+
+	// Generation is the generation of the proposed master secret.
+	Generation uint64 `json:"generation"`
+
+	// Epoch is the epoch in which the proposed master secret should become active.
+	Epoch beacon.EpochTime `json:"epoch"`
+
+	// Checksum is the verification checksum of the proposed master secret.
+	Checksum []byte `json:"checksum"`
+}
+
+// SanityCheck performs a sanity check on the master secret proposal.
+func (s *MasterSecret) SanityCheck() error {
+	if !s.ID.IsKeyManager() {
+		return fmt.Errorf("keymanager: sanity check failed: key manager runtime ID %s is invalid", s.ID)
+	}
+	if s.Generation == 0 {
+		return fmt.Errorf("keymanager: sanity check failed: generation zero can only be generated during initialization")
+	}
+	if len(s.Checksum) != ChecksumSize {
+		return fmt.Errorf("keymanager: sanity check failed: master secret checksum is malformed")
+	}
+	return nil
+}
+
+// SignedMasterSecret is a master secret proposal signed by the key manager enclave.
+type SignedMasterSecret struct {
+	// Secret is the master secret proposal.
+	Secret MasterSecret `json:"secret"`
+
+	// Signature is the RAK signature of the master secret proposal.
+	Signature []byte `json:"signature"`
+}
+
+// Verify verifies the RAK signature of the master secret proposal.
+func (s *SignedMasterSecret) Verify(rak signature.PublicKey) error {
+	raw := cbor.Marshal(s.Secret)
+	if !rak.Verify(masterSecretSignatureContext, raw, s.Signature) {
+		return fmt.Errorf("keymanager: invalid master secret signature")
+	}
+	return nil
+}
+
+// NewPublishMasterSecretTx creates a new publish master secret transaction.
+func NewPublishMasterSecretTx(nonce uint64, fee *transaction.Fee, sigSec *SignedMasterSecret) *transaction.Transaction {
+	return transaction.NewTransaction(nonce, fee, MethodPublishMasterSecret, sigSec)
+}
+
+// MasterSecretPublishedEvent is the key manager master secret published event.
+type MasterSecretPublishedEvent struct {
+	Secret *SignedMasterSecret
+}
+
+// EventKind returns a string representation of this event's kind.
+func (ev *MasterSecretPublishedEvent) EventKind() string {
+	return "master_secret"
+}
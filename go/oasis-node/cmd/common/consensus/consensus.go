@@ -32,6 +32,15 @@ const (
 	// CfgTxFeeGas configures the maximum gas limit.
 	CfgTxFeeGas = "transaction.fee.gas"
 
+	// CfgTxFeePayer configures the public key of the account sponsoring the transaction fee.
+	CfgTxFeePayer = "transaction.fee.payer"
+
+	// CfgTxExpiryHeight configures the last height at which the transaction can be included.
+	CfgTxExpiryHeight = "transaction.expiry.height"
+
+	// CfgTxExpiryEpoch configures the last epoch in which the transaction can be included.
+	CfgTxExpiryEpoch = "transaction.expiry.epoch"
+
 	// CfgTxFile configures the filename for the transaction.
 	CfgTxFile = "transaction.file"
 
@@ -89,10 +98,41 @@ func GetTxNonceAndFee() (uint64, *transaction.Fee) {
 		os.Exit(1)
 	}
 	fee.Gas = transaction.Gas(viper.GetUint64(CfgTxFeeGas))
+	if payer := viper.GetString(CfgTxFeePayer); payer != "" {
+		fee.Payer = new(signature.PublicKey)
+		if err := fee.Payer.UnmarshalText([]byte(payer)); err != nil {
+			logger.Error("failed to parse fee payer public key",
+				"err", err,
+			)
+			os.Exit(1)
+		}
+	}
 	return nonce, &fee
 }
 
+// GetTxExpiry returns the configured transaction expiry or nil if no expiry is configured.
+func GetTxExpiry() *transaction.Expiry {
+	expiry := transaction.Expiry{
+		Height: viper.GetInt64(CfgTxExpiryHeight),
+		Epoch:  viper.GetUint64(CfgTxExpiryEpoch),
+	}
+	if expiry.Height == 0 && expiry.Epoch == 0 {
+		return nil
+	}
+	if err := expiry.ValidateBasic(); err != nil {
+		logger.Error("invalid transaction expiry",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+	return &expiry
+}
+
 func SignAndSaveTx(ctx context.Context, tx *transaction.Transaction, signer signature.Signer) {
+	if tx.Expiry == nil {
+		tx.Expiry = GetTxExpiry()
+	}
+
 	if viper.GetBool(CfgTxUnsigned) {
 		rawUnsignedTx := cbor.Marshal(tx)
 		if err := os.WriteFile(viper.GetString(CfgTxFile), rawUnsignedTx, 0o600); err != nil {
@@ -162,6 +202,9 @@ func init() {
 	TxFlags.Uint64(CfgTxNonce, 0, "nonce of the signing account")
 	TxFlags.Uint64(CfgTxFeeAmount, 0, "transaction fee in base units")
 	TxFlags.String(CfgTxFeeGas, "0", "maximum transaction gas limit")
+	TxFlags.String(CfgTxFeePayer, "", "public key of the account sponsoring the transaction fee, in base64")
+	TxFlags.Int64(CfgTxExpiryHeight, 0, "last consensus height at which the transaction can be included (0 for no limit)")
+	TxFlags.Uint64(CfgTxExpiryEpoch, 0, "last epoch in which the transaction can be included (0 for no limit)")
 	TxFlags.Bool(CfgTxUnsigned, false, "generate an unsigned transaction")
 	_ = viper.BindPFlags(TxFlags)
 	TxFlags.AddFlagSet(TxFileFlags)
@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	cmnGrpc "github.com/oasisprotocol/oasis-core/go/common/grpc"
+	"github.com/oasisprotocol/oasis-core/go/common/grpc/auth"
 	"github.com/oasisprotocol/oasis-core/go/common/identity"
 	"github.com/oasisprotocol/oasis-core/go/common/logging"
 	"github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common"
@@ -30,6 +31,8 @@ const (
 	CfgAddress = "address"
 	// CfgWait waits for the remote address to become available.
 	CfgWait = "wait"
+	// CfgServerMethodPolicyFile configures the path to the method-level access control policy.
+	CfgServerMethodPolicyFile = "grpc.method_policy_file"
 	// CfgDebugGrpcInternalSocketPath sets custom internal socket path.
 	CfgDebugGrpcInternalSocketPath = "debug.grpc.internal.socket_path"
 
@@ -47,6 +50,8 @@ var (
 	// ClientFlags has the flags for a gRPC client.
 	ClientFlags = flag.NewFlagSet("", flag.ContinueOnError)
 
+	serverCommonFlags = flag.NewFlagSet("", flag.ContinueOnError)
+
 	logger = logging.GetLogger("cmd/grpc")
 )
 
@@ -56,11 +61,17 @@ var (
 // This internally takes a snapshot of the current global tracer, so
 // make sure you initialize the global tracer before calling this.
 func NewServerTCP(cert *tls.Certificate, installWrapper bool) (*cmnGrpc.Server, error) {
+	authorizer, err := newMethodAuthorizer()
+	if err != nil {
+		return nil, err
+	}
+
 	config := &cmnGrpc.ServerConfig{
-		Name:           "internal",
-		Port:           uint16(viper.GetInt(CfgServerPort)),
-		Identity:       &identity.Identity{},
-		InstallWrapper: installWrapper,
+		Name:             "internal",
+		Port:             uint16(viper.GetInt(CfgServerPort)),
+		Identity:         &identity.Identity{},
+		InstallWrapper:   installWrapper,
+		MethodAuthorizer: authorizer,
 	}
 	config.Identity.SetTLSCertificate(cert)
 	return cmnGrpc.NewServer(config)
@@ -82,15 +93,40 @@ func NewServerLocal(installWrapper bool) (*cmnGrpc.Server, error) {
 		path = viper.GetString(CfgDebugGrpcInternalSocketPath)
 	}
 
+	authorizer, err := newMethodAuthorizer()
+	if err != nil {
+		return nil, err
+	}
+
 	config := &cmnGrpc.ServerConfig{
-		Name:           "internal",
-		Path:           path,
-		InstallWrapper: installWrapper,
+		Name:             "internal",
+		Path:             path,
+		InstallWrapper:   installWrapper,
+		MethodAuthorizer: authorizer,
 	}
 
 	return cmnGrpc.NewServer(config)
 }
 
+// newMethodAuthorizer constructs the method-level access control policy enforcer if a policy
+// has been configured.
+func newMethodAuthorizer() (*auth.MethodAuthorizer, error) {
+	path := viper.GetString(CfgServerMethodPolicyFile)
+	if path == "" {
+		return nil, nil
+	}
+
+	policy, err := auth.LoadMethodPolicy(path)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("enforcing gRPC method access control policy",
+		"path", path,
+		"num_rules", len(policy.Rules),
+	)
+	return auth.NewMethodAuthorizer(policy)
+}
+
 func NewClient(cmd *cobra.Command) (*grpc.ClientConn, error) {
 	addr, _ := cmd.Flags().GetString(CfgAddress)
 
@@ -122,14 +158,19 @@ func NewClient(cmd *cobra.Command) (*grpc.ClientConn, error) {
 }
 
 func init() {
+	serverCommonFlags.String(CfgServerMethodPolicyFile, "", "path to the gRPC method access control policy (JSON)")
+	_ = viper.BindPFlags(serverCommonFlags)
+
 	ServerTCPFlags.Uint16(CfgServerPort, 9001, "gRPC server port")
 	_ = viper.BindPFlags(ServerTCPFlags)
 	ServerTCPFlags.AddFlagSet(cmnGrpc.Flags)
+	ServerTCPFlags.AddFlagSet(serverCommonFlags)
 
 	ServerLocalFlags.String(CfgDebugGrpcInternalSocketPath, "", "use custom internal unix socket path")
 	_ = ServerLocalFlags.MarkHidden(CfgDebugGrpcInternalSocketPath)
 	_ = viper.BindPFlags(ServerLocalFlags)
 	ServerLocalFlags.AddFlagSet(cmnGrpc.Flags)
+	ServerLocalFlags.AddFlagSet(serverCommonFlags)
 
 	ClientFlags.StringP(CfgAddress, "a", defaultAddress, "remote gRPC address")
 	ClientFlags.Bool(CfgWait, false, "wait for gRPC address to become available")
@@ -58,6 +58,7 @@ func newResourceService(interval time.Duration) *resourceService {
 			NewMemService(),
 			NewCPUCollector(),
 			NewNetService(),
+			NewRuntimeCollector(),
 		},
 	}
 
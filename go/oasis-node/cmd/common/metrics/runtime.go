@@ -0,0 +1,152 @@
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	MetricRuntimeProcesses      = "oasis_runtime_processes"
+	MetricRuntimeCPUTimeSeconds = "oasis_runtime_cpu_time_seconds"
+	MetricRuntimeMemRssBytes    = "oasis_runtime_mem_rss_bytes"
+	MetricRuntimeIOReadBytes    = "oasis_runtime_io_read_bytes"
+	MetricRuntimeIOWriteBytes   = "oasis_runtime_io_write_bytes"
+)
+
+var (
+	runtimeLabels = []string{"runtime", "version"}
+
+	runtimeProcessesGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricRuntimeProcesses,
+			Help: "Number of processes of the hosted runtime.",
+		},
+		runtimeLabels,
+	)
+
+	runtimeCPUTimeGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricRuntimeCPUTimeSeconds,
+			Help: "CPU time spent by the running hosted runtime processes as reported by /proc/<PID>/stat (seconds).",
+		},
+		runtimeLabels,
+	)
+
+	runtimeRssGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricRuntimeMemRssBytes,
+			Help: "Resident set size of the hosted runtime processes as reported by /proc/<PID>/stat (bytes).",
+		},
+		runtimeLabels,
+	)
+
+	runtimeIOReadGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricRuntimeIOReadBytes,
+			Help: "Data read from the storage layer by the running hosted runtime processes as reported by /proc/<PID>/io (bytes).",
+		},
+		runtimeLabels,
+	)
+
+	runtimeIOWriteGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricRuntimeIOWriteBytes,
+			Help: "Data written to the storage layer by the running hosted runtime processes as reported by /proc/<PID>/io (bytes).",
+		},
+		runtimeLabels,
+	)
+
+	runtimeCollectors = []prometheus.Collector{
+		runtimeProcessesGauge,
+		runtimeCPUTimeGauge,
+		runtimeRssGauge,
+		runtimeIOReadGauge,
+		runtimeIOWriteGauge,
+	}
+	runtimeServiceOnce sync.Once
+
+	runtimeStatsSource struct {
+		sync.Mutex
+
+		fn func() []*RuntimeStats
+	}
+)
+
+// RuntimeStats are the resource usage statistics of the processes of a hosted runtime.
+type RuntimeStats struct {
+	// RuntimeID is the runtime identifier.
+	RuntimeID string
+	// Version is the runtime version.
+	Version string
+
+	// NumProcesses is the number of runtime processes.
+	NumProcesses uint64
+	// CPUSeconds is the CPU time spent in user and kernel mode (in seconds).
+	CPUSeconds float64
+	// RSSBytes is the resident set size (in bytes).
+	RSSBytes uint64
+	// IOReadBytes is the number of bytes read from the storage layer.
+	IOReadBytes uint64
+	// IOWriteBytes is the number of bytes written to the storage layer.
+	IOWriteBytes uint64
+}
+
+// SetRuntimeStatsSource configures the function used by the runtime collector to obtain the
+// resource usage statistics of all hosted runtimes.
+func SetRuntimeStatsSource(fn func() []*RuntimeStats) {
+	runtimeStatsSource.Lock()
+	defer runtimeStatsSource.Unlock()
+
+	runtimeStatsSource.fn = fn
+}
+
+type runtimeCollector struct{}
+
+func (c *runtimeCollector) Name() string {
+	return "runtime"
+}
+
+func (c *runtimeCollector) Update() error {
+	runtimeStatsSource.Lock()
+	fn := runtimeStatsSource.fn
+	runtimeStatsSource.Unlock()
+
+	if fn == nil {
+		return nil
+	}
+	stats := fn()
+
+	// Make sure that runtimes which are no longer running are not reported.
+	for _, c := range runtimeCollectors {
+		c.(*prometheus.GaugeVec).Reset()
+	}
+	for _, st := range stats {
+		labels := prometheus.Labels{
+			"runtime": st.RuntimeID,
+			"version": st.Version,
+		}
+		runtimeProcessesGauge.With(labels).Set(float64(st.NumProcesses))
+		runtimeCPUTimeGauge.With(labels).Set(st.CPUSeconds)
+		runtimeRssGauge.With(labels).Set(float64(st.RSSBytes))
+		runtimeIOReadGauge.With(labels).Set(float64(st.IOReadBytes))
+		runtimeIOWriteGauge.With(labels).Set(float64(st.IOWriteBytes))
+	}
+
+	return nil
+}
+
+// NewRuntimeCollector constructs a new hosted runtime resource usage collector.
+//
+// This service will regularly read the resource usage statistics of all hosted runtimes as
+// reported by the source configured via SetRuntimeStatsSource.
+func NewRuntimeCollector() ResourceCollector {
+	rc := &runtimeCollector{}
+
+	// Runtime metrics are singletons per process. Ensure to register them only once.
+	runtimeServiceOnce.Do(func() {
+		prometheus.MustRegister(runtimeCollectors...)
+	})
+
+	return rc
+}
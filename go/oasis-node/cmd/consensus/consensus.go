@@ -12,23 +12,30 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
 	"github.com/oasisprotocol/oasis-core/go/common/logging"
 	"github.com/oasisprotocol/oasis-core/go/common/prettyprint"
 	consensus "github.com/oasisprotocol/oasis-core/go/consensus/api"
 	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
 	cmdCommon "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common"
 	cmdConsensus "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/consensus"
+	cmdContext "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/context"
 	cmdFlags "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/flags"
 	cmdGrpc "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/grpc"
+	cmdSigner "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/signer"
 )
 
 const (
 	// CfgSignerPub is the public key of the account that will sign an unsigned transaction in estimate gas.
 	CfgSignerPub = "consensus.signer_pub"
+
+	// CfgTxHash is the hash of an indexed transaction to show.
+	CfgTxHash = "hash"
 )
 
 var (
 	signerPub string
+	txHash    string
 
 	consensusCmd = &cobra.Command{
 		Use:   "consensus",
@@ -41,18 +48,37 @@ var (
 		Run:   doSubmitTx,
 	}
 
+	sponsorTxCmd = &cobra.Command{
+		Use:   "sponsor_tx",
+		Short: "Sign a pre-signed sponsored transaction as the fee payer",
+		Run:   doSponsorTx,
+	}
+
 	showTxCmd = &cobra.Command{
 		Use:   "show_tx",
-		Short: "Show the content a pre-signed transaction",
+		Short: "Show the content a pre-signed or an indexed transaction",
 		Run:   doShowTx,
 	}
 
+	genBatchCmd = &cobra.Command{
+		Use:   "gen_batch <unsigned-tx>...",
+		Short: "Generate a batch transaction from unsigned transactions",
+		Args:  cobra.MinimumNArgs(1),
+		Run:   doGenBatch,
+	}
+
 	estimateGasCmd = &cobra.Command{
 		Use:   "estimate_gas",
 		Short: "Estimate how much gas a transaction will use",
 		Run:   doEstimateGas,
 	}
 
+	minGasPriceCmd = &cobra.Command{
+		Use:   "min_gas_price",
+		Short: "Show the minimum gas price (the current base fee) required by the network",
+		Run:   doMinGasPrice,
+	}
+
 	nextBlockStateCmd = &cobra.Command{
 		Use: "next_block_state",
 		Run: doNextBlockState,
@@ -95,7 +121,11 @@ func loadTx() *transaction.SignedTransaction {
 }
 
 func loadUnsignedTx() *transaction.Transaction {
-	rawUnsignedTx, err := os.ReadFile(viper.GetString(cmdConsensus.CfgTxFile))
+	return loadUnsignedTxFrom(viper.GetString(cmdConsensus.CfgTxFile))
+}
+
+func loadUnsignedTxFrom(fn string) *transaction.Transaction {
+	rawUnsignedTx, err := os.ReadFile(fn)
 	if err != nil {
 		logger.Error("failed to read raw serialized unsigned transaction",
 			"err", err,
@@ -123,6 +153,7 @@ func doSubmitTx(cmd *cobra.Command, args []string) {
 	defer conn.Close()
 
 	tx := loadTx()
+	checkTxExpiry(client, tx)
 
 	if err := client.SubmitTx(context.Background(), tx); err != nil {
 		logger.Error("failed to submit transaction",
@@ -132,11 +163,97 @@ func doSubmitTx(cmd *cobra.Command, args []string) {
 	}
 }
 
+// checkTxExpiry makes sure that the given transaction has not yet expired in order to provide a
+// better error message than the one returned by the node.
+func checkTxExpiry(client consensus.ClientBackend, sigTx *transaction.SignedTransaction) {
+	var tx transaction.Transaction
+	if err := cbor.Unmarshal(sigTx.Blob, &tx); err != nil || tx.Expiry == nil {
+		// Let the node handle malformed transactions.
+		return
+	}
+
+	status, err := client.GetStatus(context.Background())
+	if err != nil {
+		logger.Error("failed to query node status",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+	if tx.Expiry.IsExpired(status.LatestHeight+1, uint64(status.LatestEpoch)) {
+		logger.Error("transaction has expired",
+			"expiry_height", tx.Expiry.Height,
+			"expiry_epoch", tx.Expiry.Epoch,
+			"latest_height", status.LatestHeight,
+			"latest_epoch", status.LatestEpoch,
+		)
+		os.Exit(1)
+	}
+}
+
+func doSponsorTx(cmd *cobra.Command, args []string) {
+	if err := cmdCommon.Init(); err != nil {
+		cmdCommon.EarlyLogAndExit(err)
+	}
+	cmdConsensus.AssertTxFileOK()
+
+	genesis := cmdConsensus.InitGenesis()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, prettyprint.ContextKeyTokenSymbol, genesis.Staking.TokenSymbol)
+	ctx = context.WithValue(ctx, prettyprint.ContextKeyTokenValueExponent, genesis.Staking.TokenValueExponent)
+
+	sigTx := loadTx()
+
+	_, signer, err := cmdCommon.LoadEntitySigner()
+	if err != nil {
+		logger.Error("failed to load signer",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+	defer signer.Reset()
+
+	fmt.Printf("You are about to pay the fee for the following transaction:\n")
+	sigTx.PrettyPrint(ctx, "  ", os.Stdout)
+
+	if !cmdFlags.AssumeYes() {
+		if !cmdCommon.GetUserConfirmation("\nAre you sure you want to continue? (y)es/(n)o: ") {
+			os.Exit(1)
+		}
+	}
+
+	if err = sigTx.SignFeePayer(signer); err != nil {
+		logger.Error("failed to sign transaction as the fee payer",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+
+	prettySigTx, err := cmdCommon.PrettyJSONMarshal(sigTx)
+	if err != nil {
+		logger.Error("failed to get pretty JSON of sponsored transaction",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+	if err = os.WriteFile(viper.GetString(cmdConsensus.CfgTxFile), prettySigTx, 0o600); err != nil {
+		logger.Error("failed to save sponsored transaction",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+}
+
 func doShowTx(cmd *cobra.Command, args []string) {
 	if err := cmdCommon.Init(); err != nil {
 		cmdCommon.EarlyLogAndExit(err)
 	}
 
+	if txHash != "" {
+		doShowIndexedTx(cmd)
+		return
+	}
+
 	genesis := cmdConsensus.InitGenesis()
 
 	ctx := context.Background()
@@ -148,6 +265,98 @@ func doShowTx(cmd *cobra.Command, args []string) {
 	sigTx.PrettyPrint(ctx, "", os.Stdout)
 }
 
+func doShowIndexedTx(cmd *cobra.Command) {
+	var h hash.Hash
+	if err := h.UnmarshalHex(txHash); err != nil {
+		logger.Error("failed to parse transaction hash",
+			"err", err,
+			"hash", txHash,
+		)
+		os.Exit(1)
+	}
+
+	conn, client := doConnect(cmd)
+	defer conn.Close()
+
+	ctx := context.Background()
+	itx, err := client.GetTransactionByHash(ctx, h)
+	if err != nil {
+		logger.Error("failed to get transaction",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+	genesis, err := client.GetGenesisDocument(ctx)
+	if err != nil {
+		logger.Error("failed to get genesis document",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+
+	ctx = context.WithValue(ctx, prettyprint.ContextKeyTokenSymbol, genesis.Staking.TokenSymbol)
+	ctx = context.WithValue(ctx, prettyprint.ContextKeyTokenValueExponent, genesis.Staking.TokenValueExponent)
+	ctx = context.WithValue(ctx, prettyprint.ContextKeyGenesisHash, genesis.Hash())
+
+	fmt.Printf("Height: %d\n", itx.Height)
+	fmt.Printf("Index: %d\n", itx.Index)
+
+	var (
+		sigTx      transaction.SignedTransaction
+		multiSigTx transaction.MultiSignedTransaction
+	)
+	if err = cbor.Unmarshal(itx.Transaction, &sigTx); err == nil {
+		fmt.Println("Transaction:")
+		sigTx.PrettyPrint(ctx, "  ", os.Stdout)
+	} else if cbor.Unmarshal(itx.Transaction, &multiSigTx) == nil {
+		fmt.Println("Transaction:")
+		multiSigTx.PrettyPrint(ctx, "  ", os.Stdout)
+	} else {
+		fmt.Printf("Transaction: <malformed: %s>\n", err)
+	}
+
+	prettyResult, err := cmdCommon.PrettyJSONMarshal(itx.Result)
+	if err != nil {
+		logger.Error("failed to get pretty JSON of transaction result",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+	fmt.Printf("Result: %s\n", prettyResult)
+}
+
+func doGenBatch(cmd *cobra.Command, args []string) {
+	if err := cmdCommon.Init(); err != nil {
+		cmdCommon.EarlyLogAndExit(err)
+	}
+
+	genesis := cmdConsensus.InitGenesis()
+	cmdConsensus.AssertTxFileOK()
+
+	// The nonce and fee of the individual transactions are ignored as the batched calls are
+	// executed under the nonce and fee of the batch transaction.
+	var calls []transaction.BatchCall
+	for _, fn := range args {
+		tx := loadUnsignedTxFrom(fn)
+		calls = append(calls, transaction.BatchCall{
+			Method: tx.Method,
+			Body:   tx.Body,
+		})
+	}
+	batch := transaction.Batch{Calls: calls}
+	if err := batch.ValidateBasic(); err != nil {
+		logger.Error("invalid batch",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+
+	nonce, fee := cmdConsensus.GetTxNonceAndFee()
+	tx := transaction.NewBatchTx(nonce, fee, calls)
+
+	cmdConsensus.SignAndSaveTx(cmdContext.GetCtxWithGenesisInfo(genesis), tx, nil)
+}
+
 func doEstimateGas(cmd *cobra.Command, args []string) {
 	if err := cmdCommon.Init(); err != nil {
 		cmdCommon.EarlyLogAndExit(err)
@@ -176,6 +385,24 @@ func doEstimateGas(cmd *cobra.Command, args []string) {
 	fmt.Println(gas)
 }
 
+func doMinGasPrice(cmd *cobra.Command, args []string) {
+	if err := cmdCommon.Init(); err != nil {
+		cmdCommon.EarlyLogAndExit(err)
+	}
+
+	conn, client := doConnect(cmd)
+	defer conn.Close()
+
+	price, err := client.GetMinGasPrice(context.Background(), consensus.HeightLatest)
+	if err != nil {
+		logger.Error("failed to query minimum gas price",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+	fmt.Println(price)
+}
+
 func doNextBlockState(cmd *cobra.Command, args []string) {
 	if err := cmdCommon.Init(); err != nil {
 		cmdCommon.EarlyLogAndExit(err)
@@ -206,8 +433,11 @@ func doNextBlockState(cmd *cobra.Command, args []string) {
 func Register(parentCmd *cobra.Command) {
 	for _, v := range []*cobra.Command{
 		submitTxCmd,
+		sponsorTxCmd,
 		showTxCmd,
+		genBatchCmd,
 		estimateGasCmd,
+		minGasPriceCmd,
 		nextBlockStateCmd,
 	} {
 		consensusCmd.AddCommand(v)
@@ -216,14 +446,30 @@ func Register(parentCmd *cobra.Command) {
 	submitTxCmd.Flags().AddFlagSet(cmdConsensus.TxFileFlags)
 	submitTxCmd.Flags().AddFlagSet(cmdGrpc.ClientFlags)
 
+	sponsorTxCmd.Flags().AddFlagSet(cmdConsensus.TxFileFlags)
+	sponsorTxCmd.Flags().AddFlagSet(cmdFlags.DebugTestEntityFlags)
+	sponsorTxCmd.Flags().AddFlagSet(cmdFlags.AssumeYesFlag)
+	sponsorTxCmd.Flags().AddFlagSet(cmdSigner.Flags)
+	sponsorTxCmd.Flags().AddFlagSet(cmdSigner.CLIFlags)
+	sponsorTxCmd.Flags().AddFlagSet(cmdFlags.GenesisFileFlags)
+
 	showTxCmd.Flags().AddFlagSet(cmdConsensus.TxFileFlags)
 	showTxCmd.Flags().AddFlagSet(cmdFlags.GenesisFileFlags)
+	showTxCmd.Flags().StringVar(&txHash, CfgTxHash, "", "hash of an indexed transaction to query from the node (hex)")
+	showTxCmd.Flags().AddFlagSet(cmdGrpc.ClientFlags)
+
+	genBatchCmd.Flags().AddFlagSet(cmdConsensus.TxFlags)
+	genBatchCmd.Flags().AddFlagSet(cmdFlags.AssumeYesFlag)
 
 	estimateGasCmd.Flags().StringVar(&signerPub, CfgSignerPub, "", "public key of the signer, in base64")
 	estimateGasCmd.Flags().AddFlagSet(cmdConsensus.TxFileFlags)
 	estimateGasCmd.Flags().AddFlagSet(cmdGrpc.ClientFlags)
 
+	minGasPriceCmd.Flags().AddFlagSet(cmdGrpc.ClientFlags)
+
 	nextBlockStateCmd.Flags().AddFlagSet(cmdGrpc.ClientFlags)
 
+	registerMultisigCmd()
+
 	parentCmd.AddCommand(consensusCmd)
 }
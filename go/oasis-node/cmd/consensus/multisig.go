@@ -0,0 +1,274 @@
+package consensus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/oasisprotocol/oasis-core/go/common/prettyprint"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
+	cmdCommon "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common"
+	cmdConsensus "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/consensus"
+	cmdFlags "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/flags"
+	cmdGrpc "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/grpc"
+	cmdSigner "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/signer"
+	staking "github.com/oasisprotocol/oasis-core/go/staking/api"
+)
+
+const (
+	// CfgMultisigConfig configures the path to the JSON-encoded multisig account configuration.
+	CfgMultisigConfig = "multisig.config"
+
+	// CfgMultisigOutput configures the path where the partially signed transaction is saved.
+	CfgMultisigOutput = "multisig.output"
+)
+
+var (
+	multisigConfigFlags = flag.NewFlagSet("", flag.ContinueOnError)
+	multisigSignFlags   = flag.NewFlagSet("", flag.ContinueOnError)
+
+	multisigCmd = &cobra.Command{
+		Use:   "multisig",
+		Short: "multisig account commands",
+	}
+
+	multisigAddressCmd = &cobra.Command{
+		Use:   "address",
+		Short: "show the staking account address of a multisig configuration",
+		Run:   doMultisigAddress,
+	}
+
+	multisigSignCmd = &cobra.Command{
+		Use:   "sign",
+		Short: "sign an unsigned transaction on behalf of a multisig account",
+		Run:   doMultisigSign,
+	}
+
+	multisigCombineCmd = &cobra.Command{
+		Use:   "combine <partially-signed-tx>...",
+		Short: "combine partially signed multisig transactions",
+		Args:  cobra.MinimumNArgs(1),
+		Run:   doMultisigCombine,
+	}
+
+	multisigSubmitCmd = &cobra.Command{
+		Use:   "submit",
+		Short: "submit a multisig transaction",
+		Run:   doMultisigSubmit,
+	}
+)
+
+func loadMultisigConfig() *transaction.MultisigConfig {
+	raw, err := os.ReadFile(viper.GetString(CfgMultisigConfig))
+	if err != nil {
+		logger.Error("failed to read multisig configuration",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+
+	var cfg transaction.MultisigConfig
+	if err = json.Unmarshal(raw, &cfg); err != nil {
+		logger.Error("failed to parse multisig configuration",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+	if err = cfg.ValidateBasic(); err != nil {
+		logger.Error("invalid multisig configuration",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+
+	return &cfg
+}
+
+func loadMultiSignedTx(fn string) *transaction.MultiSignedTransaction {
+	raw, err := os.ReadFile(fn)
+	if err != nil {
+		logger.Error("failed to read multisig transaction",
+			"err", err,
+			"file", fn,
+		)
+		os.Exit(1)
+	}
+
+	var tx transaction.MultiSignedTransaction
+	if err = json.Unmarshal(raw, &tx); err != nil {
+		logger.Error("failed to parse multisig transaction",
+			"err", err,
+			"file", fn,
+		)
+		os.Exit(1)
+	}
+
+	return &tx
+}
+
+func saveMultiSignedTx(fn string, tx *transaction.MultiSignedTransaction) {
+	prettyTx, err := cmdCommon.PrettyJSONMarshal(tx)
+	if err != nil {
+		logger.Error("failed to get pretty JSON of multisig transaction",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+	if err = os.WriteFile(fn, prettyTx, 0o600); err != nil {
+		logger.Error("failed to save multisig transaction",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+}
+
+func doMultisigAddress(cmd *cobra.Command, args []string) {
+	if err := cmdCommon.Init(); err != nil {
+		cmdCommon.EarlyLogAndExit(err)
+	}
+
+	fmt.Println(staking.NewMultisigAddress(loadMultisigConfig()))
+}
+
+func doMultisigSign(cmd *cobra.Command, args []string) {
+	if err := cmdCommon.Init(); err != nil {
+		cmdCommon.EarlyLogAndExit(err)
+	}
+
+	genesis := cmdConsensus.InitGenesis()
+	cfg := loadMultisigConfig()
+	tx := loadUnsignedTx()
+
+	multiSigTx, err := transaction.NewMultiSignedTransaction(cfg, tx)
+	if err != nil {
+		logger.Error("failed to create multisig transaction",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+
+	_, signer, err := cmdCommon.LoadEntitySigner()
+	if err != nil {
+		logger.Error("failed to load signer",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+	defer signer.Reset()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, prettyprint.ContextKeyTokenSymbol, genesis.Staking.TokenSymbol)
+	ctx = context.WithValue(ctx, prettyprint.ContextKeyTokenValueExponent, genesis.Staking.TokenValueExponent)
+
+	fmt.Printf("You are about to sign the following transaction on behalf of multisig account %s:\n",
+		staking.NewMultisigAddress(cfg),
+	)
+	tx.PrettyPrint(ctx, "  ", os.Stdout)
+
+	if !cmdFlags.AssumeYes() {
+		if !cmdCommon.GetUserConfirmation("\nAre you sure you want to continue? (y)es/(n)o: ") {
+			os.Exit(1)
+		}
+	}
+
+	if err = multiSigTx.Sign(signer); err != nil {
+		logger.Error("failed to sign transaction",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+
+	saveMultiSignedTx(viper.GetString(CfgMultisigOutput), multiSigTx)
+}
+
+func doMultisigCombine(cmd *cobra.Command, args []string) {
+	if err := cmdCommon.Init(); err != nil {
+		cmdCommon.EarlyLogAndExit(err)
+	}
+	cmdConsensus.AssertTxFileOK()
+	cmdConsensus.InitGenesis()
+
+	multiSigTx := loadMultiSignedTx(args[0])
+	for _, fn := range args[1:] {
+		if err := multiSigTx.Merge(loadMultiSignedTx(fn)); err != nil {
+			logger.Error("failed to combine multisig transactions",
+				"err", err,
+				"file", fn,
+			)
+			os.Exit(1)
+		}
+	}
+
+	if err := multiSigTx.Verify(); err != nil {
+		// Saving a transaction with insufficient signatures is still useful as it may be combined
+		// with further signatures later.
+		logger.Warn("multisig transaction is not yet valid",
+			"err", err,
+			"num_signatures", len(multiSigTx.Signatures),
+			"threshold", multiSigTx.Config.Threshold,
+		)
+	}
+
+	saveMultiSignedTx(viper.GetString(cmdConsensus.CfgTxFile), multiSigTx)
+}
+
+func doMultisigSubmit(cmd *cobra.Command, args []string) {
+	if err := cmdCommon.Init(); err != nil {
+		cmdCommon.EarlyLogAndExit(err)
+	}
+
+	conn, client := doConnect(cmd)
+	defer conn.Close()
+
+	tx := loadMultiSignedTx(viper.GetString(cmdConsensus.CfgTxFile))
+
+	if err := client.SubmitMultisigTx(context.Background(), tx); err != nil {
+		logger.Error("failed to submit multisig transaction",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+}
+
+func registerMultisigCmd() {
+	for _, v := range []*cobra.Command{
+		multisigAddressCmd,
+		multisigSignCmd,
+		multisigCombineCmd,
+		multisigSubmitCmd,
+	} {
+		multisigCmd.AddCommand(v)
+	}
+
+	multisigAddressCmd.Flags().AddFlagSet(multisigConfigFlags)
+
+	multisigSignCmd.Flags().AddFlagSet(multisigConfigFlags)
+	multisigSignCmd.Flags().AddFlagSet(multisigSignFlags)
+	multisigSignCmd.Flags().AddFlagSet(cmdConsensus.TxFileFlags)
+	multisigSignCmd.Flags().AddFlagSet(cmdFlags.DebugTestEntityFlags)
+	multisigSignCmd.Flags().AddFlagSet(cmdFlags.AssumeYesFlag)
+	multisigSignCmd.Flags().AddFlagSet(cmdSigner.Flags)
+	multisigSignCmd.Flags().AddFlagSet(cmdSigner.CLIFlags)
+	multisigSignCmd.Flags().AddFlagSet(cmdFlags.GenesisFileFlags)
+
+	multisigCombineCmd.Flags().AddFlagSet(cmdConsensus.TxFileFlags)
+	multisigCombineCmd.Flags().AddFlagSet(cmdFlags.GenesisFileFlags)
+
+	multisigSubmitCmd.Flags().AddFlagSet(cmdConsensus.TxFileFlags)
+	multisigSubmitCmd.Flags().AddFlagSet(cmdGrpc.ClientFlags)
+
+	consensusCmd.AddCommand(multisigCmd)
+}
+
+func init() {
+	multisigConfigFlags.String(CfgMultisigConfig, "", "path to the multisig account configuration (JSON)")
+	_ = viper.BindPFlags(multisigConfigFlags)
+
+	multisigSignFlags.String(CfgMultisigOutput, "", "path where to save the partially signed transaction")
+	_ = viper.BindPFlags(multisigSignFlags)
+}
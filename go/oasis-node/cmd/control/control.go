@@ -6,10 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"github.com/oasisprotocol/oasis-core/go/common"
 	"github.com/oasisprotocol/oasis-core/go/common/logging"
 	"github.com/oasisprotocol/oasis-core/go/common/persistent"
 	control "github.com/oasisprotocol/oasis-core/go/control/api"
@@ -20,7 +22,8 @@ import (
 )
 
 var (
-	shutdownWait = false
+	shutdownWait   = false
+	addRuntimeMode string
 
 	controlCmd = &cobra.Command{
 		Use:   "control",
@@ -76,6 +79,27 @@ var (
 		Run:   doRuntimeStats,
 	}
 
+	controlTxPoolCmd = &cobra.Command{
+		Use:   "txpool <runtime-id>",
+		Short: "show transaction pool contents of a runtime",
+		Args:  cobra.ExactArgs(1),
+		Run:   doTxPool,
+	}
+
+	controlAddRuntimeCmd = &cobra.Command{
+		Use:   "add-runtime <bundle>",
+		Short: "add support for a new runtime to a running node",
+		Args:  cobra.ExactArgs(1),
+		Run:   doAddRuntime,
+	}
+
+	controlRemoveRuntimeCmd = &cobra.Command{
+		Use:   "remove-runtime <runtime-id>",
+		Short: "remove support for a runtime from a running node",
+		Args:  cobra.ExactArgs(1),
+		Run:   doRemoveRuntime,
+	}
+
 	logger = logging.GetLogger("cmd/control")
 )
 
@@ -291,11 +315,102 @@ func doStatus(cmd *cobra.Command, args []string) {
 	fmt.Println(string(prettyStatus))
 }
 
+func doTxPool(cmd *cobra.Command, args []string) {
+	var runtimeID common.Namespace
+	if err := runtimeID.UnmarshalText([]byte(args[0])); err != nil {
+		logger.Error("malformed runtime ID",
+			"err", err,
+			"arg", args[0],
+		)
+		os.Exit(1)
+	}
+
+	conn, client := DoConnect(cmd)
+	defer conn.Close()
+
+	logger.Debug("querying transaction pool",
+		"runtime_id", runtimeID,
+	)
+
+	snapshot, err := client.GetTransactionPool(context.Background(), runtimeID)
+	if err != nil {
+		logger.Error("failed to query transaction pool",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+	prettySnapshot, err := cmdCommon.PrettyJSONMarshal(snapshot)
+	if err != nil {
+		logger.Error("failed to get pretty JSON of transaction pool",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+	fmt.Println(string(prettySnapshot))
+}
+
+func doAddRuntime(cmd *cobra.Command, args []string) {
+	// The bundle is opened by the node, so make sure the path does not depend on our working
+	// directory.
+	bundlePath, err := filepath.Abs(args[0])
+	if err != nil {
+		logger.Error("malformed runtime bundle path",
+			"err", err,
+			"arg", args[0],
+		)
+		os.Exit(1)
+	}
+
+	conn, client := DoConnect(cmd)
+	defer conn.Close()
+
+	logger.Debug("adding runtime",
+		"bundle", bundlePath,
+	)
+
+	err = client.AddRuntime(context.Background(), &control.AddRuntimeRequest{
+		Bundle: bundlePath,
+		Mode:   addRuntimeMode,
+	})
+	if err != nil {
+		logger.Error("failed to add runtime",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+}
+
+func doRemoveRuntime(cmd *cobra.Command, args []string) {
+	var runtimeID common.Namespace
+	if err := runtimeID.UnmarshalText([]byte(args[0])); err != nil {
+		logger.Error("malformed runtime ID",
+			"err", err,
+			"arg", args[0],
+		)
+		os.Exit(1)
+	}
+
+	conn, client := DoConnect(cmd)
+	defer conn.Close()
+
+	logger.Debug("removing runtime",
+		"runtime_id", runtimeID,
+	)
+
+	if err := client.RemoveRuntime(context.Background(), runtimeID); err != nil {
+		logger.Error("failed to remove runtime",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+}
+
 // Register registers the client sub-command and all of it's children.
 func Register(parentCmd *cobra.Command) {
 	controlCmd.PersistentFlags().AddFlagSet(cmdGrpc.ClientFlags)
 
 	controlShutdownCmd.Flags().BoolVarP(&shutdownWait, "wait", "w", false, "wait for the node to finish shutdown")
+	controlAddRuntimeCmd.Flags().StringVar(&addRuntimeMode, "mode", "", "runtime mode (defaults to the node's runtime mode)")
 
 	controlCmd.AddCommand(controlIsSyncedCmd)
 	controlCmd.AddCommand(controlWaitSyncCmd)
@@ -305,5 +420,8 @@ func Register(parentCmd *cobra.Command) {
 	controlCmd.AddCommand(controlCancelUpgradeCmd)
 	controlCmd.AddCommand(controlStatusCmd)
 	controlCmd.AddCommand(controlRuntimeStatsCmd)
+	controlCmd.AddCommand(controlTxPoolCmd)
+	controlCmd.AddCommand(controlAddRuntimeCmd)
+	controlCmd.AddCommand(controlRemoveRuntimeCmd)
 	parentCmd.AddCommand(controlCmd)
 }
@@ -10,9 +10,12 @@ import (
 	flag "github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
 	"github.com/oasisprotocol/oasis-core/go/common/logging"
 	"github.com/oasisprotocol/oasis-core/go/common/version"
 	cmdCommon "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common"
+	cmdFlags "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/flags"
+	cmdSigner "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/signer"
 	"github.com/oasisprotocol/oasis-core/go/runtime/bundle"
 )
 
@@ -26,6 +29,8 @@ const (
 
 	CfgRuntimeBundle = "runtime.bundle"
 
+	CfgTrustedPublishers = "runtime.bundle.trusted_publishers"
+
 	execName    = "runtime.elf"
 	sgxExecName = "runtime.sgx"
 	sgxSigName  = "runtime.sgx.sig"
@@ -49,6 +54,18 @@ var (
 		RunE:  doInfo,
 	}
 
+	signCmd = &cobra.Command{
+		Use:   "sign",
+		Short: "sign a runtime bundle manifest with the entity key",
+		RunE:  doSign,
+	}
+
+	verifyCmd = &cobra.Command{
+		Use:   "verify",
+		Short: "verify a runtime bundle publisher signature",
+		RunE:  doVerify,
+	}
+
 	logger = logging.GetLogger("cmd/debug/bundle")
 )
 
@@ -171,6 +188,103 @@ func doInfo(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func doSign(cmd *cobra.Command, args []string) error {
+	if err := cmdCommon.Init(); err != nil {
+		cmdCommon.EarlyLogAndExit(err)
+	}
+
+	fn := viper.GetString(CfgRuntimeBundle)
+	bnd, err := bundle.Open(fn)
+	if err != nil {
+		logger.Error("failed to open bundle",
+			"err", err,
+			"file_name", fn,
+		)
+		return err
+	}
+
+	_, signer, err := cmdCommon.LoadEntitySigner()
+	if err != nil {
+		logger.Error("failed to load entity signer",
+			"err", err,
+		)
+		return err
+	}
+	defer signer.Reset()
+
+	if err = bnd.Sign(signer); err != nil {
+		logger.Error("failed to sign bundle",
+			"err", err,
+		)
+		return err
+	}
+	if err = bnd.Write(fn); err != nil {
+		logger.Error("failed to write runtime bundle",
+			"err", err,
+		)
+		return err
+	}
+
+	return nil
+}
+
+func doVerify(cmd *cobra.Command, args []string) error {
+	if err := cmdCommon.Init(); err != nil {
+		cmdCommon.EarlyLogAndExit(err)
+	}
+
+	var trusted []signature.PublicKey
+	for _, rawPk := range viper.GetStringSlice(CfgTrustedPublishers) {
+		var pk signature.PublicKey
+		if err := pk.UnmarshalText([]byte(rawPk)); err != nil {
+			logger.Error("failed to parse trusted publisher public key",
+				"err", err,
+				"public_key", rawPk,
+			)
+			return err
+		}
+		trusted = append(trusted, pk)
+	}
+
+	// Opening the bundle validates the publisher signature if one is present.
+	fn := viper.GetString(CfgRuntimeBundle)
+	bnd, err := bundle.Open(fn)
+	if err != nil {
+		logger.Error("failed to open bundle",
+			"err", err,
+			"file_name", fn,
+		)
+		return err
+	}
+
+	sig, err := bnd.PublisherSignature()
+	if err != nil {
+		logger.Error("failed to verify publisher signature",
+			"err", err,
+		)
+		return err
+	}
+	if sig == nil {
+		err = fmt.Errorf("bundle is not signed")
+		logger.Error("failed to verify publisher signature",
+			"err", err,
+		)
+		return err
+	}
+	if len(trusted) > 0 {
+		if err = bnd.VerifyPublisher(trusted); err != nil {
+			logger.Error("failed to verify publisher",
+				"err", err,
+			)
+			return err
+		}
+	}
+
+	fmt.Printf("Publisher: %s\n", sig.PublicKey)
+
+	return nil
+}
+
 // Register registers the bundle sub-command and all of it's children.
 func Register(parentCmd *cobra.Command) {
 	commonFlags := flag.NewFlagSet("", flag.ContinueOnError)
@@ -187,9 +301,20 @@ func Register(parentCmd *cobra.Command) {
 	_ = viper.BindPFlags(initFlags)
 	initCmd.Flags().AddFlagSet(initFlags)
 
+	signCmd.Flags().AddFlagSet(cmdSigner.Flags)
+	signCmd.Flags().AddFlagSet(cmdSigner.CLIFlags)
+	signCmd.Flags().AddFlagSet(cmdFlags.DebugTestEntityFlags)
+
+	verifyFlags := flag.NewFlagSet("", flag.ContinueOnError)
+	verifyFlags.StringSlice(CfgTrustedPublishers, nil, "public keys of trusted publishers (format: <pk>,<pk>,...)")
+	_ = viper.BindPFlags(verifyFlags)
+	verifyCmd.Flags().AddFlagSet(verifyFlags)
+
 	for _, cmd := range []*cobra.Command{
 		initCmd,
 		infoCmd,
+		signCmd,
+		verifyCmd,
 	} {
 		cmd.Flags().AddFlagSet(commonFlags)
 		bundleCmd.AddCommand(cmd)
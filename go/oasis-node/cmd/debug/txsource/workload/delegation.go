@@ -175,6 +175,73 @@ func (d *delegation) doReclaimEscrowTx(ctx context.Context, rng *rand.Rand, stak
 	return nil
 }
 
+func (d *delegation) doRedelegateTx(ctx context.Context, rng *rand.Rand, stakingClient staking.Backend) error {
+	d.Logger.Debug("redelegate tx")
+
+	// Select an account that has active delegation.
+	perm := rng.Perm(delegationNumAccounts)
+	fromPermIdx := -1
+	var empty staking.Address
+	for i := range d.accounts {
+		if d.accounts[perm[i]].delegatedTo != empty {
+			fromPermIdx = i
+			break
+		}
+	}
+	if fromPermIdx == -1 {
+		d.Logger.Debug("no accounts delegating, skipping redelegation")
+		return nil
+	}
+	selectedIdx := perm[fromPermIdx]
+
+	// Select an account to redelegate to.
+	to := d.accounts[rng.Intn(delegationNumAccounts)].address
+	if to.Equal(d.accounts[selectedIdx].delegatedTo) {
+		d.Logger.Debug("selected the same account to redelegate to, skipping redelegation")
+		return nil
+	}
+
+	// Query amount of delegated shares for the account.
+	delegations, err := stakingClient.DelegationsFor(ctx, &staking.OwnerQuery{
+		Height: consensus.HeightLatest,
+		Owner:  d.accounts[selectedIdx].address,
+	})
+	if err != nil {
+		return fmt.Errorf("stakingClient.Delegations %s: %w", d.accounts[selectedIdx].signer.Public(), err)
+	}
+	delegation := delegations[d.accounts[selectedIdx].delegatedTo]
+	if delegation == nil {
+		d.Logger.Error("missing expected delegation",
+			"delegator", d.accounts[selectedIdx].signer.Public(),
+			"account", d.accounts[selectedIdx].delegatedTo,
+			"delegations", delegations,
+		)
+		return fmt.Errorf("missing expected delegation by account: %s in account: %s",
+			d.accounts[selectedIdx].signer.Public(), d.accounts[selectedIdx].delegatedTo)
+	}
+
+	// Create Redelegate tx.
+	redelegate := &staking.Redelegate{
+		From:   d.accounts[selectedIdx].delegatedTo,
+		To:     to,
+		Shares: delegation.Shares,
+	}
+	tx := staking.NewRedelegateTx(d.accounts[selectedIdx].reckonedNonce, nil, redelegate)
+	d.accounts[selectedIdx].reckonedNonce++
+	if err = d.FundSignAndSubmitTx(ctx, d.accounts[selectedIdx].signer, tx); err != nil {
+		d.Logger.Error("failed to sign and submit redelegate transaction",
+			"tx", tx,
+			"signer", d.accounts[selectedIdx].signer.Public(),
+		)
+		return fmt.Errorf("failed to sign and submit tx: %w", err)
+	}
+
+	// Update local state.
+	d.accounts[selectedIdx].delegatedTo = to
+
+	return nil
+}
+
 // Implements Workload.
 func (d *delegation) NeedsFunds() bool {
 	return true
@@ -222,7 +289,7 @@ func (d *delegation) Run(
 	stakingClient := staking.NewStakingClient(conn)
 
 	for {
-		switch rng.Intn(2) {
+		switch rng.Intn(3) {
 		case 0:
 			if err := d.doEscrowTx(ctx, rng); err != nil {
 				return err
@@ -231,6 +298,10 @@ func (d *delegation) Run(
 			if err := d.doReclaimEscrowTx(ctx, rng, stakingClient); err != nil {
 				return err
 			}
+		case 2:
+			if err := d.doRedelegateTx(ctx, rng, stakingClient); err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("unimplemented")
 		}
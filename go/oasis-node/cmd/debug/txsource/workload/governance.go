@@ -323,6 +323,12 @@ func (g *governanceWorkload) doChangeParametersProposal() error { // nolint: goc
 		if randBool() {
 			pc.EnableChangeParametersProposal = &params.EnableChangeParametersProposal
 		}
+		if randBool() {
+			pc.EnableUpdateRuntimeProposal = &params.EnableUpdateRuntimeProposal
+		}
+		if randBool() {
+			pc.EnableDelegatedVoting = &params.EnableDelegatedVoting
+		}
 		if randBool() {
 			pc.GasCosts = params.GasCosts
 		}
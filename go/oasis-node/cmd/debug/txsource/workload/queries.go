@@ -153,6 +153,9 @@ func (q *queries) sanityCheckTransactionEvents(ctx context.Context, height int64
 		case txEvent.RootHash != nil:
 			// XXX: we cannot get roothash events from a client.
 			continue
+		case txEvent.BatchCall != nil:
+			// Batch call events are not backend events.
+			continue
 		default:
 			return fmt.Errorf("unsupported event: %+v", txEvent)
 		}
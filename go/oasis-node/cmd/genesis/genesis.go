@@ -82,6 +82,8 @@ const (
 	CfgGovernanceUpgradeMinEpochDiff            = "governance.upgrade_min_epoch_diff"
 	CfgGovernanceVotingPeriod                   = "governance.voting_period"
 	CfgGovernanceEnableChangeParametersProposal = "governance.enable_change_parameters_proposal"
+	CfgGovernanceEnableUpdateRuntimeProposal    = "governance.enable_update_runtime_proposal"
+	CfgGovernanceEnableDelegatedVoting          = "governance.enable_delegated_voting"
 
 	// Beacon config flags.
 	CfgBeaconBackend                    = "beacon.backend"
@@ -248,6 +250,8 @@ func doInitGenesis(cmd *cobra.Command, args []string) {
 			UpgradeMinEpochDiff:            beacon.EpochTime(viper.GetUint64(CfgGovernanceUpgradeMinEpochDiff)),
 			VotingPeriod:                   beacon.EpochTime(viper.GetUint64(CfgGovernanceVotingPeriod)),
 			EnableChangeParametersProposal: viper.GetBool(CfgGovernanceEnableChangeParametersProposal),
+			EnableUpdateRuntimeProposal:    viper.GetBool(CfgGovernanceEnableUpdateRuntimeProposal),
+			EnableDelegatedVoting:          viper.GetBool(CfgGovernanceEnableDelegatedVoting),
 		},
 	}
 
@@ -817,6 +821,8 @@ func init() {
 	initGenesisFlags.Uint64(CfgGovernanceUpgradeMinEpochDiff, 300, "minimum number of epochs the upgrade needs to be scheduled in advance")
 	initGenesisFlags.Uint64(CfgGovernanceVotingPeriod, 100, "voting period (in epochs)")
 	initGenesisFlags.Bool(CfgGovernanceEnableChangeParametersProposal, true, "enable change parameters proposals")
+	initGenesisFlags.Bool(CfgGovernanceEnableUpdateRuntimeProposal, false, "enable update runtime proposals")
+	initGenesisFlags.Bool(CfgGovernanceEnableDelegatedVoting, false, "enable delegated voting")
 
 	// Beacon config flags.
 	initGenesisFlags.String(CfgBeaconBackend, "insecure", "beacon backend")
@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
 	"github.com/oasisprotocol/oasis-core/go/common/logging"
 	consensus "github.com/oasisprotocol/oasis-core/go/consensus/api"
 	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
@@ -22,6 +23,7 @@ import (
 	cmdFlags "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/flags"
 	cmdGrpc "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/grpc"
 	cmdSigner "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/signer"
+	registry "github.com/oasisprotocol/oasis-core/go/registry/api"
 	upgrade "github.com/oasisprotocol/oasis-core/go/upgrade/api"
 )
 
@@ -29,6 +31,12 @@ const (
 	cfgProposalCancelUpgradeID   = "proposal.cancel_upgrade.id"
 	cfgProposalUpgradeDescriptor = "proposal.upgrade.descriptor"
 
+	cfgProposalUpdateRuntimeDescriptor = "proposal.update_runtime.descriptor"
+
+	cfgProposalSignalingTitle   = "proposal.signaling.title"
+	cfgProposalSignalingContent = "proposal.signaling.content"
+	cfgProposalSignalingURI     = "proposal.signaling.uri"
+
 	cfgVote           = "vote"
 	cfgVoteProposalID = "vote.proposal.id"
 
@@ -142,9 +150,71 @@ func doGenSubmitProposal(cmd *cobra.Command, args []string) {
 				ProposalID: viper.GetUint64(cfgProposalCancelUpgradeID),
 			},
 		})
+	// Runtime descriptor.
+	case viper.GetString(cfgProposalUpdateRuntimeDescriptor) != "":
+		descriptorBytes, err := os.ReadFile(viper.GetString(cfgProposalUpdateRuntimeDescriptor))
+		if err != nil {
+			logger.Error("failed to read runtime descriptor",
+				"err", err,
+			)
+			os.Exit(1)
+		}
+
+		var rt registry.Runtime
+		if err = json.Unmarshal(descriptorBytes, &rt); err != nil {
+			logger.Error("can't parse runtime descriptor",
+				"err", err,
+			)
+			os.Exit(1)
+		}
+
+		proposal := &governance.UpdateRuntimeProposal{
+			Runtime: rt,
+		}
+		if err = proposal.ValidateBasic(); err != nil {
+			logger.Error("submitted runtime descriptor is not valid",
+				"err", err,
+			)
+			os.Exit(1)
+		}
+
+		tx = governance.NewSubmitProposalTx(nonce, fee, &governance.ProposalContent{
+			UpdateRuntime: proposal,
+		})
+	// Signaling proposal.
+	case viper.GetString(cfgProposalSignalingTitle) != "":
+		contentPath := viper.GetString(cfgProposalSignalingContent)
+		if contentPath == "" {
+			logger.Error(fmt.Sprintf("missing required argument: '%v'", cfgProposalSignalingContent))
+			os.Exit(1)
+		}
+		content, err := os.ReadFile(contentPath)
+		if err != nil {
+			logger.Error("failed to read signaling proposal content",
+				"err", err,
+			)
+			os.Exit(1)
+		}
+
+		proposal := &governance.SignalingProposal{
+			Title:       viper.GetString(cfgProposalSignalingTitle),
+			ContentHash: hash.NewFromBytes(content),
+			URI:         viper.GetString(cfgProposalSignalingURI),
+		}
+		if err = proposal.ValidateBasic(); err != nil {
+			logger.Error("submitted signaling proposal is not valid",
+				"err", err,
+			)
+			os.Exit(1)
+		}
+
+		tx = governance.NewSubmitProposalTx(nonce, fee, &governance.ProposalContent{
+			Signaling: proposal,
+		})
 	default:
-		logger.Error(fmt.Sprintf("missing required arguments: either '%v' or '%v' required",
-			cfgProposalUpgradeDescriptor, cfgProposalCancelUpgradeID,
+		logger.Error(fmt.Sprintf("missing required arguments: either '%v', '%v', '%v' or '%v' required",
+			cfgProposalUpgradeDescriptor, cfgProposalCancelUpgradeID, cfgProposalUpdateRuntimeDescriptor,
+			cfgProposalSignalingTitle,
 		))
 		os.Exit(1)
 	}
@@ -311,6 +381,10 @@ func init() {
 
 	submitProposalFlags.String(cfgProposalUpgradeDescriptor, "", "Path to the proposal upgrade descriptor")
 	submitProposalFlags.Uint64(cfgProposalCancelUpgradeID, 0, "Cancel upgrade proposal ID")
+	submitProposalFlags.String(cfgProposalUpdateRuntimeDescriptor, "", "Path to the proposal runtime descriptor")
+	submitProposalFlags.String(cfgProposalSignalingTitle, "", "Signaling proposal title")
+	submitProposalFlags.String(cfgProposalSignalingContent, "", "Path to the signaling proposal content (only its hash is submitted)")
+	submitProposalFlags.String(cfgProposalSignalingURI, "", "URI of the signaling proposal content")
 	_ = viper.BindPFlags(submitProposalFlags)
 	submitProposalFlags.AddFlagSet(cmdConsensus.TxFlags)
 	submitProposalFlags.AddFlagSet(cmdFlags.AssumeYesFlag)
@@ -27,6 +27,7 @@ import (
 	"github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/background"
 	"github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/flags"
 	cmdGrpc "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/grpc"
+	"github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/metrics"
 	"github.com/oasisprotocol/oasis-core/go/p2p"
 	p2pAPI "github.com/oasisprotocol/oasis-core/go/p2p/api"
 	registryAPI "github.com/oasisprotocol/oasis-core/go/registry/api"
@@ -251,6 +252,9 @@ func (n *Node) initRuntimeWorkers() error {
 	}
 	n.svcMgr.Register(n.CommonWorker)
 
+	// Report resource usage of hosted runtime processes.
+	metrics.SetRuntimeStatsSource(n.runtimeProcessStats)
+
 	workerCommonCfg := n.CommonWorker.GetConfig()
 
 	// Initialize the registration worker.
@@ -349,6 +353,30 @@ func (n *Node) initRuntimeWorkers() error {
 	return nil
 }
 
+// runtimeProcessStats returns the resource usage statistics of all hosted runtimes.
+func (n *Node) runtimeProcessStats() []*metrics.RuntimeStats {
+	var stats []*metrics.RuntimeStats
+	for id, rtNode := range n.CommonWorker.GetRuntimes() {
+		rt := rtNode.GetHostedRuntime()
+		if rt == nil {
+			continue
+		}
+
+		for _, st := range rt.GetProcessStats() {
+			stats = append(stats, &metrics.RuntimeStats{
+				RuntimeID:    id.String(),
+				Version:      st.Version.String(),
+				NumProcesses: st.NumProcesses,
+				CPUSeconds:   st.CPUSeconds,
+				RSSBytes:     st.RSSBytes,
+				IOReadBytes:  st.IOReadBytes,
+				IOWriteBytes: st.IOWriteBytes,
+			})
+		}
+	}
+	return stats
+}
+
 func (n *Node) startRuntimeWorkers() error {
 	// Start the common worker.
 	if err := n.CommonWorker.Start(); err != nil {
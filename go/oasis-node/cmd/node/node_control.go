@@ -12,8 +12,11 @@ import (
 	cmdFlags "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common/flags"
 	p2p "github.com/oasisprotocol/oasis-core/go/p2p/api"
 	roothash "github.com/oasisprotocol/oasis-core/go/roothash/api"
+	runtimeRegistry "github.com/oasisprotocol/oasis-core/go/runtime/registry"
+	"github.com/oasisprotocol/oasis-core/go/runtime/txpool"
 	storage "github.com/oasisprotocol/oasis-core/go/storage/api"
 	upgrade "github.com/oasisprotocol/oasis-core/go/upgrade/api"
+	"github.com/oasisprotocol/oasis-core/go/worker/common/committee"
 	keymanagerWorker "github.com/oasisprotocol/oasis-core/go/worker/keymanager/api"
 )
 
@@ -170,6 +173,113 @@ func (n *Node) GetStatus(ctx context.Context) (*control.Status, error) {
 	}, nil
 }
 
+// GetTransactionPool implements control.NodeController.
+func (n *Node) GetTransactionPool(ctx context.Context, runtimeID common.Namespace) (*txpool.Snapshot, error) {
+	rtNode := n.CommonWorker.GetRuntime(runtimeID)
+	if rtNode == nil || rtNode.TxPool == nil {
+		return nil, control.ErrNoTransactionPool
+	}
+	return rtNode.TxPool.Inspect(), nil
+}
+
+// AddRuntime implements control.NodeController.
+func (n *Node) AddRuntime(ctx context.Context, req *control.AddRuntimeRequest) error {
+	mode := n.RuntimeRegistry.Mode()
+	switch {
+	case req.Mode != "" && runtimeRegistry.RuntimeMode(req.Mode) != mode:
+		return fmt.Errorf("%w: runtime mode '%s' does not match node runtime mode '%s'", control.ErrRuntimeNotSupported, req.Mode, mode)
+	case mode == runtimeRegistry.RuntimeModeNone, mode == runtimeRegistry.RuntimeModeKeymanager:
+		return fmt.Errorf("%w: adding runtimes is not supported in runtime mode '%s'", control.ErrRuntimeNotSupported, mode)
+	}
+
+	rt, err := n.RuntimeRegistry.AddRuntime(ctx, req.Bundle)
+	if err != nil {
+		return fmt.Errorf("%w: %s", control.ErrRuntimeNotSupported, err)
+	}
+	id := rt.ID()
+
+	n.logger.Info("adding runtime",
+		"runtime_id", id,
+	)
+
+	_, err = n.CommonWorker.AddRuntime(rt, func(commonNode *committee.Node) (rerr error) {
+		defer func() {
+			if rerr != nil {
+				n.ExecutorWorker.RemoveRuntime(id)
+				n.ClientWorker.RemoveRuntime(id)
+				n.StorageWorker.RemoveRuntime(id)
+				n.RegistrationWorker.RemoveRuntimeRoleProviders(id)
+			}
+		}()
+
+		if err := n.StorageWorker.AddRuntime(commonNode); err != nil {
+			return err
+		}
+		if err := n.ClientWorker.AddRuntime(commonNode); err != nil {
+			return err
+		}
+		// Commit storage settings to the new runtime.
+		if err := n.RuntimeRegistry.FinishInitialization(ctx); err != nil {
+			return err
+		}
+		return n.ExecutorWorker.AddRuntime(commonNode)
+	})
+	if err != nil {
+		n.logger.Error("failed to add runtime",
+			"err", err,
+			"runtime_id", id,
+		)
+		if rerr := n.RuntimeRegistry.RemoveRuntime(ctx, id); rerr != nil {
+			n.logger.Error("failed to remove runtime from registry",
+				"err", rerr,
+				"runtime_id", id,
+			)
+		}
+		return fmt.Errorf("failed to add runtime %s: %w", id, err)
+	}
+
+	n.logger.Info("runtime added",
+		"runtime_id", id,
+	)
+
+	return nil
+}
+
+// RemoveRuntime implements control.NodeController.
+func (n *Node) RemoveRuntime(ctx context.Context, runtimeID common.Namespace) error {
+	if n.CommonWorker.GetRuntime(runtimeID) == nil {
+		return fmt.Errorf("%w: runtime %s is not configured", control.ErrRuntimeNotSupported, runtimeID)
+	}
+	if mode := n.RuntimeRegistry.Mode(); mode == runtimeRegistry.RuntimeModeKeymanager {
+		return fmt.Errorf("%w: removing runtimes is not supported in runtime mode '%s'", control.ErrRuntimeNotSupported, mode)
+	}
+
+	n.logger.Info("removing runtime",
+		"runtime_id", runtimeID,
+	)
+
+	// Tear down the runtime workers in reverse order of initialization.
+	n.ExecutorWorker.RemoveRuntime(runtimeID)
+	n.ClientWorker.RemoveRuntime(runtimeID)
+	n.StorageWorker.RemoveRuntime(runtimeID)
+	if err := n.CommonWorker.RemoveRuntime(runtimeID); err != nil {
+		return err
+	}
+
+	// Re-register without the removed runtime.
+	n.RegistrationWorker.RemoveRuntimeRoleProviders(runtimeID)
+
+	if err := n.RuntimeRegistry.RemoveRuntime(ctx, runtimeID); err != nil {
+		return err
+	}
+
+	n.logger.Info("runtime removed",
+		"runtime_id", runtimeID,
+	)
+
+	return nil
+}
+
 func (n *Node) getIdentityStatus() control.IdentityStatus {
 	return control.IdentityStatus{
 		Node:      n.Identity.NodeSigner.Public(),
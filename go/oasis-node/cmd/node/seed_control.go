@@ -3,8 +3,10 @@ package node
 import (
 	"context"
 
+	"github.com/oasisprotocol/oasis-core/go/common"
 	"github.com/oasisprotocol/oasis-core/go/common/version"
 	control "github.com/oasisprotocol/oasis-core/go/control/api"
+	"github.com/oasisprotocol/oasis-core/go/runtime/txpool"
 	upgrade "github.com/oasisprotocol/oasis-core/go/upgrade/api"
 )
 
@@ -79,3 +81,18 @@ func (n *SeedNode) GetStatus(ctx context.Context) (*control.Status, error) {
 		Seed:            &seedStatus,
 	}, nil
 }
+
+// GetTransactionPool implements control.NodeController.
+func (n *SeedNode) GetTransactionPool(ctx context.Context, runtimeID common.Namespace) (*txpool.Snapshot, error) {
+	return nil, control.ErrNotImplemented
+}
+
+// AddRuntime implements control.NodeController.
+func (n *SeedNode) AddRuntime(ctx context.Context, req *control.AddRuntimeRequest) error {
+	return control.ErrNotImplemented
+}
+
+// RemoveRuntime implements control.NodeController.
+func (n *SeedNode) RemoveRuntime(ctx context.Context, runtimeID common.Namespace) error {
+	return control.ErrNotImplemented
+}
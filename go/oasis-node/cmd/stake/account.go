@@ -10,6 +10,7 @@ import (
 	flag "github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
 	"github.com/oasisprotocol/oasis-core/go/common/prettyprint"
 	consensus "github.com/oasisprotocol/oasis-core/go/consensus/api"
 	cmdCommon "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common"
@@ -39,6 +40,9 @@ const (
 	// CfgEscrowAccount configures the escrow address.
 	CfgEscrowAccount = "stake.escrow.account"
 
+	// CfgRedelegateDestination configures the redelegation destination address.
+	CfgRedelegateDestination = "stake.redelegate.destination"
+
 	// CfgCommissionScheduleRates configures the commission schedule rate steps.
 	CfgCommissionScheduleRates = "stake.commission_schedule.rates"
 
@@ -53,6 +57,12 @@ const (
 
 	// CfgWithdrawSource configures the withdrawal source address.
 	CfgWithdrawSource = "stake.withdraw.source"
+
+	// CfgRewardsFromEpoch configures the first epoch of the rewards history query.
+	CfgRewardsFromEpoch = "stake.rewards.from_epoch"
+
+	// CfgRewardsToEpoch configures the last epoch of the rewards history query.
+	CfgRewardsToEpoch = "stake.rewards.to_epoch"
 )
 
 var (
@@ -66,6 +76,8 @@ var (
 	accountBurnFlags        = flag.NewFlagSet("", flag.ContinueOnError)
 	accountAllowFlags       = flag.NewFlagSet("", flag.ContinueOnError)
 	accountWithdrawFlags    = flag.NewFlagSet("", flag.ContinueOnError)
+	accountRedelegateFlags  = flag.NewFlagSet("", flag.ContinueOnError)
+	accountRewardsFlags     = flag.NewFlagSet("", flag.ContinueOnError)
 
 	accountCmd = &cobra.Command{
 		Use:   "account",
@@ -84,6 +96,12 @@ var (
 		Run:   doAccountNonce,
 	}
 
+	accountRewardsCmd = &cobra.Command{
+		Use:   "rewards",
+		Short: "get per-epoch escrow rewards history",
+		Run:   doAccountRewards,
+	}
+
 	accountValidateAddressCmd = &cobra.Command{
 		Use:   "validate_address",
 		Short: "validate account address",
@@ -114,6 +132,12 @@ var (
 		Run:   doAccountReclaimEscrow,
 	}
 
+	accountRedelegateCmd = &cobra.Command{
+		Use:   "gen_redelegate",
+		Short: "generate a redelegate (move stake between escrow accounts) transaction",
+		Run:   doAccountRedelegate,
+	}
+
 	accountAmendCommissionScheduleCmd = &cobra.Command{
 		Use:   "gen_amend_commission_schedule",
 		Short: "generate an amend commission schedule transaction",
@@ -239,6 +263,69 @@ func doAccountNonce(cmd *cobra.Command, args []string) {
 	fmt.Println(acct.General.Nonce)
 }
 
+func doAccountRewards(cmd *cobra.Command, args []string) {
+	if err := cmdCommon.Init(); err != nil {
+		cmdCommon.EarlyLogAndExit(err)
+	}
+
+	var addr api.Address
+	if err := addr.UnmarshalText([]byte(viper.GetString(CfgAccountAddr))); err != nil {
+		logger.Error("failed to parse account address",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+
+	conn, client := doConnect(cmd)
+	defer conn.Close()
+
+	ctx := context.Background()
+
+	// If the epoch range is not given, default to the most recent epochs.
+	toEpoch := beacon.EpochTime(viper.GetUint64(CfgRewardsToEpoch))
+	if !cmd.Flags().Changed(CfgRewardsToEpoch) {
+		epoch, err := beacon.NewBeaconClient(conn).GetEpoch(ctx, consensus.HeightLatest)
+		if err != nil {
+			logger.Error("failed to query current epoch",
+				"err", err,
+			)
+			os.Exit(1)
+		}
+		toEpoch = epoch
+	}
+	fromEpoch := beacon.EpochTime(viper.GetUint64(CfgRewardsFromEpoch))
+	if !cmd.Flags().Changed(CfgRewardsFromEpoch) {
+		fromEpoch = 0
+		if toEpoch >= api.MaxEscrowRewardsEpochs {
+			fromEpoch = toEpoch - api.MaxEscrowRewardsEpochs + 1
+		}
+	}
+
+	rewards, err := client.GetEscrowRewards(ctx, &api.EscrowRewardsQuery{
+		Owner:     addr,
+		FromEpoch: fromEpoch,
+		ToEpoch:   toEpoch,
+	})
+	if err != nil {
+		logger.Error("failed to query escrow rewards",
+			"address", addr,
+			"err", err,
+		)
+		os.Exit(1)
+	}
+
+	symbol := getTokenSymbol(ctx, client)
+	exp := getTokenValueExponent(ctx, client)
+	ctx = context.WithValue(ctx, prettyprint.ContextKeyTokenSymbol, symbol)
+	ctx = context.WithValue(ctx, prettyprint.ContextKeyTokenValueExponent, exp)
+
+	fmt.Printf("Escrow Rewards for Epochs: %d - %d\n", fromEpoch, toEpoch)
+	for _, r := range rewards {
+		fmt.Println()
+		r.PrettyPrint(ctx, "  ", os.Stdout)
+	}
+}
+
 func doValidateAddress(cmd *cobra.Command, args []string) {
 	if err := cmdCommon.Init(); err != nil {
 		cmdCommon.EarlyLogAndExit(err)
@@ -368,6 +455,40 @@ func doAccountReclaimEscrow(cmd *cobra.Command, args []string) {
 	cmdConsensus.SignAndSaveTx(cmdContext.GetCtxWithGenesisInfo(genesis), tx, nil)
 }
 
+func doAccountRedelegate(cmd *cobra.Command, args []string) {
+	if err := cmdCommon.Init(); err != nil {
+		cmdCommon.EarlyLogAndExit(err)
+	}
+
+	genesis := cmdConsensus.InitGenesis()
+	cmdConsensus.AssertTxFileOK()
+
+	var redelegate api.Redelegate
+	if err := redelegate.From.UnmarshalText([]byte(viper.GetString(CfgEscrowAccount))); err != nil {
+		logger.Error("failed to parse escrow account",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+	if err := redelegate.To.UnmarshalText([]byte(viper.GetString(CfgRedelegateDestination))); err != nil {
+		logger.Error("failed to parse redelegation destination account",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+	if err := redelegate.Shares.UnmarshalText([]byte(viper.GetString(CfgShares))); err != nil {
+		logger.Error("failed to parse redelegation shares",
+			"err", err,
+		)
+		os.Exit(1)
+	}
+
+	nonce, fee := cmdConsensus.GetTxNonceAndFee()
+	tx := api.NewRedelegateTx(nonce, fee, &redelegate)
+
+	cmdConsensus.SignAndSaveTx(cmdContext.GetCtxWithGenesisInfo(genesis), tx, nil)
+}
+
 func scanRateStep(dst *api.CommissionRateStep, raw string) error {
 	var rateBI big.Int
 	n, err := fmt.Sscanf(raw, "%d/%d", &dst.Start, &rateBI)
@@ -515,11 +636,13 @@ func registerAccountCmd() {
 	for _, v := range []*cobra.Command{
 		accountInfoCmd,
 		accountNonceCmd,
+		accountRewardsCmd,
 		accountValidateAddressCmd,
 		accountTransferCmd,
 		accountBurnCmd,
 		accountEscrowCmd,
 		accountReclaimEscrowCmd,
+		accountRedelegateCmd,
 		accountAmendCommissionScheduleCmd,
 		accountAllowCmd,
 		accountWithdrawCmd,
@@ -530,6 +653,8 @@ func registerAccountCmd() {
 	accountInfoCmd.Flags().AddFlagSet(commonAccountFlags)
 	accountInfoCmd.Flags().AddFlagSet(accountInfoFlags)
 	accountNonceCmd.Flags().AddFlagSet(commonAccountFlags)
+	accountRewardsCmd.Flags().AddFlagSet(commonAccountFlags)
+	accountRewardsCmd.Flags().AddFlagSet(accountRewardsFlags)
 	accountValidateAddressCmd.Flags().AddFlagSet(commonAccountFlags)
 	accountValidateAddressCmd.Flags().AddFlagSet(cmdFlags.VerboseFlags)
 	accountTransferCmd.Flags().AddFlagSet(accountTransferFlags)
@@ -538,6 +663,9 @@ func registerAccountCmd() {
 	accountEscrowCmd.Flags().AddFlagSet(amountFlags)
 	accountReclaimEscrowCmd.Flags().AddFlagSet(commonEscrowFlags)
 	accountReclaimEscrowCmd.Flags().AddFlagSet(sharesFlags)
+	accountRedelegateCmd.Flags().AddFlagSet(commonEscrowFlags)
+	accountRedelegateCmd.Flags().AddFlagSet(accountRedelegateFlags)
+	accountRedelegateCmd.Flags().AddFlagSet(sharesFlags)
 	accountAmendCommissionScheduleCmd.Flags().AddFlagSet(commissionScheduleFlags)
 	accountAllowCmd.Flags().AddFlagSet(accountAllowFlags)
 	accountWithdrawCmd.Flags().AddFlagSet(accountWithdrawFlags)
@@ -561,6 +689,10 @@ func init() {
 	)
 	_ = viper.BindPFlags(accountInfoFlags)
 
+	accountRewardsFlags.Uint64(CfgRewardsFromEpoch, 0, "first epoch of the rewards history (default: oldest epoch within the query limit)")
+	accountRewardsFlags.Uint64(CfgRewardsToEpoch, 0, "last epoch of the rewards history (default: current epoch)")
+	_ = viper.BindPFlags(accountRewardsFlags)
+
 	accountTransferFlags.String(CfgTransferDestination, "", "transfer destination account address")
 	_ = viper.BindPFlags(accountTransferFlags)
 	accountTransferFlags.AddFlagSet(cmdConsensus.TxFlags)
@@ -576,6 +708,9 @@ func init() {
 	commonEscrowFlags.AddFlagSet(cmdConsensus.TxFlags)
 	commonEscrowFlags.AddFlagSet(cmdFlags.AssumeYesFlag)
 
+	accountRedelegateFlags.String(CfgRedelegateDestination, "", "address of the redelegation destination escrow account")
+	_ = viper.BindPFlags(accountRedelegateFlags)
+
 	commissionScheduleFlags.StringSlice(CfgCommissionScheduleRates, nil, fmt.Sprintf(
 		"commission rate step. Multiple of this flag is allowed. "+
 			"Each step is in the format start_epoch/rate_numerator. "+
@@ -22,11 +22,13 @@ import (
 	"github.com/oasisprotocol/oasis-core/go/common/entity"
 	cmnGrpc "github.com/oasisprotocol/oasis-core/go/common/grpc"
 	"github.com/oasisprotocol/oasis-core/go/common/identity"
+	"github.com/oasisprotocol/oasis-core/go/common/version"
 	consensusAPI "github.com/oasisprotocol/oasis-core/go/consensus/api"
 	tendermintCommon "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/common"
 	tendermintFull "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/full"
 	tmTestGenesis "github.com/oasisprotocol/oasis-core/go/consensus/tendermint/tests/genesis"
 	consensusTests "github.com/oasisprotocol/oasis-core/go/consensus/tests"
+	control "github.com/oasisprotocol/oasis-core/go/control/api"
 	governance "github.com/oasisprotocol/oasis-core/go/governance/api"
 	governanceTests "github.com/oasisprotocol/oasis-core/go/governance/tests"
 	cmdCommon "github.com/oasisprotocol/oasis-core/go/oasis-node/cmd/common"
@@ -36,6 +38,7 @@ import (
 	registryTests "github.com/oasisprotocol/oasis-core/go/registry/tests"
 	roothash "github.com/oasisprotocol/oasis-core/go/roothash/api"
 	roothashTests "github.com/oasisprotocol/oasis-core/go/roothash/tests"
+	"github.com/oasisprotocol/oasis-core/go/runtime/bundle"
 	runtimeClient "github.com/oasisprotocol/oasis-core/go/runtime/client/api"
 	clientTests "github.com/oasisprotocol/oasis-core/go/runtime/client/tests"
 	runtimeRegistry "github.com/oasisprotocol/oasis-core/go/runtime/registry"
@@ -268,6 +271,9 @@ func TestNode(t *testing.T) {
 		{"Scheduler", testScheduler},
 		{"SchedulerClient", testSchedulerClient},
 		{"RootHash", testRootHash},
+
+		// Adding and removing runtimes is done last as it re-registers the node.
+		{"ControlRuntimes", testControlRuntimes},
 	}
 
 	for _, tc := range testCases {
@@ -447,6 +453,69 @@ func testRuntimeClient(t *testing.T, node *testNode) {
 	})
 }
 
+func testControlRuntimes(t *testing.T, node *testNode) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	runtimeID := common.NewTestNamespaceFromSeed([]byte("oasis node test namespace"), 1)
+
+	// Create a runtime bundle, using the test executable as the runtime ELF executable.
+	execBuf, err := os.ReadFile(os.Args[0])
+	require.NoError(err, "ReadFile")
+	bnd := &bundle.Bundle{
+		Manifest: &bundle.Manifest{
+			Name:       "test-runtime",
+			ID:         runtimeID,
+			Version:    version.Version{Major: 1},
+			Executable: "runtime.bin",
+		},
+	}
+	err = bnd.Add(bnd.Manifest.Executable, execBuf)
+	require.NoError(err, "bundle.Add")
+	bundlePath := filepath.Join(node.dataDir, "test-runtime.orc")
+	err = bnd.Write(bundlePath)
+	require.NoError(err, "bundle.Write")
+
+	err = node.RemoveRuntime(ctx, runtimeID)
+	require.ErrorIs(err, control.ErrRuntimeNotSupported, "removing an unknown runtime should fail")
+
+	err = node.AddRuntime(ctx, &control.AddRuntimeRequest{
+		Bundle: bundlePath,
+		Mode:   string(runtimeRegistry.RuntimeModeClient),
+	})
+	require.ErrorIs(err, control.ErrRuntimeNotSupported, "adding a runtime in a different mode should fail")
+
+	err = node.AddRuntime(ctx, &control.AddRuntimeRequest{
+		Bundle: filepath.Join(node.dataDir, "non-existent.orc"),
+	})
+	require.Error(err, "adding a runtime from a non-existent bundle should fail")
+	require.Nil(node.CommonWorker.GetRuntime(runtimeID), "runtime should not be added")
+
+	// Adding and removing the same runtime should work repeatedly.
+	for i := 0; i < 2; i++ {
+		err = node.AddRuntime(ctx, &control.AddRuntimeRequest{Bundle: bundlePath})
+		require.NoError(err, "AddRuntime")
+		require.NotNil(node.CommonWorker.GetRuntime(runtimeID), "runtime should be added to workers")
+		_, err = node.RuntimeRegistry.GetRuntime(runtimeID)
+		require.NoError(err, "runtime should be added to the runtime registry")
+
+		err = node.AddRuntime(ctx, &control.AddRuntimeRequest{Bundle: bundlePath})
+		require.Error(err, "adding an already added runtime should fail")
+
+		err = node.RemoveRuntime(ctx, runtimeID)
+		require.NoError(err, "RemoveRuntime")
+		require.Nil(node.CommonWorker.GetRuntime(runtimeID), "runtime should be removed from workers")
+		_, err = node.RuntimeRegistry.GetRuntime(runtimeID)
+		require.Error(err, "runtime should be removed from the runtime registry")
+	}
+
+	err = node.RemoveRuntime(ctx, runtimeID)
+	require.ErrorIs(err, control.ErrRuntimeNotSupported, "removing an already removed runtime should fail")
+
+	// Other runtimes should not be affected.
+	require.NotNil(node.CommonWorker.GetRuntime(testRuntimeID), "test runtime should not be removed")
+}
+
 func init() {
 	testEntity, _, _ := entity.TestEntity()
 
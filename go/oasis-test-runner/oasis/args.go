@@ -618,6 +618,12 @@ func (args *argBuilder) appendRuntimePruner(p *RuntimePrunerCfg) *argBuilder {
 		{runtimeRegistry.CfgHistoryPrunerInterval, []string{p.Interval.String()}, false},
 		{runtimeRegistry.CfgHistoryPrunerKeepLastNum, []string{strconv.Itoa(int(p.NumKept))}, false},
 	}...)
+	if p.KeepDuration > 0 {
+		args.vec = append(args.vec, Argument{
+			Name:   runtimeRegistry.CfgHistoryPrunerKeepDuration,
+			Values: []string{p.KeepDuration.String()},
+		})
+	}
 	return args
 }
 
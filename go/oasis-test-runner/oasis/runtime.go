@@ -95,7 +95,8 @@ type RuntimePrunerCfg struct {
 	Strategy string        `json:"strategy"`
 	Interval time.Duration `json:"interval"`
 
-	NumKept uint64 `json:"num_kept"`
+	NumKept      uint64        `json:"num_kept"`
+	KeepDuration time.Duration `json:"keep_duration,omitempty"`
 }
 
 // ID returns the runtime ID.
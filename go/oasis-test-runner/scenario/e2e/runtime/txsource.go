@@ -251,6 +251,7 @@ func (sc *txSourceImpl) Fixture() (*oasis.NetworkFixture, error) {
 				staking.GasOpReclaimEscrow: 10,
 				staking.GasOpAllow:         10,
 				staking.GasOpWithdraw:      10,
+				staking.GasOpRedelegate:    10,
 			},
 			MaxAllowances:             32,
 			FeeSplitWeightPropose:     *quantity.NewFromUint64(2),
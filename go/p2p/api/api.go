@@ -69,6 +69,9 @@ type Service interface {
 	// RegisterHandler registers a message handler for the specified runtime and topic kind.
 	RegisterHandler(topic string, handler Handler)
 
+	// UnregisterHandler unregisters the message handler for the specified topic.
+	UnregisterHandler(topic string)
+
 	// BlockPeer blocks a specific peer from being used by the local node.
 	BlockPeer(peerID core.PeerID)
 
@@ -81,9 +84,15 @@ type Service interface {
 	// RegisterProtocol starts tracking and managing peers that support the given protocol.
 	RegisterProtocol(p core.ProtocolID, min int, total int)
 
+	// UnregisterProtocol stops tracking and managing peers that support the given protocol.
+	UnregisterProtocol(p core.ProtocolID)
+
 	// RegisterProtocolServer registers a protocol server for the given protocol.
 	RegisterProtocolServer(srv rpc.Server)
 
+	// UnregisterProtocolServer unregisters the protocol server for the given protocol.
+	UnregisterProtocolServer(p core.ProtocolID)
+
 	// GetMinRepublishInterval returns the minimum republish interval that needs to be respected by
 	// the caller when publishing the same message. If Publish is called for the same message more
 	// quickly, the message may be dropped and not published.
@@ -34,7 +34,8 @@ type rawMessage struct {
 }
 
 type topicHandler struct {
-	ctx context.Context
+	ctx       context.Context
+	cancelCtx context.CancelFunc
 
 	p2p *p2p
 
@@ -257,8 +258,10 @@ func newTopicHandler(p *p2p, topicID string, handler api.Handler) (*topicHandler
 		return nil, fmt.Errorf("p2p: failed to join topic '%s': %w", topicID, err)
 	}
 
+	ctx, cancelCtx := context.WithCancel(p.ctx)
 	h := &topicHandler{
-		ctx:          p.ctx, // TODO: Should this support individual cancelation?
+		ctx:          ctx,
+		cancelCtx:    cancelCtx,
 		p2p:          p,
 		topic:        topic,
 		host:         p.host,
@@ -273,6 +276,7 @@ func newTopicHandler(p *p2p, topicID string, handler api.Handler) (*topicHandler
 			"err", err,
 		)
 		_ = topic.Close()
+		cancelCtx()
 
 		return nil, fmt.Errorf("p2p: failed to relay topic '%s': %w", topicID, err)
 	}
@@ -281,6 +285,17 @@ func newTopicHandler(p *p2p, topicID string, handler api.Handler) (*topicHandler
 	return h, nil
 }
 
+// close stops handling messages for the topic and leaves the topic.
+func (h *topicHandler) close() {
+	h.cancelCtx()
+	h.cancelRelay()
+	if err := h.topic.Close(); err != nil {
+		h.logger.Warn("failed to close topic",
+			"err", err,
+		)
+	}
+}
+
 func peerIDToPublicKey(peerID core.PeerID) (signature.PublicKey, error) {
 	pk, err := peerID.ExtractPublicKey()
 	if err != nil {
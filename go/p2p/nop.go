@@ -63,6 +63,10 @@ func (p *nopP2P) Publish(ctx context.Context, topic string, msg interface{}) {
 func (p *nopP2P) RegisterHandler(topic string, handler api.Handler) {
 }
 
+// Implements api.Service.
+func (p *nopP2P) UnregisterHandler(topic string) {
+}
+
 // Implements api.Service.
 func (p *nopP2P) BlockPeer(peerID core.PeerID) {
 }
@@ -81,10 +85,18 @@ func (p *nopP2P) PeerManager() api.PeerManager {
 func (p *nopP2P) RegisterProtocol(pid core.ProtocolID, min int, total int) {
 }
 
+// Implements api.Service.
+func (p *nopP2P) UnregisterProtocol(pid core.ProtocolID) {
+}
+
 // Implements api.Service.
 func (p *nopP2P) RegisterProtocolServer(srv rpc.Server) {
 }
 
+// Implements api.Service.
+func (p *nopP2P) UnregisterProtocolServer(pid core.ProtocolID) {
+}
+
 // Implements api.Service.
 func (p *nopP2P) GetMinRepublishInterval() time.Duration {
 	return time.Hour
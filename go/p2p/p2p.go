@@ -301,6 +301,29 @@ func (p *p2p) RegisterHandler(topic string, handler api.Handler) {
 	p.peerMgr.RegisterTopic(topic, minTopicPeers, totalTopicPeers)
 }
 
+// Implements api.Service.
+func (p *p2p) UnregisterHandler(topic string) {
+	p.Lock()
+	defer p.Unlock()
+
+	h, ok := p.topics[topic]
+	if !ok {
+		return
+	}
+	delete(p.topics, topic)
+
+	p.peerMgr.UnregisterTopic(topic)
+	_ = p.pubsub.UnregisterTopicValidator(topic)
+	h.close()
+
+	// Allow the topic to be registered again.
+	protocol.ReleaseTopicID(topic)
+
+	p.logger.Debug("unregistered topic handler",
+		"topic", topic,
+	)
+}
+
 // Implements api.Service.
 func (p *p2p) BlockPeer(peerID core.PeerID) {
 	p.logger.Warn("blocking peer",
@@ -317,6 +340,11 @@ func (p *p2p) RegisterProtocol(pid core.ProtocolID, min int, total int) {
 	p.peerMgr.RegisterProtocol(pid, min, total)
 }
 
+// Implements api.Service.
+func (p *p2p) UnregisterProtocol(pid core.ProtocolID) {
+	p.peerMgr.UnregisterProtocol(pid)
+}
+
 // Implements api.Service.
 func (p *p2p) Host() core.Host {
 	return p.host
@@ -338,6 +366,16 @@ func (p *p2p) RegisterProtocolServer(srv rpc.Server) {
 	)
 }
 
+// Implements api.Service.
+func (p *p2p) UnregisterProtocolServer(pid core.ProtocolID) {
+	p.host.RemoveStreamHandler(pid)
+	protocol.ReleaseProtocolID(pid)
+
+	p.logger.Info("unregistered protocol server",
+		"protocol_id", pid,
+	)
+}
+
 // Implements api.Service.
 func (p *p2p) GetMinRepublishInterval() time.Duration {
 	return seenMessagesTTL + 5*time.Second
@@ -0,0 +1,96 @@
+package p2p
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/libp2p/go-libp2p"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oasisprotocol/oasis-core/go/common"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature/signers/memory"
+	"github.com/oasisprotocol/oasis-core/go/common/logging"
+	"github.com/oasisprotocol/oasis-core/go/common/persistent"
+	"github.com/oasisprotocol/oasis-core/go/p2p/api"
+	"github.com/oasisprotocol/oasis-core/go/p2p/peermgmt"
+	"github.com/oasisprotocol/oasis-core/go/p2p/protocol"
+)
+
+type testHandler struct{}
+
+func (h *testHandler) DecodeMessage(msg []byte) (interface{}, error) {
+	return msg, nil
+}
+
+func (h *testHandler) AuthorizeMessage(context.Context, signature.PublicKey, interface{}) error {
+	return nil
+}
+
+func (h *testHandler) HandleMessage(context.Context, signature.PublicKey, interface{}, bool) error {
+	return nil
+}
+
+func newTestP2P(t *testing.T) *p2p {
+	require := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	listenAddr, err := multiaddr.NewMultiaddr("/ip4/127.0.0.1/tcp/0")
+	require.NoError(err, "NewMultiaddr")
+	signer, err := memory.NewFactory().Generate(signature.SignerP2P, rand.Reader)
+	require.NoError(err, "Generate")
+	host, err := libp2p.New(
+		libp2p.ListenAddrs(listenAddr),
+		libp2p.Identity(api.SignerToPrivKey(signer)),
+	)
+	require.NoError(err, "libp2p.New")
+	t.Cleanup(func() { host.Close() })
+
+	ps, err := pubsub.NewGossipSub(ctx, host)
+	require.NoError(err, "NewGossipSub")
+
+	store, err := persistent.NewCommonStore(t.TempDir())
+	require.NoError(err, "NewCommonStore")
+	t.Cleanup(store.Close)
+
+	return &p2p{
+		ctx:     ctx,
+		host:    host,
+		pubsub:  ps,
+		peerMgr: peermgmt.NewPeerManager(host, nil, ps, nil, "test-chain", store),
+		topics:  make(map[string]*topicHandler),
+		logger:  logging.GetLogger("p2p/test"),
+	}
+}
+
+func TestUnregisterHandler(t *testing.T) {
+	require := require.New(t)
+
+	p := newTestP2P(t)
+
+	runtimeID := common.NewTestNamespaceFromSeed([]byte("p2p unregister handler test"), 0)
+	topic := protocol.NewTopicKindTxID("test-chain", runtimeID)
+
+	p.RegisterHandler(topic, &testHandler{})
+	h := p.topics[topic]
+	require.NotNil(h, "topic handler should be registered")
+	require.Contains(p.peerMgr.Topics(), topic, "topic should be managed by the peer manager")
+
+	p.UnregisterHandler(topic)
+	require.NotContains(p.topics, topic, "topic handler should be unregistered")
+	require.NotContains(p.peerMgr.Topics(), topic, "topic should no longer be managed by the peer manager")
+	require.Error(h.ctx.Err(), "topic handler should be stopped")
+
+	// Unregistering an unknown topic should be a no-op.
+	p.UnregisterHandler(topic)
+
+	// Registering the same topic again should succeed.
+	require.NotPanics(func() { p.RegisterHandler(topic, &testHandler{}) }, "RegisterHandler")
+	require.Contains(p.topics, topic, "topic handler should be registered again")
+	p.UnregisterHandler(topic)
+}
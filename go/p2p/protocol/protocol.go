@@ -41,6 +41,19 @@ func ValidateTopicID(topic string) {
 	ValidateProtocolID(core.ProtocolID(topic))
 }
 
+// ReleaseProtocolID releases the protocol id so that it can be validated again.
+func ReleaseProtocolID(p core.ProtocolID) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	delete(registry.protocols, p)
+}
+
+// ReleaseTopicID releases the topic id so that it can be validated again.
+func ReleaseTopicID(topic string) {
+	ReleaseProtocolID(core.ProtocolID(topic))
+}
+
 // NewProtocolID generates a protocol identifier for a consensus P2P protocol.
 func NewProtocolID(chainContext string, protocolID string, version version.Version) protocol.ID {
 	return protocol.ID(fmt.Sprintf("/oasis/%s/%s/%s", chainContext, protocolID, version.MaskNonMajor()))
@@ -70,4 +70,16 @@ func TestProtocolID(t *testing.T) {
 	})
 
 	registry = newProtocolRegistry()
+
+	t.Run("ReleaseProtocolID", func(t *testing.T) {
+		ValidateProtocolID("protocol")
+		ReleaseProtocolID("protocol")
+		ValidateProtocolID("protocol")
+
+		ValidateTopicID("topic")
+		ReleaseTopicID("topic")
+		ValidateTopicID("topic")
+	})
+
+	registry = newProtocolRegistry()
 }
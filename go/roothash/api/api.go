@@ -146,6 +146,9 @@ type Backend interface {
 	// TrackRuntime adds a runtime the history of which should be tracked.
 	TrackRuntime(ctx context.Context, history BlockHistory) error
 
+	// UntrackRuntime stops tracking the history of the given runtime.
+	UntrackRuntime(ctx context.Context, runtimeID common.Namespace) error
+
 	// StateToGenesis returns the genesis state at specified block height.
 	StateToGenesis(ctx context.Context, height int64) (*Genesis, error)
 
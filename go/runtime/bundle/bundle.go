@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 
 	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
 	"github.com/oasisprotocol/oasis-core/go/common/sgx"
 	"github.com/oasisprotocol/oasis-core/go/common/sgx/sigstruct"
 )
@@ -69,10 +70,10 @@ func (bnd *Bundle) Validate() error {
 
 		mh, ok := bnd.Manifest.Digests[fn]
 		if !ok {
-			// Ignore the manifest not having a digest entry, though
-			// it having one and being valid (while quite a feat) is
-			// also ok.
-			if fn == manifestName {
+			// Ignore the manifest (and its signature) not having a
+			// digest entry, though it having one and being valid
+			// (while quite a feat) is also ok.
+			if fn == manifestName || fn == signatureName {
 				continue
 			}
 			return fmt.Errorf("runtime/bundle: missing digest: '%s'", fn)
@@ -94,6 +95,11 @@ func (bnd *Bundle) Validate() error {
 		return err
 	}
 
+	// Make sure the publisher signature is valid if it exists.
+	if _, err := bnd.PublisherSignature(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -113,6 +119,10 @@ func (bnd *Bundle) Add(fn string, b []byte) error {
 	h := hash.NewFromBytes(b)
 	bnd.Manifest.Digests[fn] = h
 	bnd.Data[fn] = append([]byte{}, b...) // Copy
+
+	// Modifying the manifest invalidates any existing publisher signature.
+	delete(bnd.Data, signatureName)
+
 	return nil
 }
 
@@ -155,6 +165,88 @@ func (bnd *Bundle) verifySgxSignature() error {
 	return nil
 }
 
+// rawManifest returns the serialized manifest as it is (or will be) stored in the bundle.
+func (bnd *Bundle) rawManifest() ([]byte, error) {
+	if b := bnd.Data[manifestName]; b != nil {
+		return b, nil
+	}
+	b, err := json.Marshal(bnd.Manifest)
+	if err != nil {
+		return nil, fmt.Errorf("runtime/bundle: failed to serialize manifest: %w", err)
+	}
+	return b, nil
+}
+
+// PublisherSignature returns the verified publisher signature over the bundle manifest or nil in
+// case the bundle is not signed.
+func (bnd *Bundle) PublisherSignature() (*signature.Signature, error) {
+	rawSig, ok := bnd.Data[signatureName]
+	if !ok {
+		return nil, nil
+	}
+
+	var sig signature.Signature
+	if err := json.Unmarshal(rawSig, &sig); err != nil {
+		return nil, fmt.Errorf("runtime/bundle: malformed manifest signature: %w", err)
+	}
+	rawManifest, err := bnd.rawManifest()
+	if err != nil {
+		return nil, err
+	}
+	if !sig.Verify(ManifestSignatureContext, rawManifest) {
+		return nil, fmt.Errorf("runtime/bundle: invalid manifest signature")
+	}
+
+	return &sig, nil
+}
+
+// VerifyPublisher verifies that the bundle manifest is signed by one of the given trusted
+// publishers.
+func (bnd *Bundle) VerifyPublisher(trusted []signature.PublicKey) error {
+	sig, err := bnd.PublisherSignature()
+	if err != nil {
+		return err
+	}
+	if sig == nil {
+		return fmt.Errorf("runtime/bundle: manifest is not signed by a publisher")
+	}
+
+	for _, pk := range trusted {
+		if pk.Equal(sig.PublicKey) {
+			return nil
+		}
+	}
+	return fmt.Errorf("runtime/bundle: untrusted publisher: %s", sig.PublicKey)
+}
+
+// Sign signs the bundle manifest with the given publisher signer, replacing any existing
+// publisher signature.
+//
+// This removes the serialized manifest from the bundle (see ResetManifest) so that the bundle
+// can be written out afterwards.
+func (bnd *Bundle) Sign(signer signature.Signer) error {
+	bnd.ResetManifest()
+
+	rawManifest, err := bnd.rawManifest()
+	if err != nil {
+		return err
+	}
+	sig, err := signature.Sign(signer, ManifestSignatureContext, rawManifest)
+	if err != nil {
+		return fmt.Errorf("runtime/bundle: failed to sign manifest: %w", err)
+	}
+	rawSig, err := json.Marshal(sig)
+	if err != nil {
+		return fmt.Errorf("runtime/bundle: failed to serialize manifest signature: %w", err)
+	}
+
+	if bnd.Data == nil {
+		bnd.Data = make(map[string][]byte)
+	}
+	bnd.Data[signatureName] = rawSig
+	return nil
+}
+
 // ResetManifest removes the serialized manifest from the bundle so that it can be regenerated on
 // the next call to Write.
 //
@@ -307,8 +399,28 @@ func (bnd *Bundle) Close() error {
 	return nil
 }
 
+// OpenOption is an option for opening runtime bundles.
+type OpenOption func(o *openOptions)
+
+type openOptions struct {
+	trustedPublishers []signature.PublicKey
+}
+
+// WithTrustedPublishers requires the bundle manifest to be signed by one of the given trusted
+// publishers. If the list is empty, unsigned bundles are accepted.
+func WithTrustedPublishers(publishers []signature.PublicKey) OpenOption {
+	return func(o *openOptions) {
+		o.trustedPublishers = publishers
+	}
+}
+
 // Open opens and validates a runtime bundle instance.
-func Open(fn string) (*Bundle, error) {
+func Open(fn string, opts ...OpenOption) (*Bundle, error) {
+	var o openOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	r, err := zip.OpenReader(fn)
 	if err != nil {
 		return nil, fmt.Errorf("runtime/bundle: failed to open bundle: %w", err)
@@ -330,6 +442,9 @@ func Open(fn string) (*Bundle, error) {
 				return nil, fmt.Errorf("runtime/bundle: invalid manifest file name: '%s'", v.Name)
 			}
 		default:
+			if v.Name == signatureName {
+				break
+			}
 			if filepath.Dir(v.Name) != "." {
 				return nil, fmt.Errorf("runtime/bundle: failed to sanitize path '%s'", v.Name)
 			}
@@ -369,5 +484,12 @@ func Open(fn string) (*Bundle, error) {
 		return nil, err
 	}
 
+	// Ensure the bundle has been published by a trusted publisher.
+	if len(o.trustedPublishers) > 0 {
+		if err = bnd.VerifyPublisher(o.trustedPublishers); err != nil {
+			return nil, err
+		}
+	}
+
 	return bnd, nil
 }
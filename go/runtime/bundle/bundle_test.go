@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/oasisprotocol/oasis-core/go/common"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	memorySigner "github.com/oasisprotocol/oasis-core/go/common/crypto/signature/signers/memory"
 )
 
 func TestBundle(t *testing.T) {
@@ -84,6 +86,49 @@ func TestBundle(t *testing.T) {
 		require.NoError(t, err, "bundle.Write")
 	})
 
+	t.Run("Sign", func(t *testing.T) {
+		publisher := memorySigner.NewTestSigner("runtime/bundle: test publisher")
+		other := memorySigner.NewTestSigner("runtime/bundle: test other publisher")
+		signedFn := bundleFn + ".signed"
+
+		bundle2, err := Open(bundleFn)
+		require.NoError(t, err, "Open")
+
+		sig, err := bundle2.PublisherSignature()
+		require.NoError(t, err, "PublisherSignature")
+		require.Nil(t, sig, "unsigned bundle should not have a publisher signature")
+
+		err = bundle2.Sign(publisher)
+		require.NoError(t, err, "bundle.Sign")
+		err = bundle2.Write(signedFn)
+		require.NoError(t, err, "bundle.Write")
+
+		bundle3, err := Open(signedFn, WithTrustedPublishers([]signature.PublicKey{publisher.Public()}))
+		require.NoError(t, err, "Open(trusted)")
+		sig, err = bundle3.PublisherSignature()
+		require.NoError(t, err, "PublisherSignature")
+		require.EqualValues(t, publisher.Public(), sig.PublicKey, "publisher signature should be present")
+
+		_, err = Open(signedFn, WithTrustedPublishers([]signature.PublicKey{other.Public()}))
+		require.Error(t, err, "Open should fail with an untrusted publisher")
+
+		_, err = Open(bundleFn, WithTrustedPublishers([]signature.PublicKey{publisher.Public()}))
+		require.Error(t, err, "Open should fail for unsigned bundles when publishers are configured")
+
+		// Tampering with the manifest should invalidate the signature.
+		bundle3.ResetManifest()
+		bundle3.Manifest.Name = "tampered"
+		err = bundle3.Validate()
+		require.Error(t, err, "Validate should fail with a tampered manifest")
+
+		// Adding files should remove the signature.
+		err = bundle3.Add("extra.bin", []byte("extra"))
+		require.NoError(t, err, "bundle.Add")
+		sig, err = bundle3.PublisherSignature()
+		require.NoError(t, err, "PublisherSignature")
+		require.Nil(t, sig, "modified bundle should not have a publisher signature")
+	})
+
 	t.Run("Explode", func(t *testing.T) {
 		err := bundle.WriteExploded(tmpDir)
 		require.NoError(t, err, "WriteExploded")
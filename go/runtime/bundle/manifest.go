@@ -3,14 +3,19 @@ package bundle
 import (
 	"github.com/oasisprotocol/oasis-core/go/common"
 	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
 	"github.com/oasisprotocol/oasis-core/go/common/version"
 )
 
 const (
-	manifestPath = "META-INF"
-	manifestName = manifestPath + "/MANIFEST.MF"
+	manifestPath  = "META-INF"
+	manifestName  = manifestPath + "/MANIFEST.MF"
+	signatureName = manifestPath + "/MANIFEST.SIG"
 )
 
+// ManifestSignatureContext is the context used for signing runtime bundle manifests.
+var ManifestSignatureContext = signature.NewContext("oasis-core/runtime/bundle: manifest")
+
 // Manifest is a deserialized runtime bundle manifest.
 type Manifest struct {
 	// Name is the optional human readable runtime name.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -448,3 +449,63 @@ func TestHistoryPruneError(t *testing.T) {
 		require.NoError(err, "GetBlock(%d)", i)
 	}
 }
+
+func TestHistoryPruneKeepDuration(t *testing.T) {
+	runtimeID := common.NewTestNamespaceFromSeed([]byte("history prune keep duration test ns"), 0)
+
+	for _, tc := range []struct {
+		name     string
+		pruner   PrunerFactory
+		numKept  int
+		numTotal int
+	}{
+		// Blocks are 10 seconds apart, so the last 100 seconds cover 11 rounds.
+		{"KeepDuration", NewKeepDurationPruner(100 * time.Second), 11, 51},
+		{"KeepHybridRounds", NewKeepHybridPruner(20, 100*time.Second), 20, 51},
+		{"KeepHybridDuration", NewKeepHybridPruner(5, 100*time.Second), 11, 51},
+		{"KeepDurationAll", NewKeepDurationPruner(24 * time.Hour), 51, 51},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := context.Background()
+
+			db, err := newDB(filepath.Join(t.TempDir(), DbFilename), runtimeID)
+			require.NoError(err, "newDB")
+			defer db.close()
+
+			for i := 0; i < tc.numTotal; i++ {
+				blk := roothash.AnnotatedBlock{
+					Height: int64(i),
+					Block:  block.NewGenesisBlock(runtimeID, 0),
+				}
+				blk.Block.Header.Round = uint64(i)
+				blk.Block.Header.Timestamp = block.Timestamp(1_000_000 + 10*i)
+
+				err = db.commit(&blk, &roothash.RoundResults{})
+				require.NoError(err, "commit")
+			}
+
+			pruner, err := tc.pruner(db)
+			require.NoError(err, "pruner")
+			ph := testPruneHandler{
+				doneCh:     make(chan struct{}),
+				waitRounds: tc.numTotal + 1,
+			}
+			pruner.RegisterHandler(&ph)
+
+			err = pruner.Prune(ctx, uint64(tc.numTotal-1))
+			require.NoError(err, "Prune")
+
+			numPruned := tc.numTotal - tc.numKept
+			require.Len(ph.prunedRounds, numPruned, "prune handler should be called for pruned rounds")
+			for i := 0; i < tc.numTotal; i++ {
+				_, err = db.getBlock(uint64(i))
+				if i < numPruned {
+					require.Equal(roothash.ErrNotFound, err, "getBlock(%d) should fail for pruned block", i)
+				} else {
+					require.NoError(err, "getBlock(%d)", i)
+				}
+			}
+		})
+	}
+}
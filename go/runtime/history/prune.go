@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/dgraph-io/badger/v3"
 
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
 	"github.com/oasisprotocol/oasis-core/go/common/logging"
+	roothash "github.com/oasisprotocol/oasis-core/go/roothash/api"
 )
 
 const (
@@ -15,6 +18,11 @@ const (
 	PrunerStrategyNone = "none"
 	// PrunerStrategyKeepLast is the name of the keep last pruner strategy.
 	PrunerStrategyKeepLast = "keep_last"
+	// PrunerStrategyKeepDuration is the name of the keep duration pruner strategy.
+	PrunerStrategyKeepDuration = "keep_duration"
+	// PrunerStrategyKeepHybrid is the name of the hybrid pruner strategy which keeps the last
+	// number of rounds or the rounds within the kept duration, whichever is larger.
+	PrunerStrategyKeepHybrid = "keep_hybrid"
 
 	// maxBatchSize is the maximum number of rounds to prune in one pass.
 	maxBatchSize = 64
@@ -93,11 +101,14 @@ func (p *keepLastPruner) Prune(ctx context.Context, latestRound uint64) error {
 		return nil
 	}
 
+	return p.pruneUpTo(ctx, latestRound-p.numKept)
+}
+
+// pruneUpTo prunes all rounds up to and including the given round.
+func (p *keepLastPruner) pruneUpTo(ctx context.Context, lastPrunedRound uint64) error {
 	p.prunerBase.RLock()
 	defer p.prunerBase.RUnlock()
 
-	lastPrunedRound := latestRound - p.numKept
-
 	return p.db.db.Update(func(tx *badger.Txn) error {
 		// NOTE: Do not prefetch values as we are only looking at keys.
 		it := tx.NewIterator(badger.IteratorOptions{
@@ -170,3 +181,109 @@ func NewKeepLastPruner(numKept uint64) PrunerFactory {
 		}, nil
 	}
 }
+
+type keepDurationPruner struct {
+	keepLastPruner
+
+	keepDuration time.Duration
+}
+
+func (p *keepDurationPruner) Prune(ctx context.Context, latestRound uint64) error {
+	if latestRound < p.numKept {
+		return nil
+	}
+
+	// Use the timestamp of the latest block as the reference so that the kept history does not
+	// depend on the local clock (e.g., while the node is still syncing).
+	latestBlk, err := p.db.getBlock(latestRound)
+	if err != nil {
+		return fmt.Errorf("runtime/history: failed to fetch latest block: %w", err)
+	}
+	cutoff := time.Unix(int64(latestBlk.Block.Header.Timestamp), 0).Add(-p.keepDuration)
+
+	firstKeptRound, err := p.firstKeptRound(latestRound, cutoff)
+	if err != nil {
+		return err
+	}
+	if firstKeptRound == 0 {
+		return nil
+	}
+
+	lastPrunedRound := latestRound - p.numKept
+	if firstKeptRound-1 < lastPrunedRound {
+		lastPrunedRound = firstKeptRound - 1
+	}
+	return p.pruneUpTo(ctx, lastPrunedRound)
+}
+
+// firstKeptRound returns the first round whose block timestamp is not before the given cutoff.
+//
+// As block timestamps are monotonic, this performs a binary search so that only a logarithmic
+// number of blocks need to be decoded.
+func (p *keepDurationPruner) firstKeptRound(latestRound uint64, cutoff time.Time) (uint64, error) {
+	var lo, hi uint64 = 0, latestRound
+	err := p.db.db.View(func(tx *badger.Txn) error {
+		it := tx.NewIterator(badger.IteratorOptions{
+			Prefix: blockKeyFmt.Encode(),
+		})
+		defer it.Close()
+
+		for lo < hi {
+			mid := lo + (hi-lo)/2
+
+			// Use the first block at or after the given round as there may be gaps.
+			it.Seek(blockKeyFmt.Encode(mid))
+			if !it.Valid() {
+				hi = mid
+				continue
+			}
+
+			var blk roothash.AnnotatedBlock
+			if err := it.Item().Value(func(val []byte) error {
+				return cbor.UnmarshalTrusted(val, &blk)
+			}); err != nil {
+				return fmt.Errorf("runtime/history: failed to decode block: %w", err)
+			}
+
+			if time.Unix(int64(blk.Block.Header.Timestamp), 0).Before(cutoff) {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+		return nil
+	})
+	return lo, err
+}
+
+// NewKeepDurationPruner creates a pruner that keeps all rounds with block timestamps within the
+// configured duration before the timestamp of the latest block.
+func NewKeepDurationPruner(keepDuration time.Duration) PrunerFactory {
+	return func(db *DB) (Pruner, error) {
+		return &keepDurationPruner{
+			keepLastPruner: keepLastPruner{
+				prunerBase: newPrunerBase(),
+				logger:     logging.GetLogger("history/prune/keep_duration"),
+				db:         db,
+			},
+			keepDuration: keepDuration,
+		}, nil
+	}
+}
+
+// NewKeepHybridPruner creates a pruner that keeps the last configured number of rounds or all
+// rounds within the configured duration before the timestamp of the latest block, whichever
+// results in more rounds being kept.
+func NewKeepHybridPruner(numKept uint64, keepDuration time.Duration) PrunerFactory {
+	return func(db *DB) (Pruner, error) {
+		return &keepDurationPruner{
+			keepLastPruner: keepLastPruner{
+				prunerBase: newPrunerBase(),
+				logger:     logging.GetLogger("history/prune/keep_hybrid"),
+				db:         db,
+				numKept:    numKept,
+			},
+			keepDuration: keepDuration,
+		}, nil
+	}
+}
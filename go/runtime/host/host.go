@@ -3,6 +3,7 @@ package host
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/oasisprotocol/oasis-core/go/common"
 	"github.com/oasisprotocol/oasis-core/go/common/node"
@@ -25,6 +26,49 @@ type Config struct {
 
 	// LocalConfig is the node-local runtime configuration.
 	LocalConfig map[string]interface{}
+
+	// Resources are the optional resource limits for the provisioned runtime.
+	Resources *ResourceLimits
+}
+
+// Resource is a resource that can be limited for a provisioned runtime.
+type Resource string
+
+const (
+	// ResourceMemory is the memory resource.
+	ResourceMemory Resource = "memory"
+	// ResourceCPU is the CPU resource.
+	ResourceCPU Resource = "cpu"
+	// ResourcePids is the number of processes resource.
+	ResourcePids Resource = "pids"
+)
+
+// MaxCPUWeight is the maximum allowed CPU weight.
+const MaxCPUWeight = 10_000
+
+// ResourceLimits are the resource limits for a provisioned runtime. Zero values mean that the
+// given resource is not limited.
+type ResourceLimits struct {
+	// MemoryMax is the maximum amount of memory (in bytes) that can be used by the runtime.
+	MemoryMax uint64 `mapstructure:"memory_max"`
+
+	// CPUWeight is the relative CPU weight of the runtime in range [1, 10000].
+	CPUWeight uint64 `mapstructure:"cpu_weight"`
+
+	// CPUQuota is the maximum CPU time the runtime can use, in percent of a single CPU (e.g., 200
+	// means that the runtime can use up to two CPUs).
+	CPUQuota uint64 `mapstructure:"cpu_quota"`
+
+	// PidsMax is the maximum number of processes that can be spawned by the runtime.
+	PidsMax uint64 `mapstructure:"pids_max"`
+}
+
+// Validate validates the resource limits.
+func (rl *ResourceLimits) Validate() error {
+	if rl.CPUWeight > MaxCPUWeight {
+		return fmt.Errorf("CPU weight must be in range [1, %d]", MaxCPUWeight)
+	}
+	return nil
 }
 
 // RuntimeBundle is a exploded runtime bundle ready for execution.
@@ -68,6 +112,32 @@ type Runtime interface {
 
 	// Stop signals the provisioned runtime to stop.
 	Stop()
+
+	// GetProcessStats returns the resource usage statistics of the running runtime processes. In
+	// case the statistics are not available (e.g., because the runtime is not running or the
+	// provisioner does not support them), an empty list is returned.
+	GetProcessStats() []*ProcessStats
+}
+
+// ProcessStats are the resource usage statistics of the processes of a provisioned runtime.
+type ProcessStats struct {
+	// Version is the runtime version.
+	Version version.Version
+
+	// NumProcesses is the number of processes.
+	NumProcesses uint64
+
+	// CPUSeconds is the CPU time spent in user and kernel mode (in seconds).
+	CPUSeconds float64
+
+	// RSSBytes is the resident set size (in bytes).
+	RSSBytes uint64
+
+	// IOReadBytes is the number of bytes read from the storage layer.
+	IOReadBytes uint64
+
+	// IOWriteBytes is the number of bytes written to the storage layer.
+	IOWriteBytes uint64
 }
 
 // RuntimeEventEmitter is the interface for emitting events for a provisioned runtime.
@@ -78,10 +148,11 @@ type RuntimeEventEmitter interface {
 
 // Event is a runtime host event.
 type Event struct {
-	Started       *StartedEvent
-	FailedToStart *FailedToStartEvent
-	Stopped       *StoppedEvent
-	Updated       *UpdatedEvent
+	Started          *StartedEvent
+	FailedToStart    *FailedToStartEvent
+	Stopped          *StoppedEvent
+	Updated          *UpdatedEvent
+	ResourceLimitHit *ResourceLimitHitEvent
 }
 
 // StartedEvent is a runtime started event.
@@ -103,6 +174,15 @@ type FailedToStartEvent struct {
 // StoppedEvent is a runtime stopped event.
 type StoppedEvent struct{}
 
+// ResourceLimitHitEvent is a runtime resource limit hit event.
+type ResourceLimitHitEvent struct {
+	// Resource is the resource whose limit has been hit.
+	Resource Resource
+
+	// Count is the number of times the limit has been hit since the last event.
+	Count uint64
+}
+
 // UpdatedEvent is a runtime metadata updated event.
 type UpdatedEvent struct {
 	// Version is the runtime version.
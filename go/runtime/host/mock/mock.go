@@ -202,6 +202,11 @@ func (r *runtime) Stop() {
 	})
 }
 
+// Implements host.Runtime.
+func (r *runtime) GetProcessStats() []*host.ProcessStats {
+	return nil
+}
+
 // New creates a new mock runtime provisioner useful for tests.
 func New() host.Provisioner {
 	return &provisioner{}
@@ -160,6 +160,18 @@ func (agg *Aggregate) Stop() {
 	agg.stopActiveLocked()
 }
 
+// GetProcessStats implements host.Runtime.
+func (agg *Aggregate) GetProcessStats() []*host.ProcessStats {
+	agg.l.RLock()
+	defer agg.l.RUnlock()
+
+	var stats []*host.ProcessStats
+	for _, ah := range agg.hosts {
+		stats = append(stats, ah.host.GetProcessStats()...)
+	}
+	return stats
+}
+
 // SetVersion sets the active runtime version.  This routine will:
 //   - Do nothing if the active version is already the requested version.
 //   - Unconditionally tear down the currently active version (via Stop()).
@@ -221,6 +233,58 @@ func (agg *Aggregate) SetVersion(ctx context.Context, version version.Version) e
 	return nil
 }
 
+// HasVersion checks whether the given version is known to the aggregate.
+func (agg *Aggregate) HasVersion(version version.Version) bool {
+	agg.l.RLock()
+	defer agg.l.RUnlock()
+
+	return agg.hosts[version] != nil
+}
+
+// AddVersion adds a new version to the aggregate. The runtime provided must be freshly
+// provisioned (ie: Start() must not have been called) and will only be started once the version
+// is activated via SetVersion.
+func (agg *Aggregate) AddVersion(ctx context.Context, version version.Version, rt host.Runtime) error {
+	agg.l.Lock()
+	defer agg.l.Unlock()
+
+	agg.logger.Info("AddVersion",
+		"id", agg.ID(),
+		"version", version,
+	)
+
+	return agg.addVersionLocked(ctx, version, rt)
+}
+
+func (agg *Aggregate) addVersionLocked(ctx context.Context, version version.Version, rt host.Runtime) error {
+	// Contract: agg.l already locked for write (or the aggregate is not yet shared).
+
+	if rt.ID() != agg.id {
+		return fmt.Errorf("runtime/host/multi: sub-runtime mismatch: got '%s', expected '%s'",
+			rt.ID().String(),
+			agg.id.String(),
+		)
+	}
+	if agg.hosts[version] != nil {
+		return fmt.Errorf("runtime/host/multi: duplicate sub-runtime version: %v", version)
+	}
+
+	ch, sub, err := rt.WatchEvents(ctx)
+	if err != nil {
+		return fmt.Errorf("runtime/host/multi: failed to subscribe to sub-runtime events: %w", err)
+	}
+
+	agg.hosts[version] = &aggregatedHost{
+		host:      rt,
+		ch:        ch,
+		sub:       sub,
+		stopCh:    make(chan struct{}),
+		stoppedCh: make(chan struct{}),
+		version:   version,
+	}
+	return nil
+}
+
 func (agg *Aggregate) stopActiveLocked() {
 	// Contract: agg.l already locked for write.
 
@@ -283,30 +347,9 @@ func New(
 	}
 
 	for version, rt := range rts {
-		if rt.ID() != id {
-			return nil, fmt.Errorf("runtime/host/multi: sub-runtime mismatch: got '%s', expected '%s'",
-				rt.ID().String(),
-				id.String(),
-			)
-		}
-		if agg.hosts[version] != nil {
-			return nil, fmt.Errorf("runtime/host/multi: duplicate sub-runtime version: %v", version)
-		}
-
-		ch, sub, err := rt.WatchEvents(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("runtime/host/multi: failed to subscribe to sub-runtime events: %w", err)
-		}
-
-		ah := &aggregatedHost{
-			host:      rts[version],
-			ch:        ch,
-			sub:       sub,
-			stopCh:    make(chan struct{}),
-			stoppedCh: make(chan struct{}),
-			version:   version,
+		if err := agg.addVersionLocked(ctx, version, rt); err != nil {
+			return nil, err
 		}
-		agg.hosts[version] = ah
 	}
 
 	return agg, nil
@@ -0,0 +1,39 @@
+package sandbox
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/oasisprotocol/oasis-core/go/runtime/host"
+)
+
+var (
+	resourceLimitHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "oasis_runtime_resource_limit_hits",
+			Help: "Number of times a runtime resource limit has been hit.",
+		},
+		[]string{"runtime", "version", "resource"},
+	)
+
+	sandboxCollectors = []prometheus.Collector{
+		resourceLimitHits,
+	}
+
+	metricsOnce sync.Once
+)
+
+func initMetrics() {
+	metricsOnce.Do(func() {
+		prometheus.MustRegister(sandboxCollectors...)
+	})
+}
+
+func (r *sandboxedRuntime) getMetricLabels(resource host.Resource) prometheus.Labels {
+	return prometheus.Labels{
+		"runtime":  r.id.String(),
+		"version":  r.rtCfg.Bundle.Manifest.Version.String(),
+		"resource": string(resource),
+	}
+}
@@ -173,6 +173,16 @@ func NewBubbleWrap(cfg Config) (Process, error) {
 		dataPipes = append(dataPipes, rwPipe{reader, pipe})
 	}
 
+	// Prepare the cgroup for limiting sandbox resources. The sandbox is started inside the cgroup
+	// so all sandboxed processes inherit it.
+	var cgroupPath string
+	if cfg.Cgroup != nil {
+		if err = setupCgroup(cfg.Cgroup); err != nil {
+			return nil, fmt.Errorf("sandbox: failed to setup cgroup: %w", err)
+		}
+		cgroupPath = cfg.Cgroup.Path
+	}
+
 	// Start our sandbox.
 	n, err := NewNaked(Config{
 		Path:   cfg.SandboxBinaryPath,
@@ -182,9 +192,10 @@ func NewBubbleWrap(cfg Config) (Process, error) {
 		// Pass all the pipe file descriptors.
 		// NOTE: Entry i becomes file descriptor 3+i.
 		extraFiles: fdPipes.pipes,
+		cgroupPath: cgroupPath,
 	})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("sandbox: %w", err)
 	}
 
 	// Send configuration arguments.
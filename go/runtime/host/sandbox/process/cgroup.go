@@ -0,0 +1,73 @@
+package process
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// CgroupConfig is the cgroup v2 configuration of a sandboxed process.
+type CgroupConfig struct {
+	// Path is the path to the cgroup that the sandbox should be placed in. In case the cgroup does
+	// not yet exist, it is created. The parent cgroup must be part of a delegated cgroup v2 subtree.
+	Path string
+
+	// MemoryMax is the memory.max limit in bytes. Zero means no limit.
+	MemoryMax uint64
+
+	// CPUWeight is the cpu.weight value. Zero means the default weight.
+	CPUWeight uint64
+
+	// CPUQuota is the cpu.max limit in percent of a single CPU. Zero means no limit.
+	CPUQuota uint64
+
+	// PidsMax is the pids.max limit. Zero means no limit.
+	PidsMax uint64
+}
+
+// CgroupEvents are the cumulative resource limit hit counters of a cgroup.
+type CgroupEvents struct {
+	// MemoryMax is the number of times the memory usage was about to go over memory.max.
+	MemoryMax uint64
+
+	// CPUThrottled is the number of periods in which the cgroup was throttled due to cpu.max.
+	CPUThrottled uint64
+
+	// PidsMax is the number of times a fork failed due to pids.max.
+	PidsMax uint64
+}
+
+// cgroupCPUPeriod is the cpu.max period in microseconds.
+const cgroupCPUPeriod = 100_000
+
+// controllers returns the cgroup controllers required to enforce the configured limits.
+func (cfg *CgroupConfig) controllers() []string {
+	var controllers []string
+	if cfg.MemoryMax > 0 {
+		controllers = append(controllers, "memory")
+	}
+	if cfg.CPUWeight > 0 || cfg.CPUQuota > 0 {
+		controllers = append(controllers, "cpu")
+	}
+	if cfg.PidsMax > 0 {
+		controllers = append(controllers, "pids")
+	}
+	return controllers
+}
+
+// limits returns the cgroup interface files and values that enforce the configured limits.
+func (cfg *CgroupConfig) limits() map[string]string {
+	limits := make(map[string]string)
+	if cfg.MemoryMax > 0 {
+		limits["memory.max"] = strconv.FormatUint(cfg.MemoryMax, 10)
+	}
+	if cfg.CPUWeight > 0 {
+		limits["cpu.weight"] = strconv.FormatUint(cfg.CPUWeight, 10)
+	}
+	if cfg.CPUQuota > 0 {
+		limits["cpu.max"] = fmt.Sprintf("%d %d", cfg.CPUQuota*cgroupCPUPeriod/100, cgroupCPUPeriod)
+	}
+	if cfg.PidsMax > 0 {
+		limits["pids.max"] = strconv.FormatUint(cfg.PidsMax, 10)
+	}
+	return limits
+}
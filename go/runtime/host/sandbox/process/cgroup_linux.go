@@ -0,0 +1,110 @@
+//go:build linux
+// +build linux
+
+package process
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// setupCgroup creates the configured cgroup, enables the required controllers in its parent and
+// applies the configured limits.
+//
+// NOTE: Due to the cgroup v2 "no internal processes" rule, controllers can only be enabled in the
+// parent in case it does not contain any processes itself. This means that the node must not run
+// in the parent cgroup and should instead be placed in a separate leaf cgroup.
+func setupCgroup(cfg *CgroupConfig) error {
+	parent := filepath.Dir(cfg.Path)
+	for _, controller := range cfg.controllers() {
+		err := os.WriteFile(filepath.Join(parent, "cgroup.subtree_control"), []byte("+"+controller), 0)
+		switch {
+		case err == nil:
+		case errors.Is(err, syscall.EBUSY):
+			return fmt.Errorf("failed to enable cgroup controller '%s' in '%s' as it contains processes (make sure the node runs in a separate leaf cgroup, e.g. '%s'): %w",
+				controller, parent, filepath.Join(parent, "node"), err,
+			)
+		default:
+			return fmt.Errorf("failed to enable cgroup controller '%s' in '%s': %w", controller, parent, err)
+		}
+	}
+
+	if err := os.Mkdir(cfg.Path, 0o755); err != nil && !errors.Is(err, os.ErrExist) {
+		return fmt.Errorf("failed to create cgroup '%s': %w", cfg.Path, err)
+	}
+
+	for file, value := range cfg.limits() {
+		if err := os.WriteFile(filepath.Join(cfg.Path, file), []byte(value), 0); err != nil {
+			return fmt.Errorf("failed to set cgroup limit '%s': %w", file, err)
+		}
+	}
+	return nil
+}
+
+// RemoveCgroup removes the given cgroup. The cgroup must not contain any processes.
+func RemoveCgroup(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove cgroup '%s': %w", path, err)
+	}
+	return nil
+}
+
+// ReadCgroupEvents reads the resource limit hit counters of the given cgroup.
+//
+// Counters of controllers that are not enabled for the cgroup are reported as zero.
+func ReadCgroupEvents(path string) (*CgroupEvents, error) {
+	var ev CgroupEvents
+	for _, f := range []struct {
+		file  string
+		key   string
+		value *uint64
+	}{
+		{"memory.events", "max", &ev.MemoryMax},
+		{"cpu.stat", "nr_throttled", &ev.CPUThrottled},
+		{"pids.events", "max", &ev.PidsMax},
+	} {
+		data, err := os.ReadFile(filepath.Join(path, f.file))
+		switch {
+		case err == nil:
+		case errors.Is(err, os.ErrNotExist):
+			continue
+		default:
+			return nil, fmt.Errorf("failed to read cgroup file '%s': %w", f.file, err)
+		}
+
+		values, err := parseFlatKeyed(data)
+		if err != nil {
+			return nil, fmt.Errorf("malformed cgroup file '%s': %w", f.file, err)
+		}
+		*f.value = values[f.key]
+	}
+	return &ev, nil
+}
+
+// parseFlatKeyed parses the contents of a flat keyed cgroup interface file.
+func parseFlatKeyed(data []byte) (map[string]uint64, error) {
+	values := make(map[string]uint64)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("malformed line: %s", scanner.Text())
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed value for key '%s': %w", fields[0], err)
+		}
+		values[fields[0]] = value
+	}
+	return values, scanner.Err()
+}
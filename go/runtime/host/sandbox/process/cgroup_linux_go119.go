@@ -0,0 +1,31 @@
+//go:build linux && !go1.20
+// +build linux,!go1.20
+
+package process
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+)
+
+// startInCgroup starts the given command and moves the process into the given cgroup.
+//
+// NOTE: Spawning a process directly into a cgroup requires Go 1.20, so the process briefly runs
+// outside of the cgroup. The Bubblewrap sandbox does not spawn anything before it receives its
+// configuration, which is only sent after this method returns.
+func startInCgroup(cmd *exec.Cmd, path string) error {
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	pid := cmd.Process.Pid
+	if err := os.WriteFile(filepath.Join(path, "cgroup.procs"), []byte(strconv.Itoa(pid)), 0); err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return fmt.Errorf("failed to move process %d into cgroup '%s': %w", pid, path, err)
+	}
+	return nil
+}
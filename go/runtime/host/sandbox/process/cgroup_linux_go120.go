@@ -0,0 +1,33 @@
+//go:build linux && go1.20
+// +build linux,go1.20
+
+package process
+
+import (
+	"fmt"
+	"os/exec"
+	"syscall"
+)
+
+// startInCgroup starts the given command with the process spawned directly into the given cgroup
+// so that the resource limits apply from the very start.
+//
+// This requires Linux 5.7 or later.
+func startInCgroup(cmd *exec.Cmd, path string) error {
+	fd, err := syscall.Open(path, syscall.O_DIRECTORY|syscall.O_RDONLY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open cgroup '%s': %w", path, err)
+	}
+	defer syscall.Close(fd)
+
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.UseCgroupFD = true
+	cmd.SysProcAttr.CgroupFD = fd
+
+	if err = cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start process in cgroup '%s': %w", path, err)
+	}
+	return nil
+}
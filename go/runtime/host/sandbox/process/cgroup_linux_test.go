@@ -0,0 +1,45 @@
+//go:build linux
+// +build linux
+
+package process
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCgroupConfig(t *testing.T) {
+	require := require.New(t)
+
+	cfg := CgroupConfig{
+		MemoryMax: 1 << 30,
+		CPUQuota:  150,
+		PidsMax:   64,
+	}
+	require.EqualValues([]string{"memory", "cpu", "pids"}, cfg.controllers())
+	require.EqualValues(map[string]string{
+		"memory.max": "1073741824",
+		"cpu.max":    "150000 100000",
+		"pids.max":   "64",
+	}, cfg.limits())
+
+	cfg = CgroupConfig{CPUWeight: 50}
+	require.EqualValues([]string{"cpu"}, cfg.controllers())
+	require.EqualValues(map[string]string{"cpu.weight": "50"}, cfg.limits())
+}
+
+func TestParseFlatKeyed(t *testing.T) {
+	require := require.New(t)
+
+	values, err := parseFlatKeyed([]byte("low 0\nhigh 0\nmax 12\noom 1\noom_kill 1\n"))
+	require.NoError(err, "parseFlatKeyed")
+	require.EqualValues(12, values["max"])
+	require.EqualValues(1, values["oom_kill"])
+
+	_, err = parseFlatKeyed([]byte("max\n"))
+	require.Error(err, "parseFlatKeyed should fail on missing value")
+
+	_, err = parseFlatKeyed([]byte("max foo\n"))
+	require.Error(err, "parseFlatKeyed should fail on malformed value")
+}
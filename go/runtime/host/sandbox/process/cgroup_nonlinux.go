@@ -0,0 +1,27 @@
+//go:build !linux
+// +build !linux
+
+package process
+
+import (
+	"errors"
+	"os/exec"
+)
+
+func setupCgroup(cfg *CgroupConfig) error {
+	return errors.New("setupCgroup only implemented for Linux")
+}
+
+func startInCgroup(cmd *exec.Cmd, path string) error {
+	return errors.New("startInCgroup only implemented for Linux")
+}
+
+// RemoveCgroup removes the given cgroup. The cgroup must not contain any processes.
+func RemoveCgroup(path string) error {
+	return errors.New("RemoveCgroup only implemented for Linux")
+}
+
+// ReadCgroupEvents reads the resource limit hit counters of the given cgroup.
+func ReadCgroupEvents(path string) (*CgroupEvents, error) {
+	return nil, errors.New("ReadCgroupEvents only implemented for Linux")
+}
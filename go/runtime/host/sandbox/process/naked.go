@@ -108,8 +108,16 @@ func NewNaked(cfg Config) (Process, error) {
 		}
 	}
 
-	if err := cmd.Start(); err != nil {
-		return nil, err
+	// Start the process, placing it into the cgroup if configured.
+	switch cfg.cgroupPath {
+	case "":
+		if err := cmd.Start(); err != nil {
+			return nil, err
+		}
+	default:
+		if err := startInCgroup(cmd, cfg.cgroupPath); err != nil {
+			return nil, err
+		}
 	}
 
 	n := &naked{
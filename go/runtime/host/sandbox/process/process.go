@@ -42,7 +42,12 @@ type Config struct {
 	// SandboxBinaryPath is the path to the sandbox support binary.
 	SandboxBinaryPath string
 
+	// Cgroup is the optional cgroup v2 configuration used to limit the resources of the sandbox.
+	// It is only supported by the Bubblewrap-based sandbox.
+	Cgroup *CgroupConfig
+
 	extraFiles []*os.File
+	cgroupPath string
 }
 
 // Process is a sandboxed process.
@@ -0,0 +1,101 @@
+package process
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs"
+)
+
+// Stats are the resource usage statistics of a sandboxed process and all of its descendants.
+type Stats struct {
+	// NumProcesses is the number of processes.
+	NumProcesses uint64
+
+	// CPUSeconds is the CPU time spent in user and kernel mode (in seconds).
+	CPUSeconds float64
+
+	// RSSBytes is the resident set size (in bytes).
+	RSSBytes uint64
+
+	// IOReadBytes is the number of bytes read from the storage layer.
+	IOReadBytes uint64
+
+	// IOWriteBytes is the number of bytes written to the storage layer.
+	IOWriteBytes uint64
+}
+
+// childPIDs returns the PIDs of the children of all threads of the process with the given PID.
+//
+// This requires the kernel to expose /proc/<PID>/task/<TID>/children, which is the case when it
+// has been built with CONFIG_PROC_CHILDREN (the default in most distributions).
+func childPIDs(pid int) ([]int, error) {
+	taskDir := filepath.Join(procfs.DefaultMountPoint, strconv.Itoa(pid), "task")
+	tasks, err := os.ReadDir(taskDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var children []int
+	for _, task := range tasks {
+		data, err := os.ReadFile(filepath.Join(taskDir, task.Name(), "children"))
+		if err != nil {
+			// Thread may have terminated in the meantime.
+			continue
+		}
+		for _, field := range strings.Fields(string(data)) {
+			child, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, fmt.Errorf("malformed child PID '%s': %w", field, err)
+			}
+			children = append(children, child)
+		}
+	}
+	return children, nil
+}
+
+// ReadStats reads the resource usage statistics of the process with the given PID and all of its
+// descendants from /proc.
+func ReadStats(pid int) (*Stats, error) {
+	var result Stats
+	pending := []int{pid}
+	for len(pending) > 0 {
+		p := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		proc, err := procfs.NewProc(p)
+		if err != nil {
+			if p == pid {
+				return nil, fmt.Errorf("process %d not found: %w", pid, err)
+			}
+			// Process may have terminated in the meantime.
+			continue
+		}
+		st, err := proc.Stat()
+		if err != nil {
+			if p == pid {
+				return nil, fmt.Errorf("failed to read stats of process %d: %w", pid, err)
+			}
+			continue
+		}
+		result.NumProcesses++
+		result.CPUSeconds += st.CPUTime()
+		result.RSSBytes += uint64(st.ResidentMemory())
+
+		// I/O accounting may not be available (e.g., due to missing permissions).
+		if io, ierr := proc.IO(); ierr == nil {
+			result.IOReadBytes += io.ReadBytes
+			result.IOWriteBytes += io.WriteBytes
+		}
+
+		children, err := childPIDs(p)
+		if err != nil {
+			continue
+		}
+		pending = append(pending, children...)
+	}
+	return &result, nil
+}
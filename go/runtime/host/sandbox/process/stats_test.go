@@ -0,0 +1,37 @@
+//go:build linux
+// +build linux
+
+package process
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadStats(t *testing.T) {
+	require := require.New(t)
+
+	st, err := ReadStats(os.Getpid())
+	require.NoError(err, "ReadStats")
+	require.GreaterOrEqual(st.NumProcesses, uint64(1), "at least the current process should be accounted")
+	require.NotZero(st.RSSBytes, "resident set size should be reported")
+
+	// Descendants should also be accounted for.
+	cmd := exec.Command("sleep", "10")
+	err = cmd.Start()
+	require.NoError(err, "Start")
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	childSt, err := ReadStats(os.Getpid())
+	require.NoError(err, "ReadStats")
+	require.Equal(st.NumProcesses+1, childSt.NumProcesses, "child process should be accounted")
+
+	_, err = ReadStats(-1)
+	require.Error(err, "ReadStats should fail for a non-existent process")
+}
@@ -28,6 +28,7 @@ const (
 	runtimeExtendedInitTimeout = 120 * time.Second
 	runtimeInterruptTimeout    = 1 * time.Second
 	resetTickerTimeout         = 15 * time.Minute
+	resourceLimitsInterval     = 5 * time.Second
 
 	bindHostSocketPath = "/host.sock"
 
@@ -56,6 +57,14 @@ type Config struct {
 
 	// InsecureNoSandbox disables the sandbox and runs the runtime binary directly.
 	InsecureNoSandbox bool
+
+	// CgroupRoot is the path to a delegated cgroup v2 subtree under which per-runtime cgroups are
+	// created in order to enforce runtime resource limits. It is required in case any resource
+	// limits are configured.
+	//
+	// As controllers can only be enabled in cgroups without processes, the node itself must not
+	// run in this cgroup (it can be placed in a separate leaf cgroup instead).
+	CgroupRoot string
 }
 
 type provisioner struct {
@@ -66,6 +75,15 @@ type provisioner struct {
 func (p *provisioner) NewRuntime(ctx context.Context, cfg host.Config) (host.Runtime, error) {
 	id := cfg.Bundle.Manifest.ID
 
+	if cfg.Resources != nil {
+		switch {
+		case p.cfg.InsecureNoSandbox:
+			return nil, fmt.Errorf("runtime resource limits are not supported without a sandbox")
+		case p.cfg.CgroupRoot == "":
+			return nil, fmt.Errorf("runtime resource limits require a cgroup root to be configured")
+		}
+	}
+
 	r := &sandboxedRuntime{
 		cfg:      p.cfg,
 		rtCfg:    cfg,
@@ -103,6 +121,9 @@ type sandboxedRuntime struct {
 	conn     protocol.Connection
 	notifier *pubsub.Broker
 
+	cgroup       *process.CgroupConfig
+	cgroupEvents process.CgroupEvents
+
 	logger *logging.Logger
 }
 
@@ -260,6 +281,7 @@ func (r *sandboxedRuntime) startProcess() (err error) {
 			cfg.BindRW = make(map[string]string)
 		}
 		cfg.BindRW[hostSocket] = bindHostSocketPath
+		cfg.Cgroup = r.cgroupConfig()
 
 		p, err = process.NewBubbleWrap(cfg)
 		if err != nil {
@@ -365,8 +387,10 @@ func (r *sandboxedRuntime) startProcess() (err error) {
 	}
 
 	ok = true
+	r.Lock()
 	r.process = p
 	r.conn = pc
+	r.Unlock()
 
 	// Notify subscribers that a runtime has been started.
 	r.notifier.Broadcast(&host.Event{Started: ev})
@@ -420,6 +444,14 @@ func (r *sandboxedRuntime) handleAbortRequest(rq *abortRequest) error {
 func (r *sandboxedRuntime) manager() {
 	var ticker *backoff.Ticker
 
+	// Periodically check whether any resource limits have been hit.
+	var limitsCh <-chan time.Time
+	if r.rtCfg.Resources != nil {
+		limitsTicker := time.NewTicker(resourceLimitsInterval)
+		defer limitsTicker.Stop()
+		limitsCh = limitsTicker.C
+	}
+
 	defer func() {
 		r.logger.Warn("terminating runtime")
 
@@ -431,12 +463,19 @@ func (r *sandboxedRuntime) manager() {
 			r.conn.Close()
 			r.process.Kill()
 			<-r.process.Wait()
-			r.process = nil
 
 			r.Lock()
+			r.process = nil
 			r.conn = nil
 			r.Unlock()
 		}
+		if r.cgroup != nil {
+			if err := process.RemoveCgroup(r.cgroup.Path); err != nil {
+				r.logger.Warn("failed to remove runtime cgroup",
+					"err", err,
+				)
+			}
+		}
 
 		// Notify subscribers that the runtime has stopped.
 		r.notifier.Broadcast(&host.Event{Stopped: &host.StoppedEvent{}})
@@ -503,12 +542,17 @@ func (r *sandboxedRuntime) manager() {
 		case <-r.stopCh:
 			r.logger.Warn("termination requested")
 			return
+		case <-limitsCh:
+			r.checkResourceLimits()
 		case <-r.process.Wait():
 			// Process has terminated.
 			r.logger.Error("runtime process has terminated unexpectedly",
 				"err", r.process.Error(),
 			)
 
+			// Make sure to report any resource limits that may have caused the termination.
+			r.checkResourceLimits()
+
 			r.Lock()
 			r.conn.Close()
 			r.process = nil
@@ -528,8 +572,73 @@ func (r *sandboxedRuntime) manager() {
 	}
 }
 
+// cgroupConfig returns the cgroup configuration for enforcing the runtime's resource limits or
+// nil in case no limits are configured.
+func (r *sandboxedRuntime) cgroupConfig() *process.CgroupConfig {
+	if r.cgroup != nil || r.rtCfg.Resources == nil {
+		return r.cgroup
+	}
+
+	rl := r.rtCfg.Resources
+	r.cgroup = &process.CgroupConfig{
+		Path:      filepath.Join(r.cfg.CgroupRoot, fmt.Sprintf("%s-%s", r.id, r.rtCfg.Bundle.Manifest.Version)),
+		MemoryMax: rl.MemoryMax,
+		CPUWeight: rl.CPUWeight,
+		CPUQuota:  rl.CPUQuota,
+		PidsMax:   rl.PidsMax,
+	}
+	return r.cgroup
+}
+
+// checkResourceLimits emits events for all resource limits that have been hit since the last
+// check.
+func (r *sandboxedRuntime) checkResourceLimits() {
+	if r.cgroup == nil {
+		return
+	}
+
+	ev, err := process.ReadCgroupEvents(r.cgroup.Path)
+	if err != nil {
+		r.logger.Error("failed to read runtime cgroup events",
+			"err", err,
+		)
+		return
+	}
+
+	for _, limit := range []struct {
+		resource host.Resource
+		current  uint64
+		last     uint64
+	}{
+		{host.ResourceMemory, ev.MemoryMax, r.cgroupEvents.MemoryMax},
+		{host.ResourceCPU, ev.CPUThrottled, r.cgroupEvents.CPUThrottled},
+		{host.ResourcePids, ev.PidsMax, r.cgroupEvents.PidsMax},
+	} {
+		if limit.current <= limit.last {
+			continue
+		}
+		count := limit.current - limit.last
+
+		r.logger.Warn("runtime resource limit hit",
+			"resource", limit.resource,
+			"count", count,
+		)
+		resourceLimitHits.With(r.getMetricLabels(limit.resource)).Add(float64(count))
+
+		r.notifier.Broadcast(&host.Event{
+			ResourceLimitHit: &host.ResourceLimitHitEvent{
+				Resource: limit.resource,
+				Count:    count,
+			},
+		})
+	}
+	r.cgroupEvents = *ev
+}
+
 // New creates a new runtime provisioner that uses a local process sandbox.
 func New(cfg Config) (host.Provisioner, error) {
+	initMetrics()
+
 	// Use a default Logger if none was provided.
 	if cfg.Logger == nil {
 		cfg.Logger = logging.GetLogger("runtime/host/sandbox")
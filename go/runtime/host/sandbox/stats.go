@@ -0,0 +1,36 @@
+package sandbox
+
+import (
+	"github.com/oasisprotocol/oasis-core/go/runtime/host"
+	"github.com/oasisprotocol/oasis-core/go/runtime/host/sandbox/process"
+)
+
+// Implements host.Runtime.
+func (r *sandboxedRuntime) GetProcessStats() []*host.ProcessStats {
+	r.RLock()
+	p := r.process
+	r.RUnlock()
+
+	if p == nil {
+		return nil
+	}
+
+	st, err := process.ReadStats(p.GetPID())
+	if err != nil {
+		// Process may have terminated in the meantime.
+		r.logger.Debug("failed to read runtime process stats",
+			"err", err,
+			"pid", p.GetPID(),
+		)
+		return nil
+	}
+
+	return []*host.ProcessStats{{
+		Version:      r.rtCfg.Bundle.Manifest.Version,
+		NumProcesses: st.NumProcesses,
+		CPUSeconds:   st.CPUSeconds,
+		RSSBytes:     st.RSSBytes,
+		IOReadBytes:  st.IOReadBytes,
+		IOWriteBytes: st.IOWriteBytes,
+	}}
+}
@@ -68,6 +68,10 @@ type Config struct {
 
 	// InsecureNoSandbox disables the sandbox and runs the loader directly.
 	InsecureNoSandbox bool
+
+	// CgroupRoot is the path to a delegated cgroup v2 subtree under which per-runtime cgroups are
+	// created in order to enforce runtime resource limits.
+	CgroupRoot string
 }
 
 // RuntimeExtra is the extra configuration for SGX runtimes.
@@ -409,6 +413,7 @@ func New(cfg Config) (host.Provisioner, error) {
 		HostInfo:          cfg.HostInfo,
 		HostInitializer:   s.hostInitializer,
 		InsecureNoSandbox: cfg.InsecureNoSandbox,
+		CgroupRoot:        cfg.CgroupRoot,
 		Logger:            s.logger,
 	})
 	if err != nil {
@@ -0,0 +1,352 @@
+package registry
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	"github.com/oasisprotocol/oasis-core/go/common/node"
+	"github.com/oasisprotocol/oasis-core/go/common/sgx"
+	"github.com/oasisprotocol/oasis-core/go/common/sgx/sigstruct"
+	"github.com/oasisprotocol/oasis-core/go/common/version"
+	registry "github.com/oasisprotocol/oasis-core/go/registry/api"
+	"github.com/oasisprotocol/oasis-core/go/runtime/bundle"
+	workerCommon "github.com/oasisprotocol/oasis-core/go/worker/common/api"
+)
+
+const (
+	// maxBundleSize is the maximum size of a runtime bundle fetched from a remote repository.
+	maxBundleSize = 512 * 1024 * 1024
+
+	// bundleFetchTimeout is the maximum time to spend fetching a single runtime bundle.
+	bundleFetchTimeout = 10 * time.Minute
+)
+
+// StagedBundlesPath returns the path under the data directory that contains all of the runtime
+// bundles fetched from a remote repository.
+func StagedBundlesPath(dataDir string) string {
+	return filepath.Join(dataDir, "runtimes", "staged")
+}
+
+func (r *runtime) StagedBundles() []workerCommon.BundleStatus {
+	r.RLock()
+	defer r.RUnlock()
+
+	var bundles []workerCommon.BundleStatus
+	for _, status := range r.stagedBundles {
+		bundles = append(bundles, *status)
+	}
+	return bundles
+}
+
+func (r *runtime) hasHostVersion(v version.Version) bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	_, ok := r.hostConfig[v]
+	return ok
+}
+
+func (r *runtime) watchBundles(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+		return
+	case <-r.consensus.Synced():
+	}
+
+	// Subscribe to epoch transitions so that failed fetches are periodically retried.
+	epoCh, sub, err := r.consensus.Beacon().WatchEpochs(ctx)
+	if err != nil {
+		r.logger.Error("failed to watch epochs",
+			"err", err,
+		)
+		return
+	}
+	defer sub.Close()
+
+	// Subscribe to registry descriptor updates to learn about new deployments.
+	rtCh, rtSub, err := r.WatchRegistryDescriptor()
+	if err != nil {
+		r.logger.Error("failed to watch registry descriptor updates",
+			"err", err,
+		)
+		return
+	}
+	defer rtSub.Close()
+
+	var (
+		epoch     beacon.EpochTime
+		haveEpoch bool
+		rt        *registry.Runtime
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case epoch = <-epoCh:
+			haveEpoch = true
+		case rt = <-rtCh:
+		}
+		if !haveEpoch || rt == nil {
+			continue
+		}
+
+		r.stageBundles(ctx, rt, epoch)
+	}
+}
+
+// stageBundles fetches, verifies and stages runtime bundles for all upcoming deployments that
+// are not yet supported by the local runtime host configuration.
+func (r *runtime) stageBundles(ctx context.Context, rt *registry.Runtime, epoch beacon.EpochTime) {
+	// Key manager runtimes only support a single configured version.
+	if rt.Kind != registry.KindCompute {
+		return
+	}
+
+	activeDeploy := rt.ActiveDeployment(epoch)
+	for _, deployment := range rt.Deployments {
+		// Skip any old versions that will never be active again.
+		if activeDeploy != nil && deployment.Version.ToU64() < activeDeploy.Version.ToU64() {
+			continue
+		}
+		if r.hasHostVersion(deployment.Version) {
+			continue
+		}
+
+		logger := r.logger.With("version", deployment.Version, "valid_from", deployment.ValidFrom)
+		logger.Info("fetching runtime bundle for deployment")
+
+		status := &workerCommon.BundleStatus{
+			Version:   deployment.Version,
+			ValidFrom: deployment.ValidFrom,
+		}
+		if err := r.stageBundle(ctx, rt, deployment); err != nil {
+			logger.Error("failed to stage runtime bundle",
+				"err", err,
+			)
+			status.Error = err.Error()
+		} else {
+			logger.Info("runtime bundle staged")
+			status.Ready = true
+		}
+
+		r.Lock()
+		r.stagedBundles[deployment.Version] = status
+		r.Unlock()
+	}
+}
+
+func (r *runtime) stageBundle(ctx context.Context, rt *registry.Runtime, deployment *registry.VersionInfo) (rerr error) {
+	if err := checkBundleVerifiable(rt, r.hostCfg.TrustedPublishers, r.hostCfg.forceNoSGX); err != nil {
+		return err
+	}
+
+	fn, remote, err := r.fetchBundle(ctx, deployment.Version)
+	if err != nil {
+		return err
+	}
+	if remote {
+		// Make sure that a bad bundle is fetched again on the next attempt.
+		defer func() {
+			if rerr != nil {
+				_ = os.Remove(fn)
+			}
+		}()
+	}
+
+	// Open the bundle. This will call Validate() and verify the publisher.
+	bnd, err := bundle.Open(fn, bundle.WithTrustedPublishers(r.hostCfg.TrustedPublishers))
+	if err != nil {
+		return fmt.Errorf("failed to load runtime bundle '%s': %w", fn, err)
+	}
+	defer func() {
+		if rerr != nil {
+			_ = bnd.Close()
+		}
+	}()
+
+	if !bnd.Manifest.ID.Equal(&r.id) {
+		return fmt.Errorf("runtime bundle has wrong runtime ID (expected: %s got: %s)", r.id, bnd.Manifest.ID)
+	}
+	if bnd.Manifest.Version != deployment.Version {
+		return fmt.Errorf("runtime bundle has wrong version (expected: %s got: %s)", deployment.Version, bnd.Manifest.Version)
+	}
+	if err = verifyBundleIdentity(rt, deployment, bnd); err != nil {
+		return err
+	}
+
+	if err = bnd.WriteExploded(r.hostDataDir); err != nil {
+		return fmt.Errorf("failed to explode runtime bundle '%s': %w", fn, err)
+	}
+
+	cfg, err := r.hostCfg.newRuntimeHostConfig(r.hostDataDir, bnd)
+	if err != nil {
+		return err
+	}
+
+	r.Lock()
+	r.hostConfig[deployment.Version] = cfg
+	r.Unlock()
+
+	return nil
+}
+
+// fetchBundle returns the path to the runtime bundle for the given version, fetching it from a
+// remote repository if needed.
+func (r *runtime) fetchBundle(ctx context.Context, v version.Version) (string, bool, error) {
+	repository := r.hostCfg.BundleRepository
+	name := fmt.Sprintf("%s-%s.orc", r.id, v)
+
+	u, err := bundleRepositoryURL(repository)
+	if err != nil {
+		return "", false, err
+	}
+	if u == nil {
+		// Not an URL, treat the repository as a local directory.
+		return filepath.Join(repository, name), false, nil
+	}
+
+	stagedDir := StagedBundlesPath(r.hostDataDir)
+	if err = os.MkdirAll(stagedDir, 0o700); err != nil {
+		return "", false, fmt.Errorf("failed to create staged bundle dir '%s': %w", stagedDir, err)
+	}
+	fn := filepath.Join(stagedDir, name)
+	if _, err = os.Stat(fn); err == nil {
+		// Bundle has already been fetched before.
+		return fn, true, nil
+	}
+
+	fetchCtx, cancel := context.WithTimeout(ctx, bundleFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(fetchCtx, http.MethodGet, u.JoinPath(name).String(), nil)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create request: %w", err)
+	}
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to fetch runtime bundle: %w", err)
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return "", false, fmt.Errorf("failed to fetch runtime bundle: %s", rsp.Status)
+	}
+
+	// Write to a temporary file first so that interrupted downloads are never used.
+	f, err := os.CreateTemp(stagedDir, name+".*.tmp")
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	n, err := io.Copy(f, io.LimitReader(rsp.Body, maxBundleSize+1))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	switch {
+	case err != nil:
+		return "", false, fmt.Errorf("failed to write runtime bundle: %w", err)
+	case n > maxBundleSize:
+		return "", false, fmt.Errorf("runtime bundle too large (max: %d bytes)", maxBundleSize)
+	}
+
+	if err = os.Rename(f.Name(), fn); err != nil {
+		return "", false, fmt.Errorf("failed to stage runtime bundle: %w", err)
+	}
+	return fn, true, nil
+}
+
+// bundleRepositoryURL parses the given bundle repository and returns its URL. In case the
+// repository is a local directory, nil is returned.
+//
+// Only HTTPS URLs are allowed for remote repositories.
+func bundleRepositoryURL(repository string) (*url.URL, error) {
+	u, err := url.Parse(repository)
+	if err != nil || u.Scheme == "" {
+		return nil, nil
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported bundle repository URL scheme '%s' (only https is allowed)", u.Scheme)
+	}
+	return u, nil
+}
+
+// checkBundleVerifiable checks whether automatically fetched runtime bundles for the given runtime
+// can be verified before they are used.
+//
+// In case forceNoSGX is set, the bundle's ELF executable is used even for SGX runtimes.
+func checkBundleVerifiable(rt *registry.Runtime, trustedPublishers []signature.PublicKey, forceNoSGX bool) error {
+	switch rt.TEEHardware {
+	case node.TEEHardwareInvalid:
+		// Without a TEE the deployment descriptor does not contain anything that the bundle could
+		// be verified against, so require the bundle to be signed by a trusted publisher.
+		if len(trustedPublishers) == 0 {
+			return fmt.Errorf("refusing to fetch runtime bundle for non-TEE runtime without trusted publishers")
+		}
+		return nil
+	case node.TEEHardwareIntelSGX:
+		// The enclave identity is verified against the deployment descriptor. This only covers
+		// the SGX executable, so require the bundle to be signed by a trusted publisher in case
+		// the ELF executable is used instead.
+		if forceNoSGX && len(trustedPublishers) == 0 {
+			return fmt.Errorf("refusing to fetch runtime bundle for SGX runtime running outside SGX without trusted publishers")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported TEE hardware '%s'", rt.TEEHardware)
+	}
+}
+
+// verifyBundleIdentity verifies that the enclave identity of the given runtime bundle is allowed
+// by the given deployment.
+func verifyBundleIdentity(rt *registry.Runtime, deployment *registry.VersionInfo, bnd *bundle.Bundle) error {
+	switch rt.TEEHardware {
+	case node.TEEHardwareInvalid:
+		// The bundle publisher has already been verified when opening the bundle.
+		return nil
+	case node.TEEHardwareIntelSGX:
+	default:
+		return fmt.Errorf("unsupported TEE hardware '%s'", rt.TEEHardware)
+	}
+
+	var cs node.SGXConstraints
+	if err := cbor.Unmarshal(deployment.TEE, &cs); err != nil {
+		return fmt.Errorf("malformed SGX constraints: %w", err)
+	}
+
+	mrEnclave, err := bnd.MrEnclave()
+	if err != nil {
+		return err
+	}
+	var mrSigner *sgx.MrSigner
+	if bnd.Manifest.SGX.Signature != "" {
+		var pk *rsa.PublicKey
+		if pk, _, err = sigstruct.Verify(bnd.Data[bnd.Manifest.SGX.Signature]); err != nil {
+			return fmt.Errorf("failed to verify sigstruct: %w", err)
+		}
+		mrSigner = new(sgx.MrSigner)
+		if err = mrSigner.FromPublicKey(pk); err != nil {
+			return fmt.Errorf("failed to derive MRSIGNER: %w", err)
+		}
+	}
+
+	for _, eid := range cs.Enclaves {
+		if eid.MrEnclave != *mrEnclave {
+			continue
+		}
+		if mrSigner != nil && eid.MrSigner != *mrSigner {
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("runtime bundle enclave identity (MRENCLAVE: %s) not allowed by deployment", mrEnclave)
+}
@@ -0,0 +1,103 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	memorySigner "github.com/oasisprotocol/oasis-core/go/common/crypto/signature/signers/memory"
+	"github.com/oasisprotocol/oasis-core/go/common/node"
+	"github.com/oasisprotocol/oasis-core/go/common/sgx"
+	registry "github.com/oasisprotocol/oasis-core/go/registry/api"
+	"github.com/oasisprotocol/oasis-core/go/runtime/bundle"
+)
+
+func TestBundleRepositoryURL(t *testing.T) {
+	require := require.New(t)
+
+	u, err := bundleRepositoryURL("https://example.com/bundles")
+	require.NoError(err, "https repository should be allowed")
+	require.NotNil(u, "https repository should be remote")
+	require.Equal("https://example.com/bundles/foo.orc", u.JoinPath("foo.orc").String())
+
+	u, err = bundleRepositoryURL("/var/lib/oasis/bundles")
+	require.NoError(err, "local repository should be allowed")
+	require.Nil(u, "local repository should not be remote")
+
+	_, err = bundleRepositoryURL("http://example.com/bundles")
+	require.Error(err, "http repository should be rejected")
+
+	_, err = bundleRepositoryURL("ftp://example.com/bundles")
+	require.Error(err, "ftp repository should be rejected")
+}
+
+func TestCheckBundleVerifiable(t *testing.T) {
+	require := require.New(t)
+
+	publisher := memorySigner.NewTestSigner("bundle fetcher test publisher")
+	trustedPublishers := []signature.PublicKey{publisher.Public()}
+
+	rt := &registry.Runtime{TEEHardware: node.TEEHardwareInvalid}
+	err := checkBundleVerifiable(rt, nil, false)
+	require.Error(err, "non-TEE runtime without trusted publishers should be rejected")
+	err = checkBundleVerifiable(rt, trustedPublishers, false)
+	require.NoError(err, "non-TEE runtime with trusted publishers should be allowed")
+
+	rt.TEEHardware = node.TEEHardwareIntelSGX
+	err = checkBundleVerifiable(rt, nil, false)
+	require.NoError(err, "SGX runtime should be allowed without trusted publishers")
+
+	// SGX runtimes running outside SGX use the unverified ELF executable.
+	err = checkBundleVerifiable(rt, nil, true)
+	require.Error(err, "SGX runtime running outside SGX without trusted publishers should be rejected")
+	err = checkBundleVerifiable(rt, trustedPublishers, true)
+	require.NoError(err, "SGX runtime running outside SGX with trusted publishers should be allowed")
+
+	rt.TEEHardware = node.TEEHardwareReserved
+	err = checkBundleVerifiable(rt, trustedPublishers, false)
+	require.Error(err, "unsupported TEE hardware should be rejected")
+}
+
+func TestVerifyBundleIdentity(t *testing.T) {
+	require := require.New(t)
+
+	sgxs := []byte("not really an sgxs file")
+	bnd := &bundle.Bundle{
+		Manifest: &bundle.Manifest{
+			Name:       "test-runtime",
+			Executable: "runtime.bin",
+			SGX: &bundle.SGXMetadata{
+				Executable: "runtime.sgx",
+			},
+		},
+	}
+	err := bnd.Add(bnd.Manifest.SGX.Executable, sgxs)
+	require.NoError(err, "bundle.Add")
+
+	var mrEnclave, otherMrEnclave sgx.MrEnclave
+	err = mrEnclave.FromSgxsBytes(sgxs)
+	require.NoError(err, "FromSgxsBytes")
+	err = otherMrEnclave.FromSgxsBytes([]byte("another enclave"))
+	require.NoError(err, "FromSgxsBytes")
+
+	rt := &registry.Runtime{TEEHardware: node.TEEHardwareIntelSGX}
+	deploymentFor := func(mrEnclave sgx.MrEnclave) *registry.VersionInfo {
+		return &registry.VersionInfo{
+			TEE: cbor.Marshal(node.SGXConstraints{
+				Versioned: cbor.NewVersioned(node.LatestSGXConstraintsVersion),
+				Enclaves:  []sgx.EnclaveIdentity{{MrEnclave: mrEnclave}},
+			}),
+		}
+	}
+
+	err = verifyBundleIdentity(rt, deploymentFor(mrEnclave), bnd)
+	require.NoError(err, "enclave identity allowed by deployment should be accepted")
+
+	err = verifyBundleIdentity(rt, deploymentFor(otherMrEnclave), bnd)
+	require.Error(err, "enclave identity not allowed by deployment should be rejected")
+
+	err = verifyBundleIdentity(rt, &registry.VersionInfo{TEE: []byte("malformed")}, bnd)
+	require.Error(err, "malformed SGX constraints should be rejected")
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/oasisprotocol/oasis-core/go/common"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
 	"github.com/oasisprotocol/oasis-core/go/common/node"
 	"github.com/oasisprotocol/oasis-core/go/common/sgx/pcs"
 	"github.com/oasisprotocol/oasis-core/go/common/version"
@@ -35,8 +36,22 @@ const (
 	//
 	// The value should be a vector of slices to the runtime bundles.
 	CfgRuntimePaths = "runtime.paths"
+	// CfgRuntimeTrustedPublishers configures the public keys of trusted runtime bundle publishers.
+	//
+	// If set, only runtime bundles with a manifest signed by one of the trusted publishers are
+	// accepted.
+	CfgRuntimeTrustedPublishers = "runtime.trusted_publishers"
+	// CfgRuntimeBundleRepository configures the repository URL or local directory from which
+	// runtime bundles for upcoming deployments are automatically fetched.
+	//
+	// Bundles are expected to be named <runtime-id>-<version>.orc.
+	CfgRuntimeBundleRepository = "runtime.bundle_repository"
 	// CfgSandboxBinary configures the runtime sandbox binary location.
 	CfgSandboxBinary = "runtime.sandbox.binary"
+	// CfgSandboxCgroupRoot configures the delegated cgroup v2 subtree under which per-runtime
+	// cgroups are created in order to enforce runtime resource limits. The node itself must not
+	// run in this cgroup.
+	CfgSandboxCgroupRoot = "runtime.sandbox.cgroup_root"
 	// CfgRuntimeEnvironment sets the runtime environment. Setting an environment that does not
 	// agree with the runtime descriptor or system hardware will cause an error.
 	CfgRuntimeEnvironment = "runtime.environment"
@@ -47,6 +62,10 @@ const (
 
 	// CfgRuntimeConfig configures node-local runtime configuration.
 	CfgRuntimeConfig = "runtime.config"
+	// CfgRuntimeResources configures per-runtime resource limits.
+	//
+	// Limits are enforced by the sandboxed provisioner and require a cgroup root to be configured.
+	CfgRuntimeResources = "runtime.resources"
 
 	// CfgHistoryPrunerStrategy configures the history pruner strategy.
 	CfgHistoryPrunerStrategy = "runtime.history.pruner.strategy"
@@ -55,6 +74,9 @@ const (
 	// CfgHistoryPrunerKeepLastNum configures the number of last kept
 	// rounds when using the "keep last" pruner strategy.
 	CfgHistoryPrunerKeepLastNum = "runtime.history.pruner.num_kept"
+	// CfgHistoryPrunerKeepDuration configures the duration of kept history
+	// when using the "keep duration" or "keep hybrid" pruner strategies.
+	CfgHistoryPrunerKeepDuration = "runtime.history.pruner.keep_duration"
 
 	// CfgRuntimeMode configures how the runtime workers should behave on this node.
 	CfgRuntimeMode = "runtime.mode"
@@ -185,6 +207,79 @@ type RuntimeHostConfig struct {
 	// Runtimes contains per-runtime provisioning configuration. Some fields may be omitted as they
 	// are provided when the runtime is provisioned.
 	Runtimes map[common.Namespace]map[version.Version]*runtimeHost.Config
+
+	// TrustedPublishers contains the public keys of trusted runtime bundle publishers. If empty,
+	// unsigned runtime bundles are accepted.
+	TrustedPublishers []signature.PublicKey
+
+	// BundleRepository is the optional repository URL or local directory from which runtime
+	// bundles for upcoming deployments are automatically fetched. Remote repositories must use
+	// HTTPS and bundles for non-TEE runtimes (or SGX runtimes running outside SGX) are only
+	// fetched when trusted publishers are configured.
+	BundleRepository string
+
+	forceNoSGX bool
+}
+
+// newRuntimeHostConfig creates the runtime host configuration for the given exploded runtime
+// bundle.
+func (rh *RuntimeHostConfig) newRuntimeHostConfig(dataDir string, bnd *bundle.Bundle) (*runtimeHost.Config, error) {
+	id := bnd.Manifest.ID
+
+	// Unmarshal any local runtime configuration.
+	var localConfig map[string]interface{}
+	if sub := viper.Sub(CfgRuntimeConfig); sub != nil {
+		if err := sub.UnmarshalKey(id.String(), &localConfig); err != nil {
+			return nil, fmt.Errorf("bad runtime configuration: %w", err)
+		}
+	}
+
+	// Unmarshal any runtime resource limits.
+	var resources *runtimeHost.ResourceLimits
+	if sub := viper.Sub(CfgRuntimeResources); sub != nil && sub.IsSet(id.String()) {
+		resources = new(runtimeHost.ResourceLimits)
+		if err := sub.UnmarshalKey(id.String(), resources); err != nil {
+			return nil, fmt.Errorf("bad runtime resource limits: %w", err)
+		}
+		if err := resources.Validate(); err != nil {
+			return nil, fmt.Errorf("bad runtime resource limits: %w", err)
+		}
+	}
+
+	runtimeHostCfg := &runtimeHost.Config{
+		Bundle: &runtimeHost.RuntimeBundle{
+			Bundle: bnd,
+			Path:   bnd.ExplodedPath(dataDir, bnd.Manifest.Executable),
+		},
+		LocalConfig: localConfig,
+		Resources:   resources,
+	}
+
+	var haveSGXSignature bool
+	if !rh.forceNoSGX && bnd.Manifest.SGX != nil {
+		// Ensure SGX provisioner is configured.
+		if _, ok := rh.Provisioners[node.TEEHardwareIntelSGX]; !ok {
+			return nil, fmt.Errorf("SGX loader binary path is not configured")
+		}
+
+		// If this is a TEE enclave, override the executable to point
+		// at the enclave binary instead.
+		runtimeHostCfg.Bundle.Path = bnd.ExplodedPath(dataDir, bnd.Manifest.SGX.Executable)
+		if bnd.Manifest.SGX.Signature != "" {
+			haveSGXSignature = true
+			runtimeHostCfg.Extra = &hostSgx.RuntimeExtra{
+				SignaturePath: bnd.ExplodedPath(dataDir, bnd.Manifest.SGX.Signature),
+			}
+		}
+	}
+	if !haveSGXSignature {
+		// HACK HACK HACK: Allow dummy SIGSTRUCT generation.
+		runtimeHostCfg.Extra = &hostSgx.RuntimeExtra{
+			UnsafeDebugGenerateSigstruct: true,
+		}
+	}
+
+	return runtimeHostCfg, nil
 }
 
 func newConfig(dataDir string, consensus consensus.Backend, ias ias.Endpoint) (*RuntimeConfig, error) { //nolint: gocyclo
@@ -215,7 +310,13 @@ func newConfig(dataDir string, consensus consensus.Backend, ias ias.Endpoint) (*
 		forceNoSGX := (cfg.Mode.IsClientOnly() && runtimeEnv != RuntimeEnvironmentSGX) ||
 			(cmdFlags.DebugDontBlameOasis() && runtimeEnv == RuntimeEnvironmentELF)
 
-		var rh RuntimeHostConfig
+		rh := RuntimeHostConfig{
+			BundleRepository: viper.GetString(CfgRuntimeBundleRepository),
+			forceNoSGX:       forceNoSGX,
+		}
+		if _, err := bundleRepositoryURL(rh.BundleRepository); err != nil {
+			return nil, err
+		}
 
 		// Configure host environment information.
 		cs, err := consensus.GetStatus(context.Background())
@@ -235,6 +336,7 @@ func newConfig(dataDir string, consensus consensus.Backend, ias ias.Endpoint) (*
 		// Register provisioners based on the configured provisioner.
 		var insecureNoSandbox bool
 		sandboxBinary := viper.GetString(CfgSandboxBinary)
+		cgroupRoot := viper.GetString(CfgSandboxCgroupRoot)
 		rh.Provisioners = make(map[node.TEEHardware]runtimeHost.Provisioner)
 		switch p := viper.GetString(CfgRuntimeProvisioner); p {
 		case RuntimeProvisionerMock:
@@ -266,6 +368,7 @@ func newConfig(dataDir string, consensus consensus.Backend, ias ias.Endpoint) (*
 				HostInfo:          hostInfo,
 				InsecureNoSandbox: insecureNoSandbox,
 				SandboxBinaryPath: sandboxBinary,
+				CgroupRoot:        cgroupRoot,
 			})
 			if err != nil {
 				return nil, fmt.Errorf("failed to create runtime provisioner: %w", err)
@@ -279,6 +382,7 @@ func newConfig(dataDir string, consensus consensus.Backend, ias ias.Endpoint) (*
 					HostInfo:          hostInfo,
 					InsecureNoSandbox: insecureNoSandbox,
 					SandboxBinaryPath: sandboxBinary,
+					CgroupRoot:        cgroupRoot,
 				})
 				if err != nil {
 					return nil, fmt.Errorf("failed to create runtime provisioner: %w", err)
@@ -307,6 +411,7 @@ func newConfig(dataDir string, consensus consensus.Backend, ias ias.Endpoint) (*
 					Consensus:         consensus,
 					SandboxBinaryPath: sandboxBinary,
 					InsecureNoSandbox: insecureNoSandbox,
+					CgroupRoot:        cgroupRoot,
 				})
 				if err != nil {
 					return nil, fmt.Errorf("failed to create SGX runtime provisioner: %w", err)
@@ -316,12 +421,21 @@ func newConfig(dataDir string, consensus consensus.Backend, ias ias.Endpoint) (*
 			return nil, fmt.Errorf("unsupported runtime provisioner: %s", p)
 		}
 
+		// Configure trusted runtime bundle publishers.
+		for _, rawPk := range viper.GetStringSlice(CfgRuntimeTrustedPublishers) {
+			var pk signature.PublicKey
+			if err = pk.UnmarshalText([]byte(rawPk)); err != nil {
+				return nil, fmt.Errorf("malformed trusted publisher public key '%s': %w", rawPk, err)
+			}
+			rh.TrustedPublishers = append(rh.TrustedPublishers, pk)
+		}
+
 		// Configure runtimes.
 		rh.Runtimes = make(map[common.Namespace]map[version.Version]*runtimeHost.Config)
 		for _, path := range viper.GetStringSlice(CfgRuntimePaths) {
-			// Open and explode the bundle.  This will call Validate().
+			// Open and explode the bundle.  This will call Validate() and verify the publisher.
 			var bnd *bundle.Bundle
-			if bnd, err = bundle.Open(path); err != nil {
+			if bnd, err = bundle.Open(path, bundle.WithTrustedPublishers(rh.TrustedPublishers)); err != nil {
 				return nil, fmt.Errorf("failed to load runtime bundle '%s': %w", path, err)
 			}
 			if err = bnd.WriteExploded(dataDir); err != nil {
@@ -333,46 +447,10 @@ func newConfig(dataDir string, consensus consensus.Backend, ias ias.Endpoint) (*
 				rh.Runtimes[id] = make(map[version.Version]*runtimeHost.Config)
 			}
 
-			// Unmarshal any local runtime configuration.
-			var localConfig map[string]interface{}
-			if sub := viper.Sub(CfgRuntimeConfig); sub != nil {
-				if err = sub.UnmarshalKey(id.String(), &localConfig); err != nil {
-					return nil, fmt.Errorf("bad runtime configuration: %w", err)
-				}
-			}
-
-			runtimeHostCfg := &runtimeHost.Config{
-				Bundle: &runtimeHost.RuntimeBundle{
-					Bundle: bnd,
-					Path:   bnd.ExplodedPath(dataDir, bnd.Manifest.Executable),
-				},
-				LocalConfig: localConfig,
+			var runtimeHostCfg *runtimeHost.Config
+			if runtimeHostCfg, err = rh.newRuntimeHostConfig(dataDir, bnd); err != nil {
+				return nil, err
 			}
-
-			var haveSGXSignature bool
-			if !forceNoSGX && bnd.Manifest.SGX != nil {
-				// Ensure SGX provisioner is configured.
-				if _, ok := rh.Provisioners[node.TEEHardwareIntelSGX]; !ok {
-					return nil, fmt.Errorf("SGX loader binary path is not configured")
-				}
-
-				// If this is a TEE enclave, override the executable to point
-				// at the enclave binary instead.
-				runtimeHostCfg.Bundle.Path = bnd.ExplodedPath(dataDir, bnd.Manifest.SGX.Executable)
-				if bnd.Manifest.SGX.Signature != "" {
-					haveSGXSignature = true
-					runtimeHostCfg.Extra = &hostSgx.RuntimeExtra{
-						SignaturePath: bnd.ExplodedPath(dataDir, bnd.Manifest.SGX.Signature),
-					}
-				}
-			}
-			if !haveSGXSignature {
-				// HACK HACK HACK: Allow dummy SIGSTRUCT generation.
-				runtimeHostCfg.Extra = &hostSgx.RuntimeExtra{
-					UnsafeDebugGenerateSigstruct: true,
-				}
-			}
-
 			rh.Runtimes[id][bnd.Manifest.Version] = runtimeHostCfg
 		}
 		if cmdFlags.DebugDontBlameOasis() {
@@ -413,6 +491,19 @@ func newConfig(dataDir string, consensus consensus.Backend, ias ias.Endpoint) (*
 	case history.PrunerStrategyKeepLast:
 		numKept := viper.GetUint64(CfgHistoryPrunerKeepLastNum)
 		cfg.History.Pruner = history.NewKeepLastPruner(numKept)
+	case history.PrunerStrategyKeepDuration:
+		keepDuration := viper.GetDuration(CfgHistoryPrunerKeepDuration)
+		if keepDuration <= 0 {
+			return nil, fmt.Errorf("runtime/registry: history pruner keep duration must be positive")
+		}
+		cfg.History.Pruner = history.NewKeepDurationPruner(keepDuration)
+	case history.PrunerStrategyKeepHybrid:
+		numKept := viper.GetUint64(CfgHistoryPrunerKeepLastNum)
+		keepDuration := viper.GetDuration(CfgHistoryPrunerKeepDuration)
+		if keepDuration <= 0 {
+			return nil, fmt.Errorf("runtime/registry: history pruner keep duration must be positive")
+		}
+		cfg.History.Pruner = history.NewKeepHybridPruner(numKept, keepDuration)
 	default:
 		return nil, fmt.Errorf("runtime/registry: unknown history pruner strategy: %s", strategy)
 	}
@@ -429,13 +520,17 @@ func newConfig(dataDir string, consensus consensus.Backend, ias ias.Endpoint) (*
 func init() {
 	Flags.String(CfgRuntimeProvisioner, RuntimeProvisionerSandboxed, "Runtime provisioner to use")
 	Flags.StringSlice(CfgRuntimePaths, nil, "Paths to runtime resources (format: <path>,<path>,...)")
+	Flags.StringSlice(CfgRuntimeTrustedPublishers, nil, "Public keys of trusted runtime bundle publishers (format: <pk>,<pk>,...)")
+	Flags.String(CfgRuntimeBundleRepository, "", "HTTPS repository URL or local directory to automatically fetch runtime bundles from")
 	Flags.String(CfgSandboxBinary, "/usr/bin/bwrap", "Path to the sandbox binary (bubblewrap)")
+	Flags.String(CfgSandboxCgroupRoot, "", "Path to a delegated cgroup v2 subtree for enforcing runtime resource limits")
 	Flags.String(CfgRuntimeSGXLoader, "", "(for SGX runtimes) Path to SGXS runtime loader binary")
 	Flags.String(CfgRuntimeEnvironment, "auto", "The runtime environment (sgx, elf, auto)")
 
 	Flags.String(CfgHistoryPrunerStrategy, history.PrunerStrategyNone, "History pruner strategy")
 	Flags.Duration(CfgHistoryPrunerInterval, 2*time.Minute, "History pruning interval")
 	Flags.Uint64(CfgHistoryPrunerKeepLastNum, 600, "Keep last history pruner: number of last rounds to keep")
+	Flags.Duration(CfgHistoryPrunerKeepDuration, 24*time.Hour, "Keep duration history pruner: duration of history to keep (based on block timestamps)")
 
 	Flags.String(CfgRuntimeMode, string(RuntimeModeNone), "Runtime mode (none, compute, keymanager, client, client-stateless)")
 
@@ -36,8 +36,9 @@ const notifyTimeout = 10 * time.Second
 type RuntimeHostNode struct {
 	sync.Mutex
 
-	factory  RuntimeHostHandlerFactory
-	notifier protocol.Notifier
+	factory    RuntimeHostHandlerFactory
+	notifier   protocol.Notifier
+	msgHandler protocol.Handler
 
 	agg           *multi.Aggregate
 	runtime       host.RichRuntime
@@ -81,6 +82,7 @@ func (n *RuntimeHostNode) ProvisionHostedRuntime(ctx context.Context) (host.Rich
 	n.agg = agg.(*multi.Aggregate)
 	n.runtime = rr
 	n.notifier = notifier
+	n.msgHandler = msgHandler
 	n.Unlock()
 
 	close(n.runtimeNotify)
@@ -117,9 +119,42 @@ func (n *RuntimeHostNode) SetHostedRuntimeVersion(ctx context.Context, version v
 		return fmt.Errorf("runtime not available")
 	}
 
+	// Provision any versions that have been staged after the runtime has been provisioned.
+	if !agg.HasVersion(version) {
+		if err := n.provisionHostedRuntimeVersion(ctx, agg, version); err != nil {
+			return err
+		}
+	}
+
 	return agg.SetVersion(ctx, version)
 }
 
+func (n *RuntimeHostNode) provisionHostedRuntimeVersion(ctx context.Context, agg *multi.Aggregate, version version.Version) error {
+	cfgs, provisioner, err := n.factory.GetRuntime().Host(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get runtime host: %w", err)
+	}
+	cfg, ok := cfgs[version]
+	if !ok {
+		// Let the aggregate deal with unsupported versions.
+		return nil
+	}
+
+	n.Lock()
+	rtCfg := *cfg
+	rtCfg.MessageHandler = n.msgHandler
+	n.Unlock()
+
+	rt, err := provisioner.NewRuntime(ctx, rtCfg)
+	if err != nil {
+		return fmt.Errorf("failed to provision runtime version %s: %w", version, err)
+	}
+	if err = agg.AddVersion(ctx, version, rt); err != nil {
+		return fmt.Errorf("failed to add runtime version %s: %w", version, err)
+	}
+	return nil
+}
+
 // RuntimeHostHandlerFactory is an interface that can be used to create new runtime handlers and
 // notifiers when provisioning hosted runtimes.
 type RuntimeHostHandlerFactory interface {
@@ -19,10 +19,12 @@ import (
 	ias "github.com/oasisprotocol/oasis-core/go/ias/api"
 	registry "github.com/oasisprotocol/oasis-core/go/registry/api"
 	roothash "github.com/oasisprotocol/oasis-core/go/roothash/api"
+	"github.com/oasisprotocol/oasis-core/go/runtime/bundle"
 	"github.com/oasisprotocol/oasis-core/go/runtime/history"
 	runtimeHost "github.com/oasisprotocol/oasis-core/go/runtime/host"
 	"github.com/oasisprotocol/oasis-core/go/runtime/localstorage"
 	storageAPI "github.com/oasisprotocol/oasis-core/go/storage/api"
+	workerCommon "github.com/oasisprotocol/oasis-core/go/worker/common/api"
 )
 
 const (
@@ -57,6 +59,16 @@ type Registry interface {
 	// to set the role for all runtimes.
 	AddRoles(roles node.RolesMask, runtimeID *common.Namespace) error
 
+	// AddRuntime adds support for a new runtime from the given runtime bundle.
+	//
+	// The caller is responsible for registering the storage backend and finishing initialization.
+	AddRuntime(ctx context.Context, bundlePath string) (Runtime, error)
+
+	// RemoveRuntime removes support for the given runtime.
+	//
+	// The caller is responsible for first stopping anything that uses the runtime.
+	RemoveRuntime(ctx context.Context, runtimeID common.Namespace) error
+
 	// Cleanup performs post-termination cleanup.
 	Cleanup()
 
@@ -115,6 +127,10 @@ type Runtime interface {
 
 	// HostVersions returns a list of supported runtime versions.
 	HostVersions() []version.Version
+
+	// StagedBundles returns the status of runtime bundles automatically fetched for upcoming
+	// deployments.
+	StagedBundles() []workerCommon.BundleStatus
 }
 
 type runtime struct { // nolint: maligned
@@ -143,6 +159,9 @@ type runtime struct { // nolint: maligned
 
 	hostProvisioners map[node.TEEHardware]runtimeHost.Provisioner
 	hostConfig       map[version.Version]*runtimeHost.Config
+	hostDataDir      string
+	hostCfg          *RuntimeHostConfig
+	stagedBundles    map[version.Version]*workerCommon.BundleStatus
 
 	logger *logging.Logger
 }
@@ -246,11 +265,14 @@ func (r *runtime) LocalStorage() localstorage.LocalStorage {
 }
 
 func (r *runtime) HasHost() bool {
+	r.RLock()
+	defer r.RUnlock()
+
 	return r.hostProvisioners != nil && r.hostConfig != nil
 }
 
 func (r *runtime) Host(ctx context.Context) (map[version.Version]*runtimeHost.Config, runtimeHost.Provisioner, error) {
-	if r.hostProvisioners == nil || r.hostConfig == nil {
+	if !r.HasHost() {
 		return nil, nil, ErrRuntimeHostNotConfigured
 	}
 
@@ -264,10 +286,21 @@ func (r *runtime) Host(ctx context.Context) (map[version.Version]*runtimeHost.Co
 		return nil, nil, fmt.Errorf("no provisioner suitable for TEE hardware '%s'", rt.TEEHardware)
 	}
 
-	return r.hostConfig, provisioner, nil
+	// Return a copy as the host configuration may be updated when new bundles are staged.
+	r.RLock()
+	defer r.RUnlock()
+
+	cfgs := make(map[version.Version]*runtimeHost.Config, len(r.hostConfig))
+	for v, cfg := range r.hostConfig {
+		cfgs[v] = cfg
+	}
+	return cfgs, provisioner, nil
 }
 
 func (r *runtime) HostVersions() []version.Version {
+	r.RLock()
+	defer r.RUnlock()
+
 	var versions []version.Version
 	for v := range r.hostConfig {
 		versions = append(versions, v)
@@ -412,6 +445,9 @@ func (r *runtime) finishInitialization(ctx context.Context) error {
 type runtimeRegistry struct {
 	sync.RWMutex
 
+	// ctx is the context of the registry which is used for the lifetime of managed runtimes, as
+	// runtimes may be added from short-lived contexts (e.g., control API requests).
+	ctx    context.Context
 	logger *logging.Logger
 
 	dataDir string
@@ -449,6 +485,9 @@ func (r *runtimeRegistry) Runtimes() []Runtime {
 }
 
 func (r *runtimeRegistry) NewUnmanagedRuntime(ctx context.Context, runtimeID common.Namespace) (Runtime, error) {
+	r.RLock()
+	defer r.RUnlock()
+
 	return newRuntime(ctx, r.dataDir, runtimeID, r.cfg, r.consensus, r.logger)
 }
 
@@ -471,6 +510,76 @@ func (r *runtimeRegistry) AddRoles(roles node.RolesMask, runtimeID *common.Names
 	return nil
 }
 
+func (r *runtimeRegistry) AddRuntime(ctx context.Context, bundlePath string) (Runtime, error) {
+	if r.cfg.Host == nil {
+		return nil, ErrRuntimeHostNotConfigured
+	}
+
+	// Open and explode the bundle. This will call Validate() and verify the publisher.
+	bnd, err := bundle.Open(bundlePath, bundle.WithTrustedPublishers(r.cfg.Host.TrustedPublishers))
+	if err != nil {
+		return nil, fmt.Errorf("runtime/registry: failed to load runtime bundle '%s': %w", bundlePath, err)
+	}
+	if err = bnd.WriteExploded(r.dataDir); err != nil {
+		return nil, fmt.Errorf("runtime/registry: failed to explode runtime bundle '%s': %w", bundlePath, err)
+	}
+	runtimeHostCfg, err := r.cfg.Host.newRuntimeHostConfig(r.dataDir, bnd)
+	if err != nil {
+		return nil, fmt.Errorf("runtime/registry: %w", err)
+	}
+	id := bnd.Manifest.ID
+
+	r.Lock()
+	if _, ok := r.runtimes[id]; ok {
+		r.Unlock()
+		return nil, fmt.Errorf("runtime/registry: runtime already registered: %s", id)
+	}
+	r.cfg.Host.Runtimes[id] = map[version.Version]*runtimeHost.Config{
+		bnd.Manifest.Version: runtimeHostCfg,
+	}
+	r.Unlock()
+
+	r.logger.Info("adding supported runtime",
+		"id", id,
+		"version", bnd.Manifest.Version,
+	)
+
+	if err = r.addSupportedRuntime(ctx, id); err != nil {
+		r.Lock()
+		delete(r.cfg.Host.Runtimes, id)
+		r.Unlock()
+		return nil, err
+	}
+
+	return r.GetRuntime(id)
+}
+
+func (r *runtimeRegistry) RemoveRuntime(ctx context.Context, runtimeID common.Namespace) error {
+	r.Lock()
+	rt, ok := r.runtimes[runtimeID]
+	if !ok {
+		r.Unlock()
+		return fmt.Errorf("runtime/registry: runtime %s is not supported", runtimeID)
+	}
+	delete(r.runtimes, runtimeID)
+	if r.cfg.Host != nil {
+		delete(r.cfg.Host.Runtimes, runtimeID)
+	}
+	r.Unlock()
+
+	r.logger.Info("removing supported runtime",
+		"id", runtimeID,
+	)
+
+	// Make sure the block history is no longer used before closing it.
+	if err := r.consensus.RootHash().UntrackRuntime(ctx, runtimeID); err != nil {
+		return fmt.Errorf("runtime/registry: cannot untrack runtime %s: %w", runtimeID, err)
+	}
+	rt.stop()
+
+	return nil
+}
+
 func (r *runtimeRegistry) Cleanup() {
 	r.Lock()
 	defer r.Unlock()
@@ -504,7 +613,7 @@ func (r *runtimeRegistry) addSupportedRuntime(ctx context.Context, id common.Nam
 		return fmt.Errorf("runtime/registry: runtime already registered: %s", id)
 	}
 
-	rt, err := newRuntime(ctx, r.dataDir, id, r.cfg, r.consensus, r.logger)
+	rt, err := newRuntime(r.ctx, r.dataDir, id, r.cfg, r.consensus, r.logger)
 	if err != nil {
 		return err
 	}
@@ -579,6 +688,14 @@ func newRuntime(
 	if cfg.Host != nil {
 		rt.hostProvisioners = cfg.Host.Provisioners
 		rt.hostConfig = cfg.Host.Runtimes[id]
+		rt.hostDataDir = dataDir
+		rt.hostCfg = cfg.Host
+		rt.stagedBundles = make(map[version.Version]*workerCommon.BundleStatus)
+
+		// Automatically fetch bundles for upcoming deployments if configured.
+		if rt.hostConfig != nil && cfg.Host.BundleRepository != "" {
+			go rt.watchBundles(watchCtx)
+		}
 	}
 
 	return rt, nil
@@ -592,6 +709,7 @@ func New(ctx context.Context, dataDir string, consensus consensus.Backend, ias i
 	}
 
 	r := &runtimeRegistry{
+		ctx:       ctx,
 		logger:    logging.GetLogger("runtime/registry"),
 		dataDir:   dataDir,
 		cfg:       cfg,
@@ -0,0 +1,255 @@
+package registry
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common"
+	"github.com/oasisprotocol/oasis-core/go/common/logging"
+	"github.com/oasisprotocol/oasis-core/go/common/pubsub"
+	"github.com/oasisprotocol/oasis-core/go/common/version"
+	consensus "github.com/oasisprotocol/oasis-core/go/consensus/api"
+	registry "github.com/oasisprotocol/oasis-core/go/registry/api"
+	roothash "github.com/oasisprotocol/oasis-core/go/roothash/api"
+	"github.com/oasisprotocol/oasis-core/go/runtime/bundle"
+	"github.com/oasisprotocol/oasis-core/go/runtime/history"
+	runtimeHost "github.com/oasisprotocol/oasis-core/go/runtime/host"
+)
+
+const recvTimeout = 5 * time.Second
+
+// testSubscription is a subscription which records when it is closed.
+type testSubscription struct {
+	closeOnce sync.Once
+	closedCh  chan struct{}
+}
+
+func (s *testSubscription) Close() {
+	s.closeOnce.Do(func() { close(s.closedCh) })
+}
+
+type testWatchers struct {
+	subCh chan *testSubscription
+}
+
+func (w *testWatchers) newSubscription() *testSubscription {
+	sub := &testSubscription{closedCh: make(chan struct{})}
+	w.subCh <- sub
+	return sub
+}
+
+type testBeacon struct {
+	beacon.Backend
+
+	*testWatchers
+}
+
+func (b *testBeacon) WatchEpochs(context.Context) (<-chan beacon.EpochTime, pubsub.ClosableSubscription, error) {
+	return make(chan beacon.EpochTime), b.newSubscription(), nil
+}
+
+type testRegistry struct {
+	registry.Backend
+
+	*testWatchers
+}
+
+func (r *testRegistry) WatchRuntimes(context.Context) (<-chan *registry.Runtime, pubsub.ClosableSubscription, error) {
+	return make(chan *registry.Runtime), r.newSubscription(), nil
+}
+
+type testRootHash struct {
+	roothash.Backend
+
+	sync.Mutex
+	tracked map[common.Namespace]roothash.BlockHistory
+}
+
+func (rh *testRootHash) TrackRuntime(_ context.Context, history roothash.BlockHistory) error {
+	rh.Lock()
+	defer rh.Unlock()
+
+	rh.tracked[history.RuntimeID()] = history
+	return nil
+}
+
+func (rh *testRootHash) UntrackRuntime(_ context.Context, runtimeID common.Namespace) error {
+	rh.Lock()
+	defer rh.Unlock()
+
+	delete(rh.tracked, runtimeID)
+	return nil
+}
+
+func (rh *testRootHash) isTracked(runtimeID common.Namespace) bool {
+	rh.Lock()
+	defer rh.Unlock()
+
+	_, ok := rh.tracked[runtimeID]
+	return ok
+}
+
+type testConsensus struct {
+	consensus.Backend
+
+	syncedCh chan struct{}
+	beacon   *testBeacon
+	registry *testRegistry
+	roothash *testRootHash
+}
+
+func (c *testConsensus) Mode() consensus.Mode {
+	return consensus.ModeFull
+}
+
+func (c *testConsensus) Synced() <-chan struct{} {
+	return c.syncedCh
+}
+
+func (c *testConsensus) Beacon() beacon.Backend {
+	return c.beacon
+}
+
+func (c *testConsensus) Registry() registry.Backend {
+	return c.registry
+}
+
+func (c *testConsensus) RootHash() roothash.Backend {
+	return c.roothash
+}
+
+func newTestConsensus() *testConsensus {
+	watchers := &testWatchers{subCh: make(chan *testSubscription, 16)}
+	syncedCh := make(chan struct{})
+	close(syncedCh)
+
+	return &testConsensus{
+		syncedCh: syncedCh,
+		beacon:   &testBeacon{testWatchers: watchers},
+		registry: &testRegistry{testWatchers: watchers},
+		roothash: &testRootHash{tracked: make(map[common.Namespace]roothash.BlockHistory)},
+	}
+}
+
+func writeTestBundle(t *testing.T, dir string, id common.Namespace) string {
+	require := require.New(t)
+
+	// Use the test executable as the runtime ELF executable.
+	execBuf, err := os.ReadFile(os.Args[0])
+	require.NoError(err, "ReadFile")
+
+	bnd := &bundle.Bundle{
+		Manifest: &bundle.Manifest{
+			Name:       "test-runtime",
+			ID:         id,
+			Version:    version.Version{Major: 1},
+			Executable: "runtime.bin",
+		},
+	}
+	err = bnd.Add(bnd.Manifest.Executable, execBuf)
+	require.NoError(err, "bundle.Add")
+
+	fn := filepath.Join(dir, "runtime.orc")
+	err = bnd.Write(fn)
+	require.NoError(err, "bundle.Write")
+	return fn
+}
+
+func TestAddRemoveRuntime(t *testing.T) {
+	require := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dataDir := t.TempDir()
+	cs := newTestConsensus()
+	r := &runtimeRegistry{
+		ctx:     ctx,
+		logger:  logging.GetLogger("runtime/registry/test"),
+		dataDir: dataDir,
+		cfg: &RuntimeConfig{
+			Mode: RuntimeModeCompute,
+			Host: &RuntimeHostConfig{
+				Runtimes: make(map[common.Namespace]map[version.Version]*runtimeHost.Config),
+			},
+			History: *history.NewDefaultConfig(),
+		},
+		consensus: cs,
+		runtimes:  make(map[common.Namespace]*runtime),
+	}
+	defer r.Cleanup()
+
+	id := common.NewTestNamespaceFromSeed([]byte("runtime registry add remove test"), 0)
+	bundlePath := writeTestBundle(t, t.TempDir(), id)
+
+	waitWatchers := func() []*testSubscription {
+		var subs []*testSubscription
+		for i := 0; i < 2; i++ {
+			select {
+			case sub := <-cs.beacon.subCh:
+				subs = append(subs, sub)
+			case <-time.After(recvTimeout):
+				t.Fatalf("failed to wait for runtime watchers to start")
+			}
+		}
+		return subs
+	}
+
+	// Removing an unknown runtime should fail.
+	err := r.RemoveRuntime(ctx, id)
+	require.Error(err, "RemoveRuntime should fail for unknown runtime")
+
+	// Adding a runtime should register it with the runtime host configuration and start tracking.
+	rt, err := r.AddRuntime(ctx, bundlePath)
+	require.NoError(err, "AddRuntime")
+	require.Equal(id, rt.ID(), "added runtime should have the bundle runtime ID")
+	require.Contains(r.cfg.Host.Runtimes, id, "runtime host should be configured")
+	require.True(cs.roothash.isTracked(id), "runtime history should be tracked")
+	subs := waitWatchers()
+
+	_, err = r.AddRuntime(ctx, bundlePath)
+	require.Error(err, "AddRuntime should fail for an already added runtime")
+
+	// Removing the runtime should stop all watchers and untrack it.
+	err = r.RemoveRuntime(ctx, id)
+	require.NoError(err, "RemoveRuntime")
+	_, err = r.GetRuntime(id)
+	require.Error(err, "removed runtime should no longer be available")
+	require.NotContains(r.cfg.Host.Runtimes, id, "runtime host should no longer be configured")
+	require.False(cs.roothash.isTracked(id), "runtime history should no longer be tracked")
+	for _, sub := range subs {
+		select {
+		case <-sub.closedCh:
+		case <-time.After(recvTimeout):
+			t.Fatalf("failed to wait for runtime watchers to stop")
+		}
+	}
+
+	err = r.RemoveRuntime(ctx, id)
+	require.Error(err, "RemoveRuntime should fail for already removed runtime")
+
+	// Adding the runtime again should succeed.
+	reqCtx, reqCancel := context.WithCancel(ctx)
+	rt, err = r.AddRuntime(reqCtx, bundlePath)
+	require.NoError(err, "AddRuntime after RemoveRuntime")
+	require.Equal(id, rt.ID(), "added runtime should have the bundle runtime ID")
+	require.True(cs.roothash.isTracked(id), "runtime history should be tracked again")
+	subs = waitWatchers()
+
+	// Watchers should outlive the context of the request which added the runtime.
+	reqCancel()
+	for _, sub := range subs {
+		select {
+		case <-sub.closedCh:
+			t.Fatalf("runtime watchers should not stop when the request context is canceled")
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
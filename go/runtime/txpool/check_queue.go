@@ -11,6 +11,8 @@ type checkTxQueue struct {
 	l sync.Mutex
 
 	txs *deque.Deque[*PendingCheckTransaction]
+	// checking is the batch which has been popped for checks and has not yet been processed.
+	checking []*PendingCheckTransaction
 
 	maxSize      int
 	maxBatchSize int
@@ -38,6 +40,7 @@ func (cq *checkTxQueue) retryBatch(pcts []*PendingCheckTransaction) {
 	for _, pct := range pcts {
 		cq.txs.PushFront(pct)
 	}
+	cq.checking = nil
 }
 
 func (cq *checkTxQueue) pop() []*PendingCheckTransaction {
@@ -58,10 +61,19 @@ func (cq *checkTxQueue) pop() []*PendingCheckTransaction {
 		tx := cq.txs.PopFront()
 		batch = append(batch, tx)
 	}
+	cq.checking = batch
 
 	return batch
 }
 
+// done marks the batch which has been popped for checks as processed.
+func (cq *checkTxQueue) done() {
+	cq.l.Lock()
+	defer cq.l.Unlock()
+
+	cq.checking = nil
+}
+
 func (cq *checkTxQueue) size() int {
 	cq.l.Lock()
 	defer cq.l.Unlock()
@@ -69,11 +81,37 @@ func (cq *checkTxQueue) size() int {
 	return cq.txs.Len()
 }
 
+func (cq *checkTxQueue) all() []*PendingCheckTransaction {
+	cq.l.Lock()
+	defer cq.l.Unlock()
+
+	pcts := make([]*PendingCheckTransaction, 0, cq.txs.Len())
+	for i := 0; i < cq.txs.Len(); i++ {
+		pcts = append(pcts, cq.txs.At(i))
+	}
+	return pcts
+}
+
+// pending returns all transactions which are pending checks, including the batch which is
+// currently being checked.
+func (cq *checkTxQueue) pending() []*PendingCheckTransaction {
+	cq.l.Lock()
+	defer cq.l.Unlock()
+
+	pcts := make([]*PendingCheckTransaction, 0, len(cq.checking)+cq.txs.Len())
+	pcts = append(pcts, cq.checking...)
+	for i := 0; i < cq.txs.Len(); i++ {
+		pcts = append(pcts, cq.txs.At(i))
+	}
+	return pcts
+}
+
 func (cq *checkTxQueue) clear() {
 	cq.l.Lock()
 	defer cq.l.Unlock()
 
 	cq.txs.Clear()
+	cq.checking = nil
 }
 
 func newCheckTxQueue(maxSize, maxBatchSize int) *checkTxQueue {
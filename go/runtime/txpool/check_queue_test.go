@@ -62,3 +62,28 @@ func TestCheckTxQueuePop(t *testing.T) {
 	require.EqualValues(t, 1, len(batch), "Batch size")
 	require.EqualValues(t, 0, queue.size(), "Size")
 }
+
+func TestCheckTxQueuePending(t *testing.T) {
+	queue := newCheckTxQueue(51, 1)
+
+	err := queue.add(newPendingTx([]byte("hello world")))
+	require.NoError(t, err, "Add")
+	err = queue.add(newPendingTx([]byte("goodbye world")))
+	require.NoError(t, err, "Add")
+
+	batch := queue.pop()
+	require.EqualValues(t, 1, len(batch), "Batch size")
+	require.EqualValues(t, 1, queue.size(), "Size")
+	require.EqualValues(t, 2, len(queue.pending()), "batch being checked should be pending")
+
+	queue.retryBatch(batch)
+	require.EqualValues(t, 2, queue.size(), "Size")
+	require.EqualValues(t, 2, len(queue.pending()), "retried batch should not be duplicated")
+
+	batch = queue.pop()
+	require.EqualValues(t, 1, len(batch), "Batch size")
+	queue.done()
+	pending := queue.pending()
+	require.EqualValues(t, 1, len(pending), "processed batch should no longer be pending")
+	require.EqualValues(t, []byte("goodbye world"), pending[0].Raw())
+}
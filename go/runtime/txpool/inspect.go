@@ -0,0 +1,248 @@
+package txpool
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
+)
+
+// QueueKind is the kind of the transaction pool queue holding a transaction.
+type QueueKind uint8
+
+const (
+	// QueueKindLocal is the queue of transactions obtained from local clients.
+	QueueKindLocal QueueKind = 0
+	// QueueKindSchedule is the main schedule queue.
+	QueueKindSchedule QueueKind = 1
+	// QueueKindRim is the queue of transactions from roothash incoming messages.
+	QueueKindRim QueueKind = 2
+	// QueueKindDiscard is used for transactions which will be discarded after checks.
+	QueueKindDiscard QueueKind = 3
+)
+
+// String returns a string representation of a queue kind.
+func (k QueueKind) String() string {
+	switch k {
+	case QueueKindLocal:
+		return "local"
+	case QueueKindSchedule:
+		return "schedule"
+	case QueueKindRim:
+		return "rim"
+	case QueueKindDiscard:
+		return "discard"
+	default:
+		return "[invalid queue kind]"
+	}
+}
+
+// MarshalText encodes a QueueKind into text form.
+func (k QueueKind) MarshalText() ([]byte, error) {
+	switch k {
+	case QueueKindLocal, QueueKindSchedule, QueueKindRim, QueueKindDiscard:
+		return []byte(k.String()), nil
+	default:
+		return nil, fmt.Errorf("invalid QueueKind: %d", k)
+	}
+}
+
+// UnmarshalText decodes a text slice into a QueueKind.
+func (k *QueueKind) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case QueueKindLocal.String():
+		*k = QueueKindLocal
+	case QueueKindSchedule.String():
+		*k = QueueKindSchedule
+	case QueueKindRim.String():
+		*k = QueueKindRim
+	case QueueKindDiscard.String():
+		*k = QueueKindDiscard
+	default:
+		return fmt.Errorf("invalid QueueKind: %s", string(text))
+	}
+	return nil
+}
+
+// CheckState is the check state of a transaction in the transaction pool.
+type CheckState uint8
+
+const (
+	// CheckStateChecked means that the transaction passed checks and is ready for scheduling.
+	CheckStateChecked CheckState = 0
+	// CheckStatePending means that the transaction is waiting to be checked for the first time.
+	CheckStatePending CheckState = 1
+	// CheckStatePendingRecheck means that the transaction passed checks earlier and is waiting
+	// to be rechecked.
+	CheckStatePendingRecheck CheckState = 2
+)
+
+// String returns a string representation of a check state.
+func (s CheckState) String() string {
+	switch s {
+	case CheckStateChecked:
+		return "checked"
+	case CheckStatePending:
+		return "pending check"
+	case CheckStatePendingRecheck:
+		return "pending recheck"
+	default:
+		return "[invalid check state]"
+	}
+}
+
+// MarshalText encodes a CheckState into text form.
+func (s CheckState) MarshalText() ([]byte, error) {
+	switch s {
+	case CheckStateChecked, CheckStatePending, CheckStatePendingRecheck:
+		return []byte(s.String()), nil
+	default:
+		return nil, fmt.Errorf("invalid CheckState: %d", s)
+	}
+}
+
+// UnmarshalText decodes a text slice into a CheckState.
+func (s *CheckState) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case CheckStateChecked.String():
+		*s = CheckStateChecked
+	case CheckStatePending.String():
+		*s = CheckStatePending
+	case CheckStatePendingRecheck.String():
+		*s = CheckStatePendingRecheck
+	default:
+		return fmt.Errorf("invalid CheckState: %s", string(text))
+	}
+	return nil
+}
+
+// TransactionInfo contains information about a transaction in the transaction pool.
+type TransactionInfo struct {
+	// Hash is the transaction hash.
+	Hash hash.Hash `json:"hash"`
+	// Size is the size (in bytes) of the raw transaction.
+	Size int `json:"size"`
+
+	// Queue is the queue holding the transaction. For transactions pending checks, this is the
+	// queue the transaction will be placed into once checked.
+	Queue QueueKind `json:"queue"`
+	// CheckState is the check state of the transaction.
+	CheckState CheckState `json:"check_state"`
+
+	// FirstSeen is the time the transaction was first seen. It is zero for transactions which
+	// were not submitted directly (e.g., transactions from roothash incoming messages).
+	FirstSeen time.Time `json:"first_seen"`
+
+	// Priority is the transaction priority as specified by the runtime. Only available for
+	// transactions in the schedule queue.
+	Priority uint64 `json:"priority,omitempty"`
+	// Sender is the transaction sender as specified by the runtime. Only available for
+	// transactions in the schedule queue.
+	Sender []byte `json:"sender,omitempty"`
+	// SenderSeq is the per-sender sequence number as specified by the runtime. Only available for
+	// transactions in the schedule queue.
+	SenderSeq uint64 `json:"sender_seq,omitempty"`
+}
+
+// QueueSizes contains the number of transactions in each transaction pool queue.
+type QueueSizes struct {
+	// Local is the number of transactions in the local queue.
+	Local int `json:"local"`
+	// Schedule is the number of transactions in the schedule queue.
+	Schedule int `json:"schedule"`
+	// Rim is the number of transactions from roothash incoming messages.
+	Rim int `json:"rim"`
+	// PendingCheck is the number of transactions pending (re)checks.
+	PendingCheck int `json:"pending_check"`
+}
+
+// Snapshot is a snapshot of the transaction pool contents.
+type Snapshot struct {
+	// QueueSizes are the number of transactions in each queue.
+	QueueSizes QueueSizes `json:"queue_sizes"`
+
+	// Transactions are the transactions in the transaction pool.
+	Transactions []*TransactionInfo `json:"transactions"`
+}
+
+func (t *txPool) Inspect() *Snapshot {
+	var snapshot Snapshot
+
+	for _, tx := range t.localQueue.GetSchedulingSuggestion(0) {
+		snapshot.Transactions = append(snapshot.Transactions, &TransactionInfo{
+			Hash:       tx.Hash(),
+			Size:       tx.Size(),
+			Queue:      QueueKindLocal,
+			CheckState: CheckStateChecked,
+			FirstSeen:  tx.FirstSeen(),
+		})
+		snapshot.QueueSizes.Local++
+	}
+
+	for _, tx := range t.mainQueue.inner.GetBatch(nil, uint32(t.mainQueue.inner.Size())) {
+		snapshot.Transactions = append(snapshot.Transactions, newScheduleTransactionInfo(tx))
+		snapshot.QueueSizes.Schedule++
+	}
+	// Some policies only return schedulable transactions in batches, so include the rest.
+	if snapshot.QueueSizes.Schedule < t.mainQueue.inner.Size() {
+		seen := make(map[hash.Hash]struct{}, snapshot.QueueSizes.Schedule)
+		for _, ti := range snapshot.Transactions[snapshot.QueueSizes.Local:] {
+			seen[ti.Hash] = struct{}{}
+		}
+		for _, tx := range t.mainQueue.inner.GetAll() {
+			if _, ok := seen[tx.Hash()]; ok {
+				continue
+			}
+			snapshot.Transactions = append(snapshot.Transactions, newScheduleTransactionInfo(tx))
+			snapshot.QueueSizes.Schedule++
+		}
+	}
+
+	for _, tx := range t.rimQueue.all() {
+		snapshot.Transactions = append(snapshot.Transactions, &TransactionInfo{
+			Hash:       tx.Hash(),
+			Size:       tx.Size(),
+			Queue:      QueueKindRim,
+			CheckState: CheckStateChecked,
+			FirstSeen:  tx.FirstSeen(),
+		})
+		snapshot.QueueSizes.Rim++
+	}
+
+	for _, pct := range t.checkTxQueue.all() {
+		ti := &TransactionInfo{
+			Hash:       pct.Hash(),
+			Size:       pct.Size(),
+			CheckState: CheckStatePending,
+			FirstSeen:  pct.FirstSeen(),
+		}
+		if pct.flags.isRecheck() {
+			ti.CheckState = CheckStatePendingRecheck
+		}
+		switch pct.dstQueue {
+		case t.localQueue:
+			ti.Queue = QueueKindLocal
+		case nil:
+			ti.Queue = QueueKindDiscard
+		default:
+			ti.Queue = QueueKindSchedule
+		}
+		snapshot.Transactions = append(snapshot.Transactions, ti)
+		snapshot.QueueSizes.PendingCheck++
+	}
+
+	return &snapshot
+}
+
+func newScheduleTransactionInfo(tx *MainQueueTransaction) *TransactionInfo {
+	return &TransactionInfo{
+		Hash:       tx.Hash(),
+		Size:       tx.Size(),
+		Queue:      QueueKindSchedule,
+		CheckState: CheckStateChecked,
+		FirstSeen:  tx.FirstSeen(),
+		Priority:   tx.priority,
+		Sender:     []byte(tx.sender),
+		SenderSeq:  tx.senderSeq,
+	}
+}
@@ -0,0 +1,97 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
+	"github.com/oasisprotocol/oasis-core/go/roothash/api/message"
+	"github.com/oasisprotocol/oasis-core/go/runtime/host/protocol"
+)
+
+func TestInspect(t *testing.T) {
+	t.Run("QueueKind", func(t *testing.T) {
+		require := require.New(t)
+		for _, k := range []QueueKind{QueueKindLocal, QueueKindSchedule, QueueKindRim, QueueKindDiscard} {
+			text, err := k.MarshalText()
+			require.NoError(err, "MarshalText")
+			var dk QueueKind
+			require.NoError(dk.UnmarshalText(text), "UnmarshalText")
+			require.Equal(k, dk, "queue kind should round-trip")
+		}
+	})
+
+	t.Run("CheckState", func(t *testing.T) {
+		require := require.New(t)
+		for _, s := range []CheckState{CheckStateChecked, CheckStatePending, CheckStatePendingRecheck} {
+			text, err := s.MarshalText()
+			require.NoError(err, "MarshalText")
+			var ds CheckState
+			require.NoError(ds.UnmarshalText(text), "UnmarshalText")
+			require.Equal(s, ds, "check state should round-trip")
+		}
+	})
+
+	require := require.New(t)
+
+	lq := newLocalQueue()
+	mq := newMainQueue(newScheduleQueue(10))
+	rq := newRimQueue()
+	cq := newCheckTxQueue(10, 10)
+	pool := &txPool{
+		localQueue:   lq,
+		mainQueue:    mq,
+		rimQueue:     rq,
+		checkTxQueue: cq,
+	}
+
+	newTx := func(raw string) *TxQueueMeta {
+		return &TxQueueMeta{raw: []byte(raw), hash: hash.NewFromBytes([]byte(raw))}
+	}
+
+	txLocal := newTx("local")
+	require.NoError(lq.OfferChecked(txLocal, nil), "OfferChecked")
+	txMain := newTx("main")
+	require.NoError(mq.OfferChecked(txMain, &protocol.CheckTxMetadata{
+		Priority:  5,
+		Sender:    []byte("sender"),
+		SenderSeq: 7,
+	}), "OfferChecked")
+	rq.Load([]*message.IncomingMessage{{Data: []byte("rim")}})
+	txCheck := newTx("check")
+	require.NoError(cq.add(&PendingCheckTransaction{TxQueueMeta: txCheck, dstQueue: lq}), "add")
+	txRecheck := newTx("recheck")
+	require.NoError(cq.add(&PendingCheckTransaction{TxQueueMeta: txRecheck, dstQueue: mq, flags: txCheckRecheck}), "add")
+
+	snapshot := pool.Inspect()
+	require.Equal(QueueSizes{Local: 1, Schedule: 1, Rim: 1, PendingCheck: 2}, snapshot.QueueSizes)
+	require.Len(snapshot.Transactions, 5)
+
+	byHash := make(map[hash.Hash]*TransactionInfo)
+	for _, ti := range snapshot.Transactions {
+		byHash[ti.Hash] = ti
+	}
+
+	ti := byHash[txLocal.Hash()]
+	require.Equal(QueueKindLocal, ti.Queue)
+	require.Equal(CheckStateChecked, ti.CheckState)
+
+	ti = byHash[txMain.Hash()]
+	require.Equal(QueueKindSchedule, ti.Queue)
+	require.Equal(CheckStateChecked, ti.CheckState)
+	require.EqualValues(5, ti.Priority)
+	require.Equal([]byte("sender"), ti.Sender)
+	require.EqualValues(7, ti.SenderSeq)
+
+	ti = byHash[hash.NewFromBytes([]byte("rim"))]
+	require.Equal(QueueKindRim, ti.Queue)
+
+	ti = byHash[txCheck.Hash()]
+	require.Equal(QueueKindLocal, ti.Queue)
+	require.Equal(CheckStatePending, ti.CheckState)
+
+	ti = byHash[txRecheck.Hash()]
+	require.Equal(QueueKindSchedule, ti.Queue)
+	require.Equal(CheckStatePendingRecheck, ti.CheckState)
+}
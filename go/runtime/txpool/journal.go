@@ -0,0 +1,302 @@
+package txpool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/oasisprotocol/oasis-core/go/common/cbor"
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
+	"github.com/oasisprotocol/oasis-core/go/common/logging"
+)
+
+const (
+	// JournalFilename is the default transaction journal filename.
+	JournalFilename = "txpool.journal"
+
+	// defaultMaxJournalSize is the default maximum size (in bytes) of the transaction journal.
+	defaultMaxJournalSize = 64 * 1024 * 1024 // 64 MiB
+
+	// journalRecordPrefixSize is the size of the length prefix of each journal record.
+	journalRecordPrefixSize = 4
+)
+
+// ErrJournalFull is the error returned when the transaction journal has reached its size limit.
+var ErrJournalFull = errors.New("txpool: journal is full")
+
+// journalEntry is a single transaction stored in the journal.
+type journalEntry struct {
+	// Local is a flag indicating that the transaction was obtained from a local client.
+	Local bool `json:"local,omitempty"`
+	// Raw is the raw transaction data.
+	Raw []byte `json:"raw"`
+}
+
+// journal is an on-disk journal of transactions in the local and main queues, used to restore
+// the transaction pool after a restart.
+//
+// The journal is a sequence of length-prefixed CBOR-encoded entries. New transactions are
+// appended as they are queued and the journal is periodically rotated by rewriting it with the
+// current contents of the queues, which drops any transactions that are no longer pending.
+type journal struct {
+	l sync.Mutex
+
+	logger *logging.Logger
+
+	path    string
+	maxSize uint64
+
+	f    *os.File
+	w    *bufio.Writer
+	size uint64
+}
+
+// load reads all entries from the journal, skipping any duplicates. A truncated or corrupted
+// trailing entry (e.g., due to a crash while writing) is ignored.
+func (j *journal) load() ([]*journalEntry, error) {
+	j.l.Lock()
+	defer j.l.Unlock()
+
+	f, err := os.Open(j.path)
+	switch {
+	case err == nil:
+	case errors.Is(err, os.ErrNotExist):
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("failed to open journal: %w", err)
+	}
+	defer f.Close()
+
+	var (
+		entries []*journalEntry
+		size    uint64
+	)
+	seen := make(map[hash.Hash]struct{})
+	r := bufio.NewReader(f)
+	for {
+		var entry journalEntry
+		n, err := readJournalEntry(r, &entry, j.maxSize)
+		switch {
+		case err == nil:
+		case errors.Is(err, io.EOF):
+			return entries, nil
+		default:
+			j.logger.Warn("ignoring corrupted journal tail",
+				"err", err,
+				"offset", size,
+			)
+			return entries, nil
+		}
+		size += n
+		if size > j.maxSize {
+			j.logger.Warn("journal exceeds size limit, ignoring remaining entries",
+				"max_size", j.maxSize,
+			)
+			return entries, nil
+		}
+
+		h := hash.NewFromBytes(entry.Raw)
+		if _, dup := seen[h]; dup {
+			continue
+		}
+		seen[h] = struct{}{}
+		entries = append(entries, &entry)
+	}
+}
+
+// open opens the journal for appending new entries.
+func (j *journal) open() error {
+	j.l.Lock()
+	defer j.l.Unlock()
+
+	f, err := os.OpenFile(j.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open journal: %w", err)
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to stat journal: %w", err)
+	}
+
+	j.f = f
+	j.w = bufio.NewWriter(f)
+	j.size = uint64(fi.Size())
+
+	return nil
+}
+
+// append appends the given entries to the journal.
+func (j *journal) append(entries []*journalEntry) error {
+	j.l.Lock()
+	defer j.l.Unlock()
+
+	if j.f == nil {
+		return fmt.Errorf("journal is closed")
+	}
+
+	for _, entry := range entries {
+		if j.size >= j.maxSize {
+			return ErrJournalFull
+		}
+		n, err := writeJournalEntry(j.w, entry, j.maxSize-j.size)
+		if err != nil {
+			_ = j.w.Flush()
+			return err
+		}
+		j.size += n
+	}
+	return j.w.Flush()
+}
+
+// rotate atomically replaces the contents of the journal with entries obtained from the given
+// function. Entries that do not fit into the size limit are dropped.
+//
+// The function is called while holding the journal lock so that no entries can be appended
+// between obtaining the current entries and replacing the journal.
+func (j *journal) rotate(fn func() []*journalEntry) error {
+	j.l.Lock()
+	defer j.l.Unlock()
+
+	if j.f == nil {
+		return fmt.Errorf("journal is closed")
+	}
+
+	entries := fn()
+
+	tmpPath := j.path + ".new"
+	f, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create journal: %w", err)
+	}
+	w := bufio.NewWriter(f)
+
+	var (
+		size    uint64
+		dropped int
+	)
+	for _, entry := range entries {
+		n, err := writeJournalEntry(w, entry, j.maxSize-size)
+		switch {
+		case err == nil:
+			size += n
+		case errors.Is(err, ErrJournalFull):
+			dropped++
+		default:
+			f.Close()
+			return fmt.Errorf("failed to write journal: %w", err)
+		}
+	}
+	if err = w.Flush(); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write journal: %w", err)
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to sync journal: %w", err)
+	}
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("failed to close journal: %w", err)
+	}
+	if dropped > 0 {
+		j.logger.Warn("journal size limit reached, some transactions were not journaled",
+			"max_size", j.maxSize,
+			"num_dropped", dropped,
+		)
+	}
+
+	// Replace the old journal and reopen it for appending.
+	if err = os.Rename(tmpPath, j.path); err != nil {
+		return fmt.Errorf("failed to replace journal: %w", err)
+	}
+	j.f.Close()
+	if j.f, err = os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0o600); err != nil {
+		j.f = nil
+		return fmt.Errorf("failed to reopen journal: %w", err)
+	}
+	j.w = bufio.NewWriter(j.f)
+	j.size = size
+
+	j.logger.Debug("rotated journal",
+		"num_txs", len(entries)-dropped,
+		"size", size,
+	)
+
+	return nil
+}
+
+// close closes the journal.
+func (j *journal) close() {
+	j.l.Lock()
+	defer j.l.Unlock()
+
+	if j.f == nil {
+		return
+	}
+	_ = j.w.Flush()
+	_ = j.f.Close()
+	j.f = nil
+	j.w = nil
+}
+
+func newJournal(path string, maxSize uint64) *journal {
+	if maxSize == 0 {
+		maxSize = defaultMaxJournalSize
+	}
+
+	return &journal{
+		logger:  logging.GetLogger("runtime/txpool/journal"),
+		path:    path,
+		maxSize: maxSize,
+	}
+}
+
+// writeJournalEntry writes a single length-prefixed entry, returning the number of bytes written.
+// If the encoded entry would exceed the given limit, ErrJournalFull is returned and nothing is
+// written.
+func writeJournalEntry(w io.Writer, entry *journalEntry, limit uint64) (uint64, error) {
+	data := cbor.Marshal(entry)
+	n := uint64(journalRecordPrefixSize + len(data))
+	if n > limit {
+		return 0, ErrJournalFull
+	}
+
+	var prefix [journalRecordPrefixSize]byte
+	binary.BigEndian.PutUint32(prefix[:], uint32(len(data)))
+	if _, err := w.Write(prefix[:]); err != nil {
+		return 0, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// readJournalEntry reads a single length-prefixed entry, returning the number of bytes read.
+// If there are no more entries, io.EOF is returned.
+func readJournalEntry(r io.Reader, entry *journalEntry, limit uint64) (uint64, error) {
+	var prefix [journalRecordPrefixSize]byte
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return 0, fmt.Errorf("truncated entry length")
+		}
+		return 0, err
+	}
+	length := binary.BigEndian.Uint32(prefix[:])
+	if uint64(length) > limit {
+		return 0, fmt.Errorf("entry too large")
+	}
+
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return 0, fmt.Errorf("truncated entry: %w", err)
+	}
+	if err := cbor.Unmarshal(data, entry); err != nil {
+		return 0, fmt.Errorf("malformed entry: %w", err)
+	}
+	return uint64(journalRecordPrefixSize + len(data)), nil
+}
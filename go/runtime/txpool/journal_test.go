@@ -0,0 +1,108 @@
+package txpool
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal(t *testing.T) {
+	require := require.New(t)
+
+	path := filepath.Join(t.TempDir(), JournalFilename)
+	j := newJournal(path, 0)
+
+	entries, err := j.load()
+	require.NoError(err, "load from missing journal")
+	require.Len(entries, 0, "missing journal should be empty")
+
+	require.NoError(j.open(), "open")
+
+	var expected []*journalEntry
+	for i := 0; i < 10; i++ {
+		expected = append(expected, &journalEntry{
+			Local: i%2 == 0,
+			Raw:   []byte(fmt.Sprintf("tx %d", i)),
+		})
+	}
+	require.NoError(j.append(expected[:5]), "append")
+	require.NoError(j.append(expected[5:]), "append")
+	// Duplicates should be ignored on load.
+	require.NoError(j.append(expected[:1]), "append duplicate")
+	j.close()
+
+	j = newJournal(path, 0)
+	entries, err = j.load()
+	require.NoError(err, "load")
+	require.EqualValues(expected, entries, "loaded entries should match appended entries")
+
+	// Rotation should replace the journal contents.
+	require.NoError(j.open(), "open")
+	require.NoError(j.rotate(func() []*journalEntry {
+		return expected[8:]
+	}), "rotate")
+	require.NoError(j.append(expected[:1]), "append after rotate")
+	j.close()
+
+	entries, err = j.load()
+	require.NoError(err, "load")
+	require.EqualValues([]*journalEntry{expected[8], expected[9], expected[0]}, entries, "loaded entries should match rotated entries")
+}
+
+func TestJournalSizeLimit(t *testing.T) {
+	require := require.New(t)
+
+	path := filepath.Join(t.TempDir(), JournalFilename)
+	var entries []*journalEntry
+	for i := 0; i < 5; i++ {
+		entries = append(entries, &journalEntry{Raw: []byte(fmt.Sprintf("tx %d", i))})
+	}
+	entrySize, err := writeJournalEntry(io.Discard, entries[0], defaultMaxJournalSize)
+	require.NoError(err, "writeJournalEntry")
+
+	j := newJournal(path, 3*entrySize)
+	require.NoError(j.open(), "open")
+
+	err = j.append(entries)
+	require.ErrorIs(err, ErrJournalFull, "append over the size limit should fail")
+
+	// Rotation should drop entries that do not fit.
+	require.NoError(j.rotate(func() []*journalEntry {
+		return entries
+	}), "rotate")
+	j.close()
+
+	loaded, err := j.load()
+	require.NoError(err, "load")
+	require.EqualValues(entries[:3], loaded, "only entries within the size limit should be kept")
+}
+
+func TestJournalCorruptedTail(t *testing.T) {
+	require := require.New(t)
+
+	path := filepath.Join(t.TempDir(), JournalFilename)
+	j := newJournal(path, 0)
+	require.NoError(j.open(), "open")
+
+	entries := []*journalEntry{
+		{Raw: []byte("tx 1")},
+		{Raw: []byte("tx 2")},
+	}
+	require.NoError(j.append(entries), "append")
+	j.close()
+
+	// Simulate a crash while writing an entry.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	require.NoError(err, "OpenFile")
+	_, err = f.Write([]byte{0x00, 0x00, 0x10, 0x00, 0xa1})
+	require.NoError(err, "Write")
+	require.NoError(f.Close(), "Close")
+
+	loaded, err := j.load()
+	require.NoError(err, "load")
+	require.EqualValues(entries, loaded, "corrupted tail should be ignored")
+}
@@ -55,6 +55,12 @@ func (tx *MainQueueTransaction) SenderSeq() uint64 {
 	return tx.senderSeq
 }
 
+// SenderStateSeq returns the sequence number of the sender stored in runtime state as of when
+// the transaction was checked.
+func (tx *MainQueueTransaction) SenderStateSeq() uint64 {
+	return tx.senderStateSeq
+}
+
 // setChecked populates transaction data retrieved from checks.
 func (tx *MainQueueTransaction) setChecked(meta *protocol.CheckTxMetadata) {
 	if meta != nil {
@@ -72,20 +78,20 @@ func (tx *MainQueueTransaction) setChecked(meta *protocol.CheckTxMetadata) {
 	}
 }
 
-// mainQueue is a priority queue for transactions that we give no special treatment.
+// mainQueue is a queue for transactions that we give no special treatment. The ordering of the
+// transactions is determined by the configured schedule policy.
 type mainQueue struct {
-	// This implementation adapts the existing scheduleQueue code.
-	inner *scheduleQueue
+	inner SchedulePolicy
 }
 
-func newMainQueue(capacity int) *mainQueue {
+func newMainQueue(policy SchedulePolicy) *mainQueue {
 	return &mainQueue{
-		inner: newScheduleQueue(capacity),
+		inner: policy,
 	}
 }
 
 func (mq *mainQueue) GetSchedulingSuggestion(countHint uint32) []*TxQueueMeta {
-	txMetas := mq.inner.getPrioritizedBatch(nil, countHint)
+	txMetas := mq.inner.GetBatch(nil, countHint)
 	var txs []*TxQueueMeta
 	for _, txMeta := range txMetas {
 		txs = append(txs, &txMeta.TxQueueMeta)
@@ -94,7 +100,7 @@ func (mq *mainQueue) GetSchedulingSuggestion(countHint uint32) []*TxQueueMeta {
 }
 
 func (mq *mainQueue) GetTxByHash(h hash.Hash) *TxQueueMeta {
-	txMetas, _ := mq.inner.getKnownBatch([]hash.Hash{h})
+	txMetas, _ := mq.inner.GetKnownBatch([]hash.Hash{h})
 	if txMetas[0] == nil {
 		return nil
 	}
@@ -102,11 +108,11 @@ func (mq *mainQueue) GetTxByHash(h hash.Hash) *TxQueueMeta {
 }
 
 func (mq *mainQueue) HandleTxsUsed(hashes []hash.Hash) {
-	mq.inner.remove(hashes)
+	mq.inner.Remove(hashes)
 }
 
 func (mq *mainQueue) GetSchedulingExtra(offset *hash.Hash, limit uint32) []*TxQueueMeta {
-	txMetas := mq.inner.getPrioritizedBatch(offset, limit)
+	txMetas := mq.inner.GetBatch(offset, limit)
 	var txs []*TxQueueMeta
 	for _, txMeta := range txMetas {
 		txs = append(txs, &txMeta.TxQueueMeta)
@@ -115,8 +121,8 @@ func (mq *mainQueue) GetSchedulingExtra(offset *hash.Hash, limit uint32) []*TxQu
 }
 
 func (mq *mainQueue) TakeAll() []*TxQueueMeta {
-	txMetas := mq.inner.getAll()
-	mq.inner.clear()
+	txMetas := mq.inner.GetAll()
+	mq.inner.Clear()
 	var txs []*TxQueueMeta
 	for _, txMeta := range txMetas {
 		txs = append(txs, &txMeta.TxQueueMeta)
@@ -128,11 +134,11 @@ func (mq *mainQueue) OfferChecked(tx *TxQueueMeta, meta *protocol.CheckTxMetadat
 	txMeta := newTransaction(*tx)
 	txMeta.setChecked(meta)
 
-	return mq.inner.add(txMeta)
+	return mq.inner.Add(txMeta)
 }
 
 func (mq *mainQueue) GetTxsToPublish() []*TxQueueMeta {
-	txMetas := mq.inner.getAll()
+	txMetas := mq.inner.GetAll()
 	var txs []*TxQueueMeta
 	for _, txMeta := range txMetas {
 		txs = append(txs, &txMeta.TxQueueMeta)
@@ -51,6 +51,17 @@ func (rq *rimQueue) Load(inMsgs []*message.IncomingMessage) {
 	rq.txs = newTxs
 }
 
+func (rq *rimQueue) all() []*TxQueueMeta {
+	rq.l.RLock()
+	defer rq.l.RUnlock()
+
+	txs := make([]*TxQueueMeta, 0, len(rq.txs))
+	for _, tx := range rq.txs {
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
 func (rq *rimQueue) size() int {
 	rq.l.Lock()
 	defer rq.l.Unlock()
@@ -0,0 +1,108 @@
+package txpool
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
+)
+
+const (
+	// SchedulePolicyPriority is the name of the default schedule queue policy which orders
+	// transactions by priority and first seen time and allows one transaction per sender.
+	SchedulePolicyPriority = "priority"
+	// SchedulePolicySequence is the name of the schedule queue policy which allows multiple
+	// transactions per sender, ordered by their per-sender sequence numbers, and shares the
+	// batch fairly across senders.
+	SchedulePolicySequence = "sequence"
+)
+
+// SchedulePolicy is a transaction ordering policy of the main schedule queue.
+//
+// Implementations must be safe for concurrent use.
+type SchedulePolicy interface {
+	// Add adds a checked transaction to the queue.
+	Add(tx *MainQueueTransaction) error
+
+	// Remove removes the given transactions from the queue. Unknown transactions are ignored.
+	Remove(txHashes []hash.Hash)
+
+	// GetBatch returns up to limit transactions in scheduling order, starting after the given
+	// offset transaction (if specified). If the offset transaction does not exist, no transactions
+	// are returned.
+	GetBatch(offset *hash.Hash, limit uint32) []*MainQueueTransaction
+
+	// GetKnownBatch returns the transactions with the given hashes (or nil for unknown ones) and
+	// a map of missing transaction hashes to their indices in the given batch.
+	GetKnownBatch(batch []hash.Hash) ([]*MainQueueTransaction, map[hash.Hash]int)
+
+	// GetAll returns all transactions in the queue in no particular order.
+	GetAll() []*MainQueueTransaction
+
+	// Size returns the number of transactions in the queue.
+	Size() int
+
+	// Clear removes all transactions from the queue.
+	Clear()
+}
+
+// SchedulePolicyFactory is a function that creates a new schedule queue policy instance.
+type SchedulePolicyFactory func(cfg *Config) (SchedulePolicy, error)
+
+var (
+	schedulePoliciesLock sync.RWMutex
+	schedulePolicies     = map[string]SchedulePolicyFactory{
+		SchedulePolicyPriority: func(cfg *Config) (SchedulePolicy, error) {
+			return newScheduleQueue(int(cfg.MaxPoolSize)), nil
+		},
+		SchedulePolicySequence: func(cfg *Config) (SchedulePolicy, error) {
+			if cfg.MaxTxsPerSender == 0 {
+				return nil, fmt.Errorf("maximum number of transactions per sender must be non-zero")
+			}
+			return newSequenceQueue(int(cfg.MaxPoolSize), int(cfg.MaxTxsPerSender)), nil
+		},
+	}
+)
+
+// RegisterSchedulePolicy registers a new schedule queue policy under the given name.
+//
+// This method will panic in case a policy with the same name has already been registered.
+func RegisterSchedulePolicy(name string, factory SchedulePolicyFactory) {
+	schedulePoliciesLock.Lock()
+	defer schedulePoliciesLock.Unlock()
+
+	if _, exists := schedulePolicies[name]; exists {
+		panic(fmt.Errorf("txpool: schedule policy '%s' is already registered", name))
+	}
+	schedulePolicies[name] = factory
+}
+
+// SchedulePolicies returns the names of all registered schedule queue policies.
+func SchedulePolicies() []string {
+	schedulePoliciesLock.RLock()
+	defer schedulePoliciesLock.RUnlock()
+
+	names := make([]string, 0, len(schedulePolicies))
+	for name := range schedulePolicies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newSchedulePolicy creates a new schedule queue policy based on the configuration.
+func newSchedulePolicy(cfg *Config) (SchedulePolicy, error) {
+	name := cfg.SchedulePolicy
+	if name == "" {
+		name = SchedulePolicyPriority
+	}
+
+	schedulePoliciesLock.RLock()
+	factory, ok := schedulePolicies[name]
+	schedulePoliciesLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown schedule policy: %s", name)
+	}
+	return factory(cfg)
+}
@@ -35,6 +35,10 @@ func priorityLessFunc(tx, tx2 *MainQueueTransaction) bool {
 	return tx.FirstSeen().After(tx2.FirstSeen())
 }
 
+var _ SchedulePolicy = (*scheduleQueue)(nil)
+
+// scheduleQueue is the priority schedule queue policy. Transactions are ordered by priority and
+// first seen time and only one transaction per sender is allowed.
 type scheduleQueue struct {
 	l sync.Mutex
 
@@ -45,7 +49,7 @@ type scheduleQueue struct {
 	capacity int
 }
 
-func (sq *scheduleQueue) add(tx *MainQueueTransaction) error {
+func (sq *scheduleQueue) Add(tx *MainQueueTransaction) error {
 	sq.l.Lock()
 	defer sq.l.Unlock()
 
@@ -83,7 +87,7 @@ func (sq *scheduleQueue) removeLocked(tx *MainQueueTransaction) {
 	sq.byPriority.Delete(tx)
 }
 
-func (sq *scheduleQueue) remove(txHashes []hash.Hash) {
+func (sq *scheduleQueue) Remove(txHashes []hash.Hash) {
 	sq.l.Lock()
 	defer sq.l.Unlock()
 
@@ -97,7 +101,7 @@ func (sq *scheduleQueue) remove(txHashes []hash.Hash) {
 	}
 }
 
-func (sq *scheduleQueue) getPrioritizedBatch(offset *hash.Hash, limit uint32) []*MainQueueTransaction {
+func (sq *scheduleQueue) GetBatch(offset *hash.Hash, limit uint32) []*MainQueueTransaction {
 	sq.l.Lock()
 	defer sq.l.Unlock()
 
@@ -132,7 +136,7 @@ func (sq *scheduleQueue) getPrioritizedBatch(offset *hash.Hash, limit uint32) []
 	return batch
 }
 
-func (sq *scheduleQueue) getKnownBatch(batch []hash.Hash) ([]*MainQueueTransaction, map[hash.Hash]int) {
+func (sq *scheduleQueue) GetKnownBatch(batch []hash.Hash) ([]*MainQueueTransaction, map[hash.Hash]int) {
 	sq.l.Lock()
 	defer sq.l.Unlock()
 
@@ -149,7 +153,7 @@ func (sq *scheduleQueue) getKnownBatch(batch []hash.Hash) ([]*MainQueueTransacti
 	return result, missing
 }
 
-func (sq *scheduleQueue) getAll() []*MainQueueTransaction {
+func (sq *scheduleQueue) GetAll() []*MainQueueTransaction {
 	sq.l.Lock()
 	defer sq.l.Unlock()
 
@@ -160,14 +164,14 @@ func (sq *scheduleQueue) getAll() []*MainQueueTransaction {
 	return result
 }
 
-func (sq *scheduleQueue) size() int {
+func (sq *scheduleQueue) Size() int {
 	sq.l.Lock()
 	defer sq.l.Unlock()
 
 	return len(sq.all)
 }
 
-func (sq *scheduleQueue) clear() {
+func (sq *scheduleQueue) Clear() {
 	sq.l.Lock()
 	defer sq.l.Unlock()
 
@@ -30,46 +30,46 @@ func TestScheduleQueueBasic(t *testing.T) {
 
 	tx := newTestTransaction([]byte("hello world"), 0)
 
-	err := queue.add(tx)
+	err := queue.Add(tx)
 	require.NoError(err, "Add")
 
-	err = queue.add(tx)
+	err = queue.Add(tx)
 	require.Error(err, "Add error on duplicates")
 
 	// Add some more calls.
 	for i := 0; i < 50; i++ {
-		err = queue.add(
+		err = queue.Add(
 			newTestTransaction([]byte(fmt.Sprintf("call %d", i)), 0),
 		)
 		require.NoError(err, "Add")
 	}
 
-	err = queue.add(newTestTransaction([]byte("another call"), 0))
+	err = queue.Add(newTestTransaction([]byte("another call"), 0))
 	require.Error(err, "Add error on queue full")
 
-	require.EqualValues(51, queue.size(), "Size")
+	require.EqualValues(51, queue.Size(), "Size")
 
-	batch := queue.getPrioritizedBatch(nil, 10)
+	batch := queue.GetBatch(nil, 10)
 	require.EqualValues(10, len(batch), "Batch size")
-	require.EqualValues(51, queue.size(), "Size")
+	require.EqualValues(51, queue.Size(), "Size")
 
 	hashes := make([]hash.Hash, 0, len(batch))
 	for _, tx := range batch {
 		hashes = append(hashes, tx.Hash())
 		hashes = append(hashes, tx.Hash()) // Duplicate to ensure this is handled correctly.
 	}
-	queue.remove(hashes)
-	require.EqualValues(41, queue.size(), "Size")
+	queue.Remove(hashes)
+	require.EqualValues(41, queue.Size(), "Size")
 
-	queue.clear()
-	require.EqualValues(0, queue.size(), "Size")
+	queue.Clear()
+	require.EqualValues(0, queue.Size(), "Size")
 }
 
 func TestScheduleQueueRemoveTxBatch(t *testing.T) {
 	require := require.New(t)
 
 	queue := newScheduleQueue(51)
-	queue.remove([]hash.Hash{})
+	queue.Remove([]hash.Hash{})
 
 	for _, tx := range []*MainQueueTransaction{
 		newTestTransaction([]byte("hello world"), 0),
@@ -77,23 +77,23 @@ func TestScheduleQueueRemoveTxBatch(t *testing.T) {
 		newTestTransaction([]byte("two"), 0),
 		newTestTransaction([]byte("three"), 0),
 	} {
-		require.NoError(queue.add(tx), "Add")
+		require.NoError(queue.Add(tx), "Add")
 	}
-	require.EqualValues(4, queue.size(), "Size")
+	require.EqualValues(4, queue.Size(), "Size")
 
-	queue.remove([]hash.Hash{})
-	require.EqualValues(4, queue.size(), "Size")
+	queue.Remove([]hash.Hash{})
+	require.EqualValues(4, queue.Size(), "Size")
 
-	queue.remove([]hash.Hash{
+	queue.Remove([]hash.Hash{
 		hash.NewFromBytes([]byte("hello world")),
 		hash.NewFromBytes([]byte("two")),
 	})
-	require.EqualValues(2, queue.size(), "Size")
+	require.EqualValues(2, queue.Size(), "Size")
 
-	queue.remove([]hash.Hash{
+	queue.Remove([]hash.Hash{
 		hash.NewFromBytes([]byte("hello world")),
 	})
-	require.EqualValues(2, queue.size(), "Size")
+	require.EqualValues(2, queue.Size(), "Size")
 }
 
 func TestScheduleQueuePriority(t *testing.T) {
@@ -116,10 +116,10 @@ func TestScheduleQueuePriority(t *testing.T) {
 		),
 	}
 	for _, tx := range txs {
-		require.NoError(queue.add(tx), "Add")
+		require.NoError(queue.Add(tx), "Add")
 	}
 
-	batch := queue.getPrioritizedBatch(nil, 2)
+	batch := queue.GetBatch(nil, 2)
 	require.Len(batch, 2, "two transactions should be returned")
 	require.EqualValues(
 		[]*MainQueueTransaction{
@@ -131,7 +131,7 @@ func TestScheduleQueuePriority(t *testing.T) {
 	)
 
 	offsetTx := txs[2].Hash()
-	batch = queue.getPrioritizedBatch(&offsetTx, 2)
+	batch = queue.GetBatch(&offsetTx, 2)
 	require.Len(batch, 2, "two transactions should be returned")
 	require.EqualValues(
 		[]*MainQueueTransaction{
@@ -143,7 +143,7 @@ func TestScheduleQueuePriority(t *testing.T) {
 	)
 
 	offsetTx.Empty()
-	batch = queue.getPrioritizedBatch(&offsetTx, 2)
+	batch = queue.GetBatch(&offsetTx, 2)
 	require.Len(batch, 0, "no transactions should be returned on invalid hash")
 
 	// When the pool is full, a higher priority transaction should still get queued.
@@ -151,10 +151,10 @@ func TestScheduleQueuePriority(t *testing.T) {
 		[]byte("hello world 6"),
 		6,
 	)
-	err := queue.add(highTx)
+	err := queue.Add(highTx)
 	require.NoError(err, "higher priority transaction should still get queued")
 
-	batch = queue.getPrioritizedBatch(nil, 3)
+	batch = queue.GetBatch(nil, 3)
 	require.Len(batch, 3, "three transactions should be returned")
 	require.EqualValues(
 		[]*MainQueueTransaction{
@@ -171,7 +171,7 @@ func TestScheduleQueuePriority(t *testing.T) {
 		[]byte("hello world 3"),
 		3,
 	)
-	err = queue.add(lowTx)
+	err = queue.Add(lowTx)
 	require.Error(err, "lower priority transaction should not get queued")
 	require.Equal(ErrQueueFull, err)
 }
@@ -189,7 +189,7 @@ func TestScheduleQueueSender(t *testing.T) {
 	tx := newTestTransaction([]byte("hello world s1 p0"), 0)
 	tx.sender = sender1
 
-	err := queue.add(tx)
+	err := queue.Add(tx)
 	require.NoError(err, "Add")
 
 	tx = newTestTransaction([]byte("hello world s2 p0"), 0)
@@ -198,18 +198,18 @@ func TestScheduleQueueSender(t *testing.T) {
 	tx = newTestTransaction([]byte("hello worldd s1 p0"), 0)
 	tx.sender = sender1
 
-	err = queue.add(tx)
+	err = queue.Add(tx)
 	require.Error(err, "Add")
 	require.Equal(ErrReplacementTxPriorityTooLow, err)
-	require.Equal(1, queue.size())
+	require.Equal(1, queue.Size())
 
 	tx = newTestTransaction([]byte("hello world 2"), 10)
 	tx.sender = sender1
 
-	err = queue.add(tx)
+	err = queue.Add(tx)
 	require.NoError(err, "Add")
-	require.Equal(1, queue.size())
+	require.Equal(1, queue.Size())
 
-	queue.remove([]hash.Hash{tx.Hash()})
-	require.Equal(0, queue.size())
+	queue.Remove([]hash.Hash{tx.Hash()})
+	require.Equal(0, queue.Size())
 }
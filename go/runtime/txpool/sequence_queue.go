@@ -0,0 +1,343 @@
+package txpool
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/google/btree"
+
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
+)
+
+var (
+	// ErrSenderQueueFull is the error returned when a transaction is rejected because the sender
+	// already has the maximum number of transactions with lower sequence numbers in the queue.
+	ErrSenderQueueFull = errors.New("txpool: sender queue is full")
+	// ErrSenderSeqTooLow is the error returned when a transaction is rejected because its sequence
+	// number is lower than the sender's current sequence number stored in runtime state or has
+	// already been used by a previously scheduled transaction.
+	ErrSenderSeqTooLow = errors.New("txpool: sender sequence number too low")
+)
+
+var _ SchedulePolicy = (*sequenceQueue)(nil)
+
+// sequenceLessFunc is a comparison function for ordering transactions by priority which breaks
+// ties using transaction hashes so that distinct transactions are never considered equal.
+func sequenceLessFunc(tx, tx2 *MainQueueTransaction) bool {
+	if priorityLessFunc(tx, tx2) {
+		return true
+	}
+	if priorityLessFunc(tx2, tx) {
+		return false
+	}
+	h1, h2 := tx.Hash(), tx2.Hash()
+	return bytes.Compare(h1[:], h2[:]) < 0
+}
+
+// senderQueue is a queue of transactions from a single sender, ordered by sequence number.
+//
+// Sender queues are kept even when empty so that the expected sequence number is not lost once
+// all of the sender's transactions have been used.
+type senderQueue struct {
+	txs []*MainQueueTransaction
+
+	// nextSeq is the expected sequence number of the next transaction from the sender.
+	nextSeq uint64
+}
+
+// find returns the index of the first transaction with a sequence number greater or equal to the
+// given sequence number.
+func (q *senderQueue) find(seq uint64) int {
+	return sort.Search(len(q.txs), func(i int) bool {
+		return q.txs[i].senderSeq >= seq
+	})
+}
+
+// tail returns the transaction with the highest sequence number.
+func (q *senderQueue) tail() *MainQueueTransaction {
+	return q.txs[len(q.txs)-1]
+}
+
+// schedulable returns the run of transactions with consecutive sequence numbers starting at the
+// transaction with the expected sequence number. In case the transaction with the lowest sequence
+// number is not the expected one, no transactions are schedulable.
+func (q *senderQueue) schedulable() []*MainQueueTransaction {
+	if len(q.txs) == 0 || q.txs[0].senderSeq != q.nextSeq {
+		return nil
+	}
+
+	n := 1
+	for ; n < len(q.txs); n++ {
+		if q.txs[n].senderSeq != q.txs[n-1].senderSeq+1 {
+			break
+		}
+	}
+	return q.txs[:n]
+}
+
+// sequenceQueue is the sequence schedule queue policy.
+//
+// Multiple transactions per sender are allowed and are scheduled in order of their per-sender
+// sequence numbers. Only transactions with consecutive sequence numbers are scheduled, gaps need
+// to be filled before any later transactions from the same sender are scheduled.
+//
+// To share the batch fairly across senders, batches are assembled in rounds where each round takes
+// the next transaction of every sender. Within a round, senders are ordered by the priority of
+// their next transaction.
+type sequenceQueue struct {
+	l sync.Mutex
+
+	all map[hash.Hash]*MainQueueTransaction
+	// bySender contains the queues of all senders, including empty ones. Empty queues are only
+	// pruned once the number of senders exceeds the queue capacity.
+	bySender map[string]*senderQueue
+	// tails contains the transaction with the highest sequence number of each sender. These are
+	// the only transactions that can be evicted without creating gaps.
+	tails *btree.BTreeG[*MainQueueTransaction]
+
+	capacity        int
+	maxTxsPerSender int
+}
+
+func (sq *sequenceQueue) Add(tx *MainQueueTransaction) error {
+	sq.l.Lock()
+	defer sq.l.Unlock()
+
+	if tx.senderSeq < tx.senderStateSeq {
+		return ErrSenderSeqTooLow
+	}
+
+	q := sq.bySender[tx.sender]
+	if q != nil {
+		if tx.senderStateSeq > q.nextSeq {
+			q.nextSeq = tx.senderStateSeq
+		}
+		if tx.senderSeq < q.nextSeq {
+			return ErrSenderSeqTooLow
+		}
+
+		// Remove any transactions that are no longer valid based on sequence numbers.
+		for len(q.txs) > 0 && q.txs[0].senderSeq < q.nextSeq {
+			sq.removeLocked(q.txs[0])
+		}
+
+		// If a transaction with the same sequence number already exists, we accept a new
+		// transaction only if it has a higher priority.
+		if idx := q.find(tx.senderSeq); idx < len(q.txs) && q.txs[idx].senderSeq == tx.senderSeq {
+			etx := q.txs[idx]
+			if tx.priority <= etx.priority {
+				return ErrReplacementTxPriorityTooLow
+			}
+			sq.removeLocked(etx)
+		}
+
+		// If the sender queue is full, we accept a new transaction only if it comes before the
+		// transaction with the highest sequence number, which is evicted.
+		if len(q.txs) >= sq.maxTxsPerSender {
+			etx := q.tail()
+			if tx.senderSeq > etx.senderSeq {
+				return ErrSenderQueueFull
+			}
+			sq.removeLocked(etx)
+		}
+	}
+
+	// If the queue is full, we accept a new transaction only if it has a higher priority than the
+	// lowest priority transaction that can be evicted.
+	if len(sq.all) >= sq.capacity {
+		etx, _ := sq.tails.Min()
+		if etx == nil || tx.priority <= etx.priority {
+			return ErrQueueFull
+		}
+		sq.removeLocked(etx)
+	}
+
+	sq.insertLocked(tx)
+
+	return nil
+}
+
+func (sq *sequenceQueue) insertLocked(tx *MainQueueTransaction) {
+	q := sq.bySender[tx.sender]
+	if q == nil {
+		if len(sq.bySender) >= sq.capacity {
+			sq.pruneSendersLocked()
+		}
+
+		q = &senderQueue{
+			nextSeq: tx.senderStateSeq,
+		}
+		sq.bySender[tx.sender] = q
+	}
+
+	idx := q.find(tx.senderSeq)
+	if idx == len(q.txs) {
+		// The transaction becomes the new tail.
+		if len(q.txs) > 0 {
+			sq.tails.Delete(q.tail())
+		}
+		sq.tails.ReplaceOrInsert(tx)
+	}
+	q.txs = append(q.txs, nil)
+	copy(q.txs[idx+1:], q.txs[idx:])
+	q.txs[idx] = tx
+
+	sq.all[tx.Hash()] = tx
+}
+
+func (sq *sequenceQueue) removeLocked(tx *MainQueueTransaction) {
+	delete(sq.all, tx.Hash())
+
+	q := sq.bySender[tx.sender]
+	idx := q.find(tx.senderSeq)
+	isTail := idx == len(q.txs)-1
+	q.txs = append(q.txs[:idx], q.txs[idx+1:]...)
+
+	if isTail {
+		sq.tails.Delete(tx)
+		if len(q.txs) > 0 {
+			sq.tails.ReplaceOrInsert(q.tail())
+		}
+	}
+}
+
+// pruneSendersLocked removes the queues of all senders without any transactions.
+func (sq *sequenceQueue) pruneSendersLocked() {
+	for sender, q := range sq.bySender {
+		if len(q.txs) == 0 {
+			delete(sq.bySender, sender)
+		}
+	}
+}
+
+func (sq *sequenceQueue) Remove(txHashes []hash.Hash) {
+	sq.l.Lock()
+	defer sq.l.Unlock()
+
+	for _, txHash := range txHashes {
+		tx, exists := sq.all[txHash]
+		if !exists {
+			continue
+		}
+
+		// Transactions are removed once they have been used, so the sender's sequence number has
+		// been incremented.
+		if q := sq.bySender[tx.sender]; tx.senderSeq >= q.nextSeq {
+			q.nextSeq = tx.senderSeq + 1
+		}
+
+		sq.removeLocked(tx)
+	}
+}
+
+// orderedLocked returns all schedulable transactions in scheduling order.
+func (sq *sequenceQueue) orderedLocked() []*MainQueueTransaction {
+	runs := make([][]*MainQueueTransaction, 0, len(sq.bySender))
+	for _, q := range sq.bySender {
+		if run := q.schedulable(); len(run) > 0 {
+			runs = append(runs, run)
+		}
+	}
+	// Order senders by the priority of their next transaction (descending).
+	sort.Slice(runs, func(i, j int) bool {
+		return sequenceLessFunc(runs[j][0], runs[i][0])
+	})
+
+	var ordered []*MainQueueTransaction
+	for round := 0; len(runs) > 0; round++ {
+		remaining := runs[:0]
+		for _, run := range runs {
+			ordered = append(ordered, run[round])
+			if len(run) > round+1 {
+				remaining = append(remaining, run)
+			}
+		}
+		runs = remaining
+	}
+	return ordered
+}
+
+func (sq *sequenceQueue) GetBatch(offset *hash.Hash, limit uint32) []*MainQueueTransaction {
+	sq.l.Lock()
+	defer sq.l.Unlock()
+
+	if offset != nil {
+		if _, exists := sq.all[*offset]; !exists {
+			// Offset does not exist so no items will be matched anyway.
+			return nil
+		}
+	}
+
+	ordered := sq.orderedLocked()
+	if offset != nil {
+		start := len(ordered)
+		for i, tx := range ordered {
+			h := tx.Hash()
+			if h.Equal(offset) {
+				start = i + 1
+				break
+			}
+		}
+		ordered = ordered[start:]
+	}
+	if uint32(len(ordered)) > limit {
+		ordered = ordered[:limit]
+	}
+	return ordered
+}
+
+func (sq *sequenceQueue) GetKnownBatch(batch []hash.Hash) ([]*MainQueueTransaction, map[hash.Hash]int) {
+	sq.l.Lock()
+	defer sq.l.Unlock()
+
+	result := make([]*MainQueueTransaction, 0, len(batch))
+	missing := make(map[hash.Hash]int)
+	for index, txHash := range batch {
+		if tx, ok := sq.all[txHash]; ok {
+			result = append(result, tx)
+		} else {
+			result = append(result, nil)
+			missing[txHash] = index
+		}
+	}
+	return result, missing
+}
+
+func (sq *sequenceQueue) GetAll() []*MainQueueTransaction {
+	sq.l.Lock()
+	defer sq.l.Unlock()
+
+	result := make([]*MainQueueTransaction, 0, len(sq.all))
+	for _, tx := range sq.all {
+		result = append(result, tx)
+	}
+	return result
+}
+
+func (sq *sequenceQueue) Size() int {
+	sq.l.Lock()
+	defer sq.l.Unlock()
+
+	return len(sq.all)
+}
+
+func (sq *sequenceQueue) Clear() {
+	sq.l.Lock()
+	defer sq.l.Unlock()
+
+	sq.all = make(map[hash.Hash]*MainQueueTransaction)
+	sq.bySender = make(map[string]*senderQueue)
+	sq.tails.Clear(true)
+}
+
+func newSequenceQueue(capacity, maxTxsPerSender int) *sequenceQueue {
+	return &sequenceQueue{
+		all:             make(map[hash.Hash]*MainQueueTransaction),
+		bySender:        make(map[string]*senderQueue),
+		tails:           btree.NewG[*MainQueueTransaction](2, sequenceLessFunc),
+		capacity:        capacity,
+		maxTxsPerSender: maxTxsPerSender,
+	}
+}
@@ -0,0 +1,250 @@
+package txpool
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oasisprotocol/oasis-core/go/common/crypto/hash"
+)
+
+func newTestSequenceTransaction(sender string, seq, priority uint64) *MainQueueTransaction {
+	tx := newTestTransaction([]byte(fmt.Sprintf("%s seq %d prio %d", sender, seq, priority)), priority)
+	tx.sender = sender
+	tx.senderSeq = seq
+	return tx
+}
+
+func TestSequenceQueueBasic(t *testing.T) {
+	require := require.New(t)
+
+	queue := newSequenceQueue(51, 100)
+
+	tx := newTestSequenceTransaction("sender", 0, 0)
+
+	err := queue.Add(tx)
+	require.NoError(err, "Add")
+
+	err = queue.Add(tx)
+	require.Error(err, "Add error on duplicates")
+
+	// Add some more transactions from the same sender.
+	for i := uint64(1); i <= 50; i++ {
+		err = queue.Add(newTestSequenceTransaction("sender", i, 0))
+		require.NoError(err, "Add")
+	}
+
+	err = queue.Add(newTestSequenceTransaction("another sender", 0, 0))
+	require.Error(err, "Add error on queue full")
+	require.Equal(ErrQueueFull, err)
+
+	require.EqualValues(51, queue.Size(), "Size")
+
+	batch := queue.GetBatch(nil, 10)
+	require.EqualValues(10, len(batch), "Batch size")
+	require.EqualValues(51, queue.Size(), "Size")
+	for i, tx := range batch {
+		require.EqualValues(i, tx.SenderSeq(), "transactions should be ordered by sequence number")
+	}
+
+	hashes := make([]hash.Hash, 0, len(batch))
+	for _, tx := range batch {
+		hashes = append(hashes, tx.Hash())
+		hashes = append(hashes, tx.Hash()) // Duplicate to ensure this is handled correctly.
+	}
+	queue.Remove(hashes)
+	require.EqualValues(41, queue.Size(), "Size")
+
+	batch = queue.GetBatch(nil, 1)
+	require.Len(batch, 1, "one transaction should be returned")
+	require.EqualValues(10, batch[0].SenderSeq(), "next transaction should be scheduled")
+
+	queue.Clear()
+	require.EqualValues(0, queue.Size(), "Size")
+}
+
+func TestSequenceQueueReplacement(t *testing.T) {
+	require := require.New(t)
+
+	queue := newSequenceQueue(10, 10)
+
+	tx := newTestSequenceTransaction("sender", 1, 5)
+	tx.senderStateSeq = 1
+	require.NoError(queue.Add(tx), "Add")
+
+	err := queue.Add(newTestSequenceTransaction("sender", 1, 4))
+	require.Error(err, "lower priority replacement should fail")
+	require.Equal(ErrReplacementTxPriorityTooLow, err)
+
+	replacementTx := newTestSequenceTransaction("sender", 1, 10)
+	replacementTx.senderStateSeq = 1
+	require.NoError(queue.Add(replacementTx), "higher priority replacement should work")
+	require.Equal(1, queue.Size())
+
+	batch := queue.GetBatch(nil, 10)
+	require.EqualValues([]*MainQueueTransaction{replacementTx}, batch)
+
+	// Transactions with sequence numbers below the sender state should be rejected.
+	staleTx := newTestSequenceTransaction("sender", 1, 20)
+	staleTx.senderStateSeq = 2
+	err = queue.Add(staleTx)
+	require.Error(err, "stale transaction should be rejected")
+	require.Equal(ErrSenderSeqTooLow, err)
+
+	// Transactions that became stale should be removed.
+	newTx := newTestSequenceTransaction("sender", 2, 0)
+	newTx.senderStateSeq = 2
+	require.NoError(queue.Add(newTx), "Add")
+	require.Equal(1, queue.Size(), "stale transactions should be removed")
+}
+
+func TestSequenceQueueSenderSeq(t *testing.T) {
+	require := require.New(t)
+
+	queue := newSequenceQueue(10, 10)
+
+	// Transactions not starting at the sender's current sequence number should not be scheduled.
+	tx1 := newTestSequenceTransaction("sender", 1, 0)
+	tx2 := newTestSequenceTransaction("sender", 2, 0)
+	require.NoError(queue.Add(tx1), "Add")
+	require.NoError(queue.Add(tx2), "Add")
+
+	batch := queue.GetBatch(nil, 10)
+	require.Len(batch, 0, "transactions with a gap at the sender sequence number should not be scheduled")
+
+	tx0 := newTestSequenceTransaction("sender", 0, 0)
+	require.NoError(queue.Add(tx0), "Add")
+
+	batch = queue.GetBatch(nil, 10)
+	require.EqualValues([]*MainQueueTransaction{tx0, tx1, tx2}, batch)
+
+	// Removing used transactions should advance the expected sequence number.
+	queue.Remove([]hash.Hash{tx0.Hash(), tx1.Hash()})
+	batch = queue.GetBatch(nil, 10)
+	require.EqualValues([]*MainQueueTransaction{tx2}, batch)
+
+	// Transactions checked against a newer sender state should advance the expected sequence number.
+	tx4 := newTestSequenceTransaction("sender", 4, 0)
+	require.NoError(queue.Add(tx4), "Add")
+	batch = queue.GetBatch(nil, 10)
+	require.EqualValues([]*MainQueueTransaction{tx2}, batch, "transactions after a gap should not be scheduled")
+
+	tx3 := newTestSequenceTransaction("sender", 3, 0)
+	tx3.senderStateSeq = 3
+	require.NoError(queue.Add(tx3), "Add")
+	batch = queue.GetBatch(nil, 10)
+	require.EqualValues([]*MainQueueTransaction{tx3, tx4}, batch)
+}
+
+func TestSequenceQueueSenderSeqAfterRemove(t *testing.T) {
+	require := require.New(t)
+
+	queue := newSequenceQueue(10, 10)
+
+	tx0 := newTestSequenceTransaction("sender", 0, 0)
+	require.NoError(queue.Add(tx0), "Add")
+	batch := queue.GetBatch(nil, 10)
+	require.EqualValues([]*MainQueueTransaction{tx0}, batch)
+
+	// Removing the last transaction of a sender should not reset the expected sequence number.
+	queue.Remove([]hash.Hash{tx0.Hash()})
+	require.Equal(0, queue.Size(), "Size")
+
+	// Transactions checked against the sender state before the removed transaction was used
+	// should be scheduled in case they have the next sequence number.
+	tx1 := newTestSequenceTransaction("sender", 1, 0)
+	require.NoError(queue.Add(tx1), "Add")
+	batch = queue.GetBatch(nil, 10)
+	require.EqualValues([]*MainQueueTransaction{tx1}, batch, "transaction with the next sequence number should be scheduled")
+
+	// Transactions with already used sequence numbers should be rejected.
+	err := queue.Add(newTestSequenceTransaction("sender", 0, 10))
+	require.Error(err, "transaction with a used sequence number should be rejected")
+	require.Equal(ErrSenderSeqTooLow, err)
+
+	// Empty sender queues should be pruned once the number of senders exceeds the capacity.
+	queue.Remove([]hash.Hash{tx1.Hash()})
+	for i := 0; i < 10; i++ {
+		require.NoError(queue.Add(newTestSequenceTransaction(fmt.Sprintf("sender %d", i), 0, 0)), "Add")
+	}
+	require.Equal(10, queue.Size(), "Size")
+	require.Len(queue.bySender, 10, "empty sender queues should be pruned")
+}
+
+func TestSequenceQueueSenderLimit(t *testing.T) {
+	require := require.New(t)
+
+	queue := newSequenceQueue(10, 2)
+
+	tx0 := newTestSequenceTransaction("sender", 0, 0)
+	tx2 := newTestSequenceTransaction("sender", 2, 0)
+	require.NoError(queue.Add(tx0), "Add")
+	require.NoError(queue.Add(tx2), "Add")
+
+	// Transactions with a gap should not be scheduled.
+	batch := queue.GetBatch(nil, 10)
+	require.EqualValues([]*MainQueueTransaction{tx0}, batch, "only consecutive transactions should be scheduled")
+
+	err := queue.Add(newTestSequenceTransaction("sender", 3, 0))
+	require.Error(err, "Add error on sender queue full")
+	require.Equal(ErrSenderQueueFull, err)
+
+	// Filling the gap should evict the transaction with the highest sequence number.
+	tx1 := newTestSequenceTransaction("sender", 1, 0)
+	require.NoError(queue.Add(tx1), "Add")
+	require.Equal(2, queue.Size())
+
+	batch = queue.GetBatch(nil, 10)
+	require.EqualValues([]*MainQueueTransaction{tx0, tx1}, batch)
+}
+
+func TestSequenceQueueFairShare(t *testing.T) {
+	require := require.New(t)
+
+	queue := newSequenceQueue(10, 10)
+
+	a0 := newTestSequenceTransaction("a", 0, 10)
+	a1 := newTestSequenceTransaction("a", 1, 10)
+	a2 := newTestSequenceTransaction("a", 2, 10)
+	b0 := newTestSequenceTransaction("b", 0, 5)
+	b1 := newTestSequenceTransaction("b", 1, 50)
+	c0 := newTestSequenceTransaction("c", 0, 20)
+	for _, tx := range []*MainQueueTransaction{a2, a1, a0, b1, b0, c0} {
+		require.NoError(queue.Add(tx), "Add")
+	}
+
+	expected := []*MainQueueTransaction{
+		// First round, ordered by priority of the next transaction of each sender.
+		c0, a0, b0,
+		// Second round.
+		a1, b1,
+		// Third round.
+		a2,
+	}
+	batch := queue.GetBatch(nil, 10)
+	require.EqualValues(expected, batch, "transactions should be shared fairly across senders")
+
+	offset := a0.Hash()
+	batch = queue.GetBatch(&offset, 2)
+	require.EqualValues(expected[2:4], batch, "offset should be respected")
+
+	offset.Empty()
+	batch = queue.GetBatch(&offset, 2)
+	require.Len(batch, 0, "no transactions should be returned on invalid hash")
+
+	// When the pool is full, a higher priority transaction should evict the lowest priority
+	// transaction with the highest sequence number of its sender.
+	queue = newSequenceQueue(3, 10)
+	for _, tx := range []*MainQueueTransaction{a0, a1, c0} {
+		require.NoError(queue.Add(tx), "Add")
+	}
+	err := queue.Add(b0)
+	require.Error(err, "lower priority transaction should not get queued")
+	require.Equal(ErrQueueFull, err)
+
+	require.NoError(queue.Add(b1), "higher priority transaction should get queued")
+	_, missing := queue.GetKnownBatch([]hash.Hash{a0.Hash(), a1.Hash()})
+	require.Len(missing, 1, "one transaction should be evicted")
+	require.Contains(missing, a1.Hash(), "transaction with the highest sequence number should be evicted")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -41,6 +42,10 @@ const (
 	// case when the maxRepublishTxs limit is reached. This should be much shorter than the
 	// RepublishInterval.
 	republishLimitReinvokeTimeout = 1 * time.Second
+
+	// journalRotateInterval is the interval at which the transaction journal is rewritten with
+	// the current contents of the local and main queues.
+	journalRotateInterval = 1 * time.Minute
 )
 
 // Config is the transaction pool configuration.
@@ -54,6 +59,20 @@ type Config struct {
 	// RecheckInterval is the interval (in rounds) when any pending transactions are subject to a
 	// recheck and any non-passing transactions are removed.
 	RecheckInterval uint64
+
+	// SchedulePolicy is the name of the schedule queue policy that determines the ordering of
+	// transactions. If empty, the priority policy is used.
+	SchedulePolicy string
+	// MaxTxsPerSender is the maximum number of transactions per sender in the schedule queue for
+	// policies that allow multiple transactions per sender.
+	MaxTxsPerSender uint64
+
+	// JournalPath is the path to the on-disk journal of local and scheduled transactions, which is
+	// reloaded and rechecked on startup. If empty, the journal is disabled.
+	JournalPath string
+	// MaxJournalSize is the maximum size (in bytes) of the transaction journal. Transactions that
+	// do not fit are not journaled. If zero, a default limit is used.
+	MaxJournalSize uint64
 }
 
 // TransactionMeta contains the per-transaction metadata.
@@ -139,6 +158,9 @@ type TransactionPool interface {
 
 	// PendingCheckSize returns the number of transactions currently pending to be checked.
 	PendingCheckSize() int
+
+	// Inspect returns a snapshot of the transactions currently in the transaction pool.
+	Inspect() *Snapshot
 }
 
 // RuntimeHostProvisioner is a runtime host provisioner.
@@ -216,13 +238,25 @@ type txPool struct {
 	lastRecheckRound   uint64
 
 	republishCh *channels.RingChannel
+
+	journal         *journal
+	journalRotateCh *channels.RingChannel
 }
 
 func (t *txPool) Start() error {
+	if t.journal != nil {
+		if err := t.reloadJournal(); err != nil {
+			return err
+		}
+	}
+
 	go t.checkWorker()
 	go t.republishWorker()
 	go t.recheckWorker()
 	go t.flushWorker()
+	if t.journal != nil {
+		go t.journalWorker()
+	}
 	return nil
 }
 
@@ -373,7 +407,7 @@ func (t *txPool) HandleTxsUsed(hashes []hash.Hash) {
 		q.HandleTxsUsed(hashes)
 	}
 
-	mainQueueSize.With(t.getMetricLabels()).Set(float64(t.mainQueue.inner.size()))
+	mainQueueSize.With(t.getMetricLabels()).Set(float64(t.mainQueue.inner.Size()))
 	localQueueSize.With(t.getMetricLabels()).Set(float64(t.localQueue.size()))
 }
 
@@ -500,6 +534,7 @@ func (t *txPool) checkTxBatch(ctx context.Context, rr host.RichRuntime) {
 	if len(batch) == 0 {
 		return
 	}
+	defer t.checkTxQueue.done()
 
 	results, err := func() ([]protocol.CheckTxResult, error) {
 		checkCtx, cancel := context.WithTimeout(ctx, checkTxTimeout)
@@ -610,6 +645,7 @@ func (t *txPool) checkTxBatch(ctx context.Context, rr host.RichRuntime) {
 	)
 
 	// Queue checked transactions for scheduling.
+	var journalEntries []*journalEntry
 	for i, pct := range goodPcts {
 		if err = pct.dstQueue.OfferChecked(pct.TxQueueMeta, results[batchIndices[i]].Meta); err != nil {
 			t.logger.Error("unable to queue transaction for scheduling",
@@ -643,9 +679,20 @@ func (t *txPool) checkTxBatch(ctx context.Context, rr host.RichRuntime) {
 			// Put cannot fail as seenCache's LRU capacity is not in bytes and the only case where it
 			// can error is if the capacity is in bytes and the value size is over capacity.
 			_ = t.seenCache.Put(pct.Hash(), publishTime)
+
+			if t.journal != nil {
+				journalEntries = append(journalEntries, &journalEntry{
+					Local: pct.dstQueue == t.localQueue,
+					Raw:   pct.Raw(),
+				})
+			}
 		}
 	}
 
+	if len(journalEntries) > 0 {
+		t.appendJournal(journalEntries)
+	}
+
 	if len(newTxs) != 0 {
 		// Kick off publishing for any new txs after waiting for block publish delay based on when
 		// we received the block that we just used to check the transaction batch.
@@ -659,7 +706,7 @@ func (t *txPool) checkTxBatch(ctx context.Context, rr host.RichRuntime) {
 		t.schedulerNotifier.Broadcast(false)
 	}
 
-	mainQueueSize.With(t.getMetricLabels()).Set(float64(t.mainQueue.inner.size()))
+	mainQueueSize.With(t.getMetricLabels()).Set(float64(t.mainQueue.inner.Size()))
 	localQueueSize.With(t.getMetricLabels()).Set(float64(t.localQueue.size()))
 }
 
@@ -865,7 +912,7 @@ func (t *txPool) recheck() {
 			results = append(results, notifyCh)
 		}
 	}
-	mainQueueSize.With(t.getMetricLabels()).Set(float64(t.mainQueue.inner.size()))
+	mainQueueSize.With(t.getMetricLabels()).Set(float64(t.mainQueue.inner.Size()))
 	localQueueSize.With(t.getMetricLabels()).Set(float64(t.localQueue.size()))
 
 	if len(pcts) == 0 {
@@ -910,6 +957,121 @@ func (t *txPool) flushWorker() {
 	}
 }
 
+// reloadJournal loads the transactions stored in the journal and queues them for checks before
+// they are scheduled.
+func (t *txPool) reloadJournal() error {
+	entries, err := t.journal.load()
+	if err != nil {
+		return fmt.Errorf("failed to load transaction journal: %w", err)
+	}
+	if err = t.journal.open(); err != nil {
+		return fmt.Errorf("failed to open transaction journal: %w", err)
+	}
+
+	t.logger.Info("reloading transactions from journal",
+		"num_txs", len(entries),
+	)
+
+	for _, entry := range entries {
+		pct := &PendingCheckTransaction{
+			TxQueueMeta: &TxQueueMeta{
+				raw:       entry.Raw,
+				hash:      hash.NewFromBytes(entry.Raw),
+				firstSeen: time.Now(),
+			},
+			// Journaled transactions already passed checks before and are already journaled.
+			flags:    txCheckRecheck,
+			dstQueue: t.mainQueue,
+		}
+		if entry.Local {
+			pct.dstQueue = t.localQueue
+		}
+
+		if err = t.addToCheckQueue(pct); err != nil {
+			t.logger.Warn("failed to queue journaled transaction for checks",
+				"err", err,
+				"tx_hash", pct.Hash(),
+			)
+		}
+	}
+
+	return nil
+}
+
+// appendJournal appends newly queued transactions to the journal.
+func (t *txPool) appendJournal(entries []*journalEntry) {
+	switch err := t.journal.append(entries); {
+	case err == nil:
+	case errors.Is(err, ErrJournalFull):
+		// Rotate the journal to make room for new transactions.
+		t.journalRotateCh.In() <- struct{}{}
+	default:
+		t.logger.Warn("failed to append transactions to journal",
+			"err", err,
+		)
+	}
+}
+
+// rotateJournal rewrites the journal with the current contents of the local and main queues,
+// including transactions which are still pending checks (e.g., reloaded from the journal).
+func (t *txPool) rotateJournal() {
+	// Prevent rotation while queues are being drained for rechecks.
+	t.drainLock.Lock()
+	defer t.drainLock.Unlock()
+
+	err := t.journal.rotate(func() []*journalEntry {
+		var entries []*journalEntry
+		for _, tx := range t.localQueue.GetSchedulingSuggestion(0) {
+			entries = append(entries, &journalEntry{Local: true, Raw: tx.Raw()})
+		}
+
+		// Prefer higher priority transactions in case the journal size limit is reached.
+		txs := t.mainQueue.inner.GetAll()
+		sort.Slice(txs, func(i, j int) bool {
+			return txs[i].priority > txs[j].priority
+		})
+		for _, tx := range txs {
+			entries = append(entries, &journalEntry{Raw: tx.Raw()})
+		}
+
+		// Rechecked transactions are not appended to the journal after checks, so they need to be
+		// retained here. New transactions are appended once they pass checks.
+		for _, pct := range t.checkTxQueue.pending() {
+			if !pct.flags.isRecheck() || pct.dstQueue == nil {
+				continue
+			}
+			entries = append(entries, &journalEntry{
+				Local: pct.dstQueue == t.localQueue,
+				Raw:   pct.Raw(),
+			})
+		}
+		return entries
+	})
+	if err != nil {
+		t.logger.Error("failed to rotate transaction journal",
+			"err", err,
+		)
+	}
+}
+
+func (t *txPool) journalWorker() {
+	defer t.journal.close()
+
+	ticker := time.NewTicker(journalRotateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.stopCh:
+			return
+		case <-ticker.C:
+		case <-t.journalRotateCh.Out():
+		}
+
+		t.rotateJournal()
+	}
+}
+
 // New creates a new transaction pool instance.
 func New(
 	runtimeID common.Namespace,
@@ -929,9 +1091,19 @@ func New(
 	// buffer in case the schedule queue is full and is being rechecked.
 	maxCheckTxQueueSize := int((110 * cfg.MaxPoolSize) / 100)
 
+	policy, err := newSchedulePolicy(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error creating schedule policy: %w", err)
+	}
+
 	rq := newRimQueue()
 	lq := newLocalQueue()
-	mq := newMainQueue(int(cfg.MaxPoolSize))
+	mq := newMainQueue(policy)
+
+	var jr *journal
+	if cfg.JournalPath != "" {
+		jr = newJournal(cfg.JournalPath, cfg.MaxJournalSize)
+	}
 
 	return &txPool{
 		logger:               logging.GetLogger("runtime/txpool"),
@@ -958,5 +1130,7 @@ func New(
 		schedulerNotifier:    pubsub.NewBroker(false),
 		proposedTxs:          make(map[hash.Hash]*TxQueueMeta),
 		republishCh:          channels.NewRingChannel(1),
+		journal:              jr,
+		journalRotateCh:      channels.NewRingChannel(1),
 	}, nil
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/oasisprotocol/oasis-core/go/common"
 	"github.com/oasisprotocol/oasis-core/go/common/cbor"
 	"github.com/oasisprotocol/oasis-core/go/common/crypto/signature"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
 )
 
 func TestAddressDeserialization(t *testing.T) {
@@ -72,6 +73,30 @@ func TestRuntimeAddress(t *testing.T) {
 	require.NotEqualValues(addr1, addrPk1, "runtime addresses should be separated from staking addresses")
 }
 
+func TestMultisigAddress(t *testing.T) {
+	require := require.New(t)
+
+	pk1 := signature.NewPublicKey("aaafffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	pk2 := signature.NewPublicKey("bbbfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+	cfg := &transaction.MultisigConfig{Signers: []signature.PublicKey{pk1, pk2}, Threshold: 1}
+	addr := NewMultisigAddress(cfg)
+	require.True(addr.IsValid(), "multisig address should be valid")
+	require.EqualValues(addr, NewMultisigAddress(cfg), "multisig address derivation should be deterministic")
+
+	reordered := &transaction.MultisigConfig{Signers: []signature.PublicKey{pk2, pk1}, Threshold: 1}
+	require.EqualValues(addr, NewMultisigAddress(reordered), "multisig address should not depend on signer order")
+	require.EqualValues([]signature.PublicKey{pk2, pk1}, reordered.Signers, "address derivation should not modify the configuration")
+
+	cfg2 := &transaction.MultisigConfig{Signers: []signature.PublicKey{pk1, pk2}, Threshold: 2}
+	require.NotEqualValues(addr, NewMultisigAddress(cfg2), "multisig addresses for different thresholds should be different")
+
+	// Make sure domain separation works.
+	require.NotEqualValues(addr, NewAddress(pk1), "multisig addresses should be separated from staking addresses")
+	single := &transaction.MultisigConfig{Signers: []signature.PublicKey{pk1}, Threshold: 1}
+	require.NotEqualValues(NewAddress(pk1), NewMultisigAddress(single), "1-of-1 multisig address should differ from the signer address")
+}
+
 func TestInternal(t *testing.T) {
 	for _, v := range []struct {
 		n       string
@@ -70,6 +70,14 @@ var (
 	// total supply value.
 	ErrAllowanceGreaterThanSupply = errors.New(ModuleName, 11, "staking: allowance greater than total supply")
 
+	// ErrLockedByVesting is the error returned when an operation fails because the amount is
+	// still locked by the account's vesting schedule.
+	ErrLockedByVesting = errors.New(ModuleName, 12, "staking: amount locked by vesting schedule")
+
+	// ErrRewardsIndexDisabled is the error returned when the escrow rewards history is queried
+	// on a node that does not maintain the rewards index.
+	ErrRewardsIndexDisabled = errors.New(ModuleName, 13, "staking: escrow rewards index not enabled")
+
 	// MethodTransfer is the method name for transfers.
 	MethodTransfer = transaction.NewMethodName(ModuleName, "Transfer", Transfer{})
 	// MethodBurn is the method name for burns.
@@ -84,6 +92,10 @@ var (
 	MethodAllow = transaction.NewMethodName(ModuleName, "Allow", Allow{})
 	// MethodWithdraw is the method name for
 	MethodWithdraw = transaction.NewMethodName(ModuleName, "Withdraw", Withdraw{})
+	// MethodVestingTransfer is the method name for vesting transfers.
+	MethodVestingTransfer = transaction.NewMethodName(ModuleName, "VestingTransfer", VestingTransfer{})
+	// MethodRedelegate is the method name for redelegations.
+	MethodRedelegate = transaction.NewMethodName(ModuleName, "Redelegate", Redelegate{})
 
 	// Methods is the list of all methods supported by the staking backend.
 	Methods = []transaction.MethodName{
@@ -94,6 +106,8 @@ var (
 		MethodAmendCommissionSchedule,
 		MethodAllow,
 		MethodWithdraw,
+		MethodVestingTransfer,
+		MethodRedelegate,
 	}
 
 	_ prettyprint.PrettyPrinter = (*Transfer)(nil)
@@ -169,6 +183,13 @@ type Backend interface {
 	// Allowance looks up the allowance for the given owner/beneficiary combination.
 	Allowance(ctx context.Context, query *AllowanceQuery) (*quantity.Quantity, error)
 
+	// GetEscrowRewards returns the per-epoch reward accrual history for the given escrow
+	// account.
+	//
+	// Only epochs during which the account received rewards are included. The history is only
+	// available on nodes that maintain the escrow rewards index.
+	GetEscrowRewards(ctx context.Context, query *EscrowRewardsQuery) ([]*EscrowRewards, error)
+
 	// StateToGenesis returns the genesis state at specified block height.
 	StateToGenesis(ctx context.Context, height int64) (*Genesis, error)
 
@@ -234,6 +255,7 @@ type EscrowEvent struct {
 	Take           *TakeEscrowEvent           `json:"take,omitempty"`
 	DebondingStart *DebondingStartEscrowEvent `json:"debonding_start,omitempty"`
 	Reclaim        *ReclaimEscrowEvent        `json:"reclaim,omitempty"`
+	Redelegate     *RedelegateEscrowEvent     `json:"redelegate,omitempty"`
 }
 
 // Event signifies a staking event, returned via GetEvents.
@@ -347,6 +369,11 @@ func (t Transfer) PrettyType() (interface{}, error) {
 	return t, nil
 }
 
+// MethodMetadata returns the method metadata of Transfer.
+func (t Transfer) MethodMetadata() transaction.MethodMetadata {
+	return transaction.MethodMetadata{AllowMultisig: true, AllowBatch: true}
+}
+
 // NewTransferTx creates a new transfer transaction.
 func NewTransferTx(nonce uint64, fee *transaction.Fee, xfer *Transfer) *transaction.Transaction {
 	return transaction.NewTransaction(nonce, fee, MethodTransfer, xfer)
@@ -371,6 +398,11 @@ func (b Burn) PrettyType() (interface{}, error) {
 	return b, nil
 }
 
+// MethodMetadata returns the method metadata of Burn.
+func (b Burn) MethodMetadata() transaction.MethodMetadata {
+	return transaction.MethodMetadata{AllowMultisig: true, AllowBatch: true}
+}
+
 // NewBurnTx creates a new burn transaction.
 func NewBurnTx(nonce uint64, fee *transaction.Fee, burn *Burn) *transaction.Transaction {
 	return transaction.NewTransaction(nonce, fee, MethodBurn, burn)
@@ -398,6 +430,11 @@ func (e Escrow) PrettyType() (interface{}, error) {
 	return e, nil
 }
 
+// MethodMetadata returns the method metadata of Escrow.
+func (e Escrow) MethodMetadata() transaction.MethodMetadata {
+	return transaction.MethodMetadata{AllowMultisig: true, AllowBatch: true}
+}
+
 // NewAddEscrowTx creates a new add escrow transaction.
 func NewAddEscrowTx(nonce uint64, fee *transaction.Fee, escrow *Escrow) *transaction.Transaction {
 	return transaction.NewTransaction(nonce, fee, MethodAddEscrow, escrow)
@@ -423,6 +460,11 @@ func (re ReclaimEscrow) PrettyType() (interface{}, error) {
 	return re, nil
 }
 
+// MethodMetadata returns the method metadata of ReclaimEscrow.
+func (re ReclaimEscrow) MethodMetadata() transaction.MethodMetadata {
+	return transaction.MethodMetadata{AllowMultisig: true, AllowBatch: true}
+}
+
 // NewReclaimEscrowTx creates a new reclaim escrow transaction.
 func NewReclaimEscrowTx(nonce uint64, fee *transaction.Fee, reclaim *ReclaimEscrow) *transaction.Transaction {
 	return transaction.NewTransaction(nonce, fee, MethodReclaimEscrow, reclaim)
@@ -477,6 +519,11 @@ func (aw Allow) PrettyType() (interface{}, error) {
 	return aw, nil
 }
 
+// MethodMetadata returns the method metadata of Allow.
+func (aw Allow) MethodMetadata() transaction.MethodMetadata {
+	return transaction.MethodMetadata{AllowMultisig: true, AllowBatch: true}
+}
+
 // NewAllowTx creates a new beneficiary allowance configuration transaction.
 func NewAllowTx(nonce uint64, fee *transaction.Fee, allow *Allow) *transaction.Transaction {
 	return transaction.NewTransaction(nonce, fee, MethodAllow, allow)
@@ -502,6 +549,11 @@ func (wt Withdraw) PrettyType() (interface{}, error) {
 	return wt, nil
 }
 
+// MethodMetadata returns the method metadata of Withdraw.
+func (wt Withdraw) MethodMetadata() transaction.MethodMetadata {
+	return transaction.MethodMetadata{AllowMultisig: true, AllowBatch: true}
+}
+
 // NewWithdrawTx creates a new beneficiary allowance configuration transaction.
 func NewWithdrawTx(nonce uint64, fee *transaction.Fee, withdraw *Withdraw) *transaction.Transaction {
 	return transaction.NewTransaction(nonce, fee, MethodWithdraw, withdraw)
@@ -876,6 +928,12 @@ type GeneralAccount struct {
 	Nonce   uint64            `json:"nonce,omitempty"`
 
 	Allowances map[Address]quantity.Quantity `json:"allowances,omitempty"`
+
+	// Vesting is an optional vesting schedule restricting the spendable balance.
+	Vesting *VestingSchedule `json:"vesting,omitempty"`
+	// DelegatedVesting is the amount of tokens locked by the vesting schedule that are currently
+	// escrowed (or debonding) and therefore no longer part of the general balance.
+	DelegatedVesting quantity.Quantity `json:"delegated_vesting,omitempty"`
 }
 
 // PrettyPrint writes a pretty-printed representation of GeneralAccount to the
@@ -897,6 +955,15 @@ func (ga GeneralAccount) PrettyPrint(ctx context.Context, prefix string, w io.Wr
 			fmt.Fprintln(w)
 		}
 	}
+
+	if ga.Vesting != nil {
+		fmt.Fprintf(w, "%sVesting:\n", prefix)
+		ga.Vesting.PrettyPrint(ctx, prefix+"  ", w)
+
+		fmt.Fprintf(w, "%sDelegated Vesting: ", prefix)
+		token.PrettyPrintAmount(ctx, ga.DelegatedVesting, w)
+		fmt.Fprintln(w)
+	}
 }
 
 // PrettyType returns a representation of GeneralAccount that can be used for
@@ -1082,6 +1149,9 @@ type Genesis struct {
 	// DebondingDelegations is a nested map of staking delegations of the form:
 	// DEBONDING-DELEGATEE-ACCOUNT-ADDRESS: DEBONDING-DELEGATOR-ACCOUNT-ADDRESS: list of DEBONDING-DELEGATIONs.
 	DebondingDelegations map[Address]map[Address][]*DebondingDelegation `json:"debonding_delegations,omitempty"`
+	// Redelegations is a nested map of redelegations still exposed to slashing of the form:
+	// SOURCE-ESCROW-ACCOUNT-ADDRESS: DELEGATOR-ACCOUNT-ADDRESS: list of REDELEGATIONs.
+	Redelegations map[Address]map[Address][]*Redelegation `json:"redelegations,omitempty"`
 }
 
 // ConsensusParameters are the staking consensus parameters.
@@ -1102,6 +1172,19 @@ type ConsensusParameters struct { // nolint: maligned
 	DisableDelegation      bool             `json:"disable_delegation,omitempty"`
 	UndisableTransfersFrom map[Address]bool `json:"undisable_transfers_from,omitempty"`
 
+	// RedelegationExposurePeriod is the number of epochs redelegated stake remains exposed to
+	// slashing of the source escrow account. Zero means that the debonding interval is used.
+	RedelegationExposurePeriod beacon.EpochTime `json:"redelegation_exposure_period,omitempty"`
+
+	// VestingTransfersFrom are the addresses allowed to perform vesting transfers.
+	VestingTransfersFrom map[Address]bool `json:"vesting_transfers_from,omitempty"`
+
+	// Downtime are the validator downtime jailing parameters.
+	Downtime DowntimeParameters `json:"downtime,omitempty"`
+
+	// BaseFee are the dynamic base fee parameters.
+	BaseFee BaseFeeParameters `json:"base_fee,omitempty"`
+
 	// AllowEscrowMessages can be used to allow runtimes to perform AddEscrow
 	// and ReclaimEscrow via runtime messages.
 	AllowEscrowMessages bool `json:"allow_escrow_messages,omitempty"`
@@ -1144,6 +1227,15 @@ type ConsensusParameterChanges struct {
 	// DisableDelegation is the new disable delegation flag.
 	DisableDelegation *bool `json:"disable_delegation,omitempty"`
 
+	// RedelegationExposurePeriod is the new redelegation exposure period.
+	RedelegationExposurePeriod *beacon.EpochTime `json:"redelegation_exposure_period,omitempty"`
+
+	// Downtime are the new validator downtime jailing parameters.
+	Downtime *DowntimeParameters `json:"downtime,omitempty"`
+
+	// BaseFee are the new dynamic base fee parameters.
+	BaseFee *BaseFeeParameters `json:"base_fee,omitempty"`
+
 	// AllowEscrowMessages is the new allow escrow messages flag.
 	AllowEscrowMessages *bool `json:"allow_escrow_messages,omitempty"`
 
@@ -1186,6 +1278,15 @@ func (c *ConsensusParameterChanges) Apply(params *ConsensusParameters) error {
 	if c.DisableDelegation != nil {
 		params.DisableDelegation = *c.DisableDelegation
 	}
+	if c.RedelegationExposurePeriod != nil {
+		params.RedelegationExposurePeriod = *c.RedelegationExposurePeriod
+	}
+	if c.Downtime != nil {
+		params.Downtime = *c.Downtime
+	}
+	if c.BaseFee != nil {
+		params.BaseFee = *c.BaseFee
+	}
 	if c.AllowEscrowMessages != nil {
 		params.AllowEscrowMessages = *c.AllowEscrowMessages
 	}
@@ -1225,6 +1326,10 @@ const (
 	GasOpAllow transaction.Op = "allow"
 	// GasOpWithdraw is the gas operation identifier for withdraw.
 	GasOpWithdraw transaction.Op = "withdraw"
+	// GasOpVestingTransfer is the gas operation identifier for vesting transfer.
+	GasOpVestingTransfer transaction.Op = "vesting_transfer"
+	// GasOpRedelegate is the gas operation identifier for redelegate.
+	GasOpRedelegate transaction.Op = "redelegate"
 )
 
 // TransferResult is the result of staking transfer.
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
+)
+
+// BaseFeeParameters are the dynamic base fee parameters.
+//
+// Each transaction must pay a gas price of at least the current base fee. The base fee portion of
+// the fee (the base fee multiplied by the transaction's gas limit) is burned or transferred to the
+// common pool while the rest (the tip) is disbursed to validators as other fees. After each block,
+// the base fee is adjusted based on the amount of gas the block used compared to the target.
+type BaseFeeParameters struct {
+	// TargetBlockGas is the amount of gas used by a block at which the base fee remains unchanged.
+	// Zero means that the dynamic base fee is disabled.
+	TargetBlockGas transaction.Gas `json:"target_block_gas,omitempty"`
+	// MinBaseFee is the minimum (and initial) base fee per unit of gas.
+	MinBaseFee quantity.Quantity `json:"min_base_fee,omitempty"`
+	// MaxChangeDenominator bounds the change of the base fee in each block to the current base fee
+	// divided by the denominator.
+	MaxChangeDenominator uint64 `json:"max_change_denominator,omitempty"`
+	// Burn specifies whether the base fee portion of fees is burned instead of being transferred
+	// to the common pool.
+	Burn bool `json:"burn,omitempty"`
+}
+
+// IsEnabled returns true iff the dynamic base fee is enabled.
+func (p *BaseFeeParameters) IsEnabled() bool {
+	return p.TargetBlockGas > 0
+}
+
+// SanityCheck performs a sanity check on the dynamic base fee parameters.
+func (p *BaseFeeParameters) SanityCheck() error {
+	if !p.IsEnabled() {
+		return nil
+	}
+	if !p.MinBaseFee.IsValid() {
+		return fmt.Errorf("minimum base fee has invalid value")
+	}
+	if p.MaxChangeDenominator == 0 {
+		return fmt.Errorf("base fee max change denominator must be non-zero")
+	}
+	return nil
+}
+
+// NextBaseFee computes the base fee for the next block given the current base fee and the amount
+// of gas used by the current block.
+func (p *BaseFeeParameters) NextBaseFee(current *quantity.Quantity, gasUsed transaction.Gas) (*quantity.Quantity, error) {
+	if !p.IsEnabled() {
+		return quantity.NewQuantity(), nil
+	}
+
+	var (
+		next  *quantity.Quantity
+		delta quantity.Quantity
+	)
+	switch {
+	case gasUsed == p.TargetBlockGas:
+		next = current.Clone()
+	case gasUsed > p.TargetBlockGas:
+		// delta = max(current * (gasUsed - target) / target / denominator, 1)
+		if err := delta.FromUint64(uint64(gasUsed - p.TargetBlockGas)); err != nil {
+			return nil, err
+		}
+		if err := p.scaleDelta(&delta, current); err != nil {
+			return nil, err
+		}
+		if delta.IsZero() {
+			_ = delta.FromUint64(1)
+		}
+		next = current.Clone()
+		if err := next.Add(&delta); err != nil {
+			return nil, fmt.Errorf("failed to increase base fee: %w", err)
+		}
+	default:
+		// delta = current * (target - gasUsed) / target / denominator
+		if err := delta.FromUint64(uint64(p.TargetBlockGas - gasUsed)); err != nil {
+			return nil, err
+		}
+		if err := p.scaleDelta(&delta, current); err != nil {
+			return nil, err
+		}
+		next = current.Clone()
+		if next.Sub(&delta) != nil {
+			next = quantity.NewQuantity()
+		}
+	}
+
+	if next.Cmp(&p.MinBaseFee) < 0 {
+		next = p.MinBaseFee.Clone()
+	}
+	return next, nil
+}
+
+func (p *BaseFeeParameters) scaleDelta(delta, current *quantity.Quantity) error {
+	var target, denominator quantity.Quantity
+	if err := target.FromUint64(uint64(p.TargetBlockGas)); err != nil {
+		return err
+	}
+	if err := denominator.FromUint64(p.MaxChangeDenominator); err != nil {
+		return err
+	}
+	if err := delta.Mul(current); err != nil {
+		return fmt.Errorf("failed to compute base fee change: %w", err)
+	}
+	if err := delta.Quo(&target); err != nil {
+		return fmt.Errorf("failed to compute base fee change: %w", err)
+	}
+	if err := delta.Quo(&denominator); err != nil {
+		return fmt.Errorf("failed to compute base fee change: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
+)
+
+func TestBaseFee(t *testing.T) {
+	require := require.New(t)
+
+	var disabled BaseFeeParameters
+	require.False(disabled.IsEnabled(), "zero target block gas should disable the base fee")
+	require.NoError(disabled.SanityCheck(), "disabled base fee should be valid")
+	next, err := disabled.NextBaseFee(quantity.NewFromUint64(100), 1_000)
+	require.NoError(err, "NextBaseFee")
+	require.True(next.IsZero(), "disabled base fee should be zero")
+
+	p := BaseFeeParameters{
+		TargetBlockGas:       1_000,
+		MinBaseFee:           *quantity.NewFromUint64(10),
+		MaxChangeDenominator: 8,
+	}
+	require.NoError(p.SanityCheck(), "SanityCheck")
+	invalid := p
+	invalid.MaxChangeDenominator = 0
+	require.Error(invalid.SanityCheck(), "zero max change denominator should be invalid")
+
+	for _, tc := range []struct {
+		current uint64
+		gasUsed transaction.Gas
+		next    uint64
+	}{
+		// Target gas used, base fee remains unchanged.
+		{800, 1_000, 800},
+		// Full block (twice the target), base fee increases by 1/8.
+		{800, 2_000, 900},
+		// Slightly above the target, base fee increases by at least one.
+		{10, 1_001, 11},
+		// Empty block, base fee decreases by 1/8.
+		{800, 0, 700},
+		// Base fee never decreases below the minimum.
+		{10, 0, 10},
+		// Base fee below the minimum (e.g., after a parameter change) is raised to the minimum.
+		{5, 1_000, 10},
+	} {
+		next, err = p.NextBaseFee(quantity.NewFromUint64(tc.current), tc.gasUsed)
+		require.NoError(err, "NextBaseFee")
+		require.EqualValues(*quantity.NewFromUint64(tc.next), *next, "next base fee (current: %d gas used: %d)", tc.current, tc.gasUsed)
+	}
+}
@@ -46,6 +46,8 @@ var (
 	methodDebondingDelegationsTo = serviceName.NewMethod("DebondingDelegationsTo", OwnerQuery{})
 	// methodAllowance is the Allowance method.
 	methodAllowance = serviceName.NewMethod("Allowance", AllowanceQuery{})
+	// methodGetEscrowRewards is the GetEscrowRewards method.
+	methodGetEscrowRewards = serviceName.NewMethod("GetEscrowRewards", EscrowRewardsQuery{})
 	// methodStateToGenesis is the StateToGenesis method.
 	methodStateToGenesis = serviceName.NewMethod("StateToGenesis", int64(0))
 	// methodConsensusParameters is the ConsensusParameters method.
@@ -125,6 +127,10 @@ var (
 				MethodName: methodAllowance.ShortName(),
 				Handler:    handlerAllowance,
 			},
+			{
+				MethodName: methodGetEscrowRewards.ShortName(),
+				Handler:    handlerGetEscrowRewards,
+			},
 			{
 				MethodName: methodStateToGenesis.ShortName(),
 				Handler:    handlerStateToGenesis,
@@ -508,6 +514,29 @@ func handlerAllowance(
 	return interceptor(ctx, &query, info, handler)
 }
 
+func handlerGetEscrowRewards(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	var query EscrowRewardsQuery
+	if err := dec(&query); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Backend).GetEscrowRewards(ctx, &query)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: methodGetEscrowRewards.FullName(),
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Backend).GetEscrowRewards(ctx, req.(*EscrowRewardsQuery))
+	}
+	return interceptor(ctx, &query, info, handler)
+}
+
 func handlerStateToGenesis(
 	srv interface{},
 	ctx context.Context,
@@ -742,6 +771,14 @@ func (c *stakingClient) Allowance(ctx context.Context, query *AllowanceQuery) (*
 	return &rsp, nil
 }
 
+func (c *stakingClient) GetEscrowRewards(ctx context.Context, query *EscrowRewardsQuery) ([]*EscrowRewards, error) {
+	var rsp []*EscrowRewards
+	if err := c.conn.Invoke(ctx, methodGetEscrowRewards.FullName(), query, &rsp); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
 func (c *stakingClient) StateToGenesis(ctx context.Context, height int64) (*Genesis, error) {
 	var rsp Genesis
 	if err := c.conn.Invoke(ctx, methodStateToGenesis.FullName(), height, &rsp); err != nil {
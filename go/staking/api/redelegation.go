@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common/prettyprint"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
+	"github.com/oasisprotocol/oasis-core/go/staking/api/token"
+)
+
+var _ prettyprint.PrettyPrinter = (*Redelegate)(nil)
+
+// Redelegate is a move of active delegation shares from one escrow account
+// into another escrow account without going through the debonding process.
+type Redelegate struct {
+	// From is the escrow account the shares are taken from.
+	From Address `json:"from"`
+	// To is the escrow account the stake is delegated to.
+	To Address `json:"to"`
+	// Shares is the number of active shares in the source escrow account to redelegate.
+	Shares quantity.Quantity `json:"shares"`
+}
+
+// PrettyPrint writes a pretty-printed representation of Redelegate to the
+// given writer.
+func (rd Redelegate) PrettyPrint(ctx context.Context, prefix string, w io.Writer) {
+	fmt.Fprintf(w, "%sFrom:   %s\n", prefix, rd.From)
+	fmt.Fprintf(w, "%sTo:     %s\n", prefix, rd.To)
+
+	fmt.Fprintf(w, "%sShares: %s\n", prefix, rd.Shares)
+}
+
+// PrettyType returns a representation of Redelegate that can be used for
+// pretty printing.
+func (rd Redelegate) PrettyType() (interface{}, error) {
+	return rd, nil
+}
+
+// MethodMetadata returns the method metadata of Redelegate.
+func (rd Redelegate) MethodMetadata() transaction.MethodMetadata {
+	return transaction.MethodMetadata{AllowMultisig: true, AllowBatch: true}
+}
+
+// NewRedelegateTx creates a new redelegate transaction.
+func NewRedelegateTx(nonce uint64, fee *transaction.Fee, redelegate *Redelegate) *transaction.Transaction {
+	return transaction.NewTransaction(nonce, fee, MethodRedelegate, redelegate)
+}
+
+// Redelegation is a record of redelegated stake which remains exposed to
+// slashing of the source escrow account until the exposure end time.
+type Redelegation struct {
+	// To is the escrow account the stake was redelegated to.
+	To Address `json:"to"`
+	// Amount is the redelegated amount that is still exposed to slashing.
+	Amount quantity.Quantity `json:"amount"`
+	// ExposureEndTime is the epoch at which the redelegated stake stops being
+	// exposed to slashing of the source escrow account.
+	ExposureEndTime beacon.EpochTime `json:"exposure_end_time"`
+}
+
+// PrettyPrint writes a pretty-printed representation of Redelegation to the
+// given writer.
+func (r Redelegation) PrettyPrint(ctx context.Context, prefix string, w io.Writer) {
+	fmt.Fprintf(w, "%sTo:                %s\n", prefix, r.To)
+
+	fmt.Fprintf(w, "%sAmount:            ", prefix)
+	token.PrettyPrintAmount(ctx, r.Amount, w)
+	fmt.Fprintln(w)
+
+	fmt.Fprintf(w, "%sExposure End Time: epoch %d\n", prefix, r.ExposureEndTime)
+}
+
+// PrettyType returns a representation of Redelegation that can be used for
+// pretty printing.
+func (r Redelegation) PrettyType() (interface{}, error) {
+	return r, nil
+}
+
+// RedelegateEscrowEvent is the event emitted when active shares are moved from
+// one escrow account into another.
+//
+// Note that the redelegated amount remains exposed to slashing of the source
+// escrow account until the exposure end time.
+type RedelegateEscrowEvent struct {
+	Owner           Address           `json:"owner"`
+	From            Address           `json:"from"`
+	To              Address           `json:"to"`
+	Amount          quantity.Quantity `json:"amount"`
+	SourceShares    quantity.Quantity `json:"source_shares"`
+	NewShares       quantity.Quantity `json:"new_shares"`
+	ExposureEndTime beacon.EpochTime  `json:"exposure_end_time"`
+}
+
+// EventKind returns a string representation of this event's kind.
+func (e *RedelegateEscrowEvent) EventKind() string {
+	return "redelegate_escrow"
+}
+
+// RedelegateResult is the result of redelegate.
+type RedelegateResult struct {
+	Owner           Address           `json:"owner"`
+	From            Address           `json:"from"`
+	To              Address           `json:"to"`
+	Amount          quantity.Quantity `json:"amount"`
+	SourceShares    quantity.Quantity `json:"source_shares"`
+	NewShares       quantity.Quantity `json:"new_shares"`
+	ExposureEndTime beacon.EpochTime  `json:"exposure_end_time"`
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common/prettyprint"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
+	"github.com/oasisprotocol/oasis-core/go/staking/api/token"
+)
+
+// MaxEscrowRewardsEpochs is the maximum number of epochs that can be requested in a single
+// escrow rewards history query.
+const MaxEscrowRewardsEpochs = 1000
+
+var _ prettyprint.PrettyPrinter = (*EscrowRewards)(nil)
+
+// EscrowRewardsQuery is an escrow rewards history query.
+type EscrowRewardsQuery struct {
+	// Owner is the address of the escrow account.
+	Owner Address `json:"owner"`
+	// FromEpoch is the first epoch (inclusive) to return the rewards history for.
+	FromEpoch beacon.EpochTime `json:"from_epoch"`
+	// ToEpoch is the last epoch (inclusive) to return the rewards history for.
+	ToEpoch beacon.EpochTime `json:"to_epoch"`
+}
+
+// EscrowRewards is the reward accrual of an escrow account during a single epoch.
+type EscrowRewards struct {
+	// Epoch is the epoch during which the rewards were disbursed.
+	Epoch beacon.EpochTime `json:"epoch"`
+	// Rewards is the amount of rewards added to the escrow account's active
+	// pool, excluding commission.
+	Rewards quantity.Quantity `json:"rewards"`
+	// Commission is the amount of rewards taken as commission by the escrow
+	// account owner.
+	Commission quantity.Quantity `json:"commission"`
+	// StartActive is the state of the escrow account's active pool before the
+	// first rewards disbursement in the epoch.
+	StartActive SharePool `json:"start_active"`
+	// EndActive is the state of the escrow account's active pool after the
+	// last rewards disbursement in the epoch.
+	EndActive SharePool `json:"end_active"`
+}
+
+// DelegationReward computes the change in value of a delegation holding the given number of
+// shares over the epoch.
+//
+// In case the share price decreased (e.g., because the escrow account was slashed), zero is
+// returned.
+func (r *EscrowRewards) DelegationReward(shares *quantity.Quantity) (*quantity.Quantity, error) {
+	start, err := r.StartActive.StakeForShares(shares)
+	if err != nil {
+		return nil, err
+	}
+	end, err := r.EndActive.StakeForShares(shares)
+	if err != nil {
+		return nil, err
+	}
+	if end.Cmp(start) <= 0 {
+		return quantity.NewQuantity(), nil
+	}
+	if err = end.Sub(start); err != nil {
+		return nil, err
+	}
+	return end, nil
+}
+
+// PrettyPrint writes a pretty-printed representation of EscrowRewards to the
+// given writer.
+func (r EscrowRewards) PrettyPrint(ctx context.Context, prefix string, w io.Writer) {
+	fmt.Fprintf(w, "%sEpoch:      %d\n", prefix, r.Epoch)
+
+	fmt.Fprintf(w, "%sRewards:    ", prefix)
+	token.PrettyPrintAmount(ctx, r.Rewards, w)
+	fmt.Fprintln(w)
+
+	fmt.Fprintf(w, "%sCommission: ", prefix)
+	token.PrettyPrintAmount(ctx, r.Commission, w)
+	fmt.Fprintln(w)
+
+	fmt.Fprintf(w, "%sActive (start of epoch):\n", prefix)
+	r.StartActive.PrettyPrint(ctx, prefix+"  ", w)
+
+	fmt.Fprintf(w, "%sActive (end of epoch):\n", prefix)
+	r.EndActive.PrettyPrint(ctx, prefix+"  ", w)
+}
+
+// PrettyType returns a representation of EscrowRewards that can be used for
+// pretty printing.
+func (r EscrowRewards) PrettyType() (interface{}, error) {
+	return r, nil
+}
@@ -37,6 +37,31 @@ func (p *ConsensusParameters) SanityCheck() error {
 		return fmt.Errorf("fee split proportions are all zero")
 	}
 
+	// Vesting transfers.
+	for addr := range p.VestingTransfersFrom {
+		if !addr.IsValid() || addr.IsReserved() {
+			return fmt.Errorf("vesting transfers allowed from invalid address %s", addr)
+		}
+	}
+
+	// Dynamic base fee.
+	if err := p.BaseFee.SanityCheck(); err != nil {
+		return err
+	}
+
+	// Validator downtime.
+	if p.Downtime.Window > 0 {
+		if p.Downtime.MinSignedBlocks > p.Downtime.Window {
+			return fmt.Errorf("downtime minimum signed blocks (%d) exceed the window (%d)",
+				p.Downtime.MinSignedBlocks,
+				p.Downtime.Window,
+			)
+		}
+		if p.Slashing[SlashConsensusDowntime].FreezeInterval == 0 {
+			return fmt.Errorf("downtime jailing requires a non-zero %s freeze interval", SlashConsensusDowntime)
+		}
+	}
+
 	return nil
 }
 
@@ -49,6 +74,9 @@ func (c *ConsensusParameterChanges) SanityCheck() error {
 		c.MinTransactBalance == nil &&
 		c.DisableTransfers == nil &&
 		c.DisableDelegation == nil &&
+		c.RedelegationExposurePeriod == nil &&
+		c.Downtime == nil &&
+		c.BaseFee == nil &&
 		c.AllowEscrowMessages == nil &&
 		c.MaxAllowances == nil &&
 		c.FeeSplitWeightPropose == nil &&
@@ -115,6 +143,23 @@ func SanityCheckAccount(
 		}
 	}
 
+	if vesting := acct.General.Vesting; vesting != nil {
+		if addr.IsReserved() {
+			return fmt.Errorf("staking: sanity check failed: reserved account %s has a vesting schedule", addr)
+		}
+		if err := vesting.ValidateBasic(); err != nil {
+			return fmt.Errorf("staking: sanity check failed: vesting schedule for account %s is invalid: %w", addr, err)
+		}
+		if vesting.Amount.Cmp(totalSupply) > 0 {
+			return fmt.Errorf("staking: sanity check failed: account %s vesting amount is greater than total supply", addr)
+		}
+		if acct.General.DelegatedVesting.Cmp(&vesting.Amount) > 0 {
+			return fmt.Errorf("staking: sanity check failed: account %s delegated vesting amount is greater than vesting amount", addr)
+		}
+	} else if !acct.General.DelegatedVesting.IsZero() {
+		return fmt.Errorf("staking: sanity check failed: account %s has delegated vesting without a vesting schedule", addr)
+	}
+
 	return nil
 }
 
@@ -199,6 +244,43 @@ func SanityCheckDebondingDelegations(addr Address, account *Account, delegations
 	return nil
 }
 
+// SanityCheckRedelegations examines redelegations from an account which are still exposed to
+// slashing of the account.
+func SanityCheckRedelegations(addr Address, redelegations map[Address][]*Redelegation) error {
+	if !addr.IsValid() {
+		return fmt.Errorf("staking: sanity check failed: redelegation from %s: address is invalid", addr)
+	}
+	for delegatorAddr, rds := range redelegations {
+		if !delegatorAddr.IsValid() {
+			return fmt.Errorf(
+				"staking: sanity check failed: redelegation by %s from %s: delegator address is invalid",
+				delegatorAddr, addr,
+			)
+		}
+		for _, rd := range rds {
+			if rd == nil {
+				return fmt.Errorf(
+					"staking: sanity check failed: redelegation by %s from %s is nil",
+					delegatorAddr, addr,
+				)
+			}
+			if !rd.To.IsValid() || rd.To.Equal(addr) {
+				return fmt.Errorf(
+					"staking: sanity check failed: redelegation by %s from %s: destination address %s is invalid",
+					delegatorAddr, addr, rd.To,
+				)
+			}
+			if !rd.Amount.IsValid() || rd.Amount.IsZero() {
+				return fmt.Errorf(
+					"staking: sanity check failed: redelegation by %s from %s to %s: amount is invalid",
+					delegatorAddr, addr, rd.To,
+				)
+			}
+		}
+	}
+	return nil
+}
+
 // SanityCheckAccountShares examines an account's share pools.
 func SanityCheckAccountShares(
 	addr Address,
@@ -345,6 +427,18 @@ func (g *Genesis) SanityCheck(now beacon.EpochTime) error { // nolint: gocyclo
 		}
 	}
 
+	// All redelegations must be from existing accounts.
+	for addr, redelegations := range g.Redelegations {
+		if g.Ledger[addr] == nil {
+			return fmt.Errorf(
+				"staking: sanity check failed: redelegation specified from a nonexisting account: %v", addr,
+			)
+		}
+		if err := SanityCheckRedelegations(addr, redelegations); err != nil {
+			return err
+		}
+	}
+
 	// The burn address is actually "unused" for reasonable definitions of "unused".
 	if ba := g.Ledger[BurnAddress]; ba != nil {
 		if !ba.General.Balance.IsZero() {
@@ -21,6 +21,8 @@ const (
 	SlashBeaconNonparticipation SlashReason = 0x03
 	// SlashConsensusLightClientAttack is slashing due to light client attacks.
 	SlashConsensusLightClientAttack SlashReason = 0x04
+	// SlashConsensusDowntime is slashing due to not signing enough blocks.
+	SlashConsensusDowntime SlashReason = 0x05
 
 	// SlashRuntimeIncorrectResults is slashing due to submission of incorrect
 	// results in runtime executor commitments.
@@ -35,6 +37,8 @@ const (
 	SlashConsensusEquivocationName = "consensus-equivocation"
 	// SlashConsensusLightClientAttackName is the string representation of SlashConsensusLightClientAttack.
 	SlashConsensusLightClientAttackName = "consensus-light-client-attack"
+	// SlashConsensusDowntimeName is the string representation of SlashConsensusDowntime.
+	SlashConsensusDowntimeName = "consensus-downtime"
 	// SlashRuntimeIncorrectResultsName is the string representation of SlashRuntimeIncorrectResultsName.
 	SlashRuntimeIncorrectResultsName = "runtime-incorrect-results"
 	// SlashRuntimeEquivocationName is the string representation of SlashRuntimeEquivocation.
@@ -55,6 +59,8 @@ func (s SlashReason) checkedString() (string, error) {
 		return SlashConsensusEquivocationName, nil
 	case SlashConsensusLightClientAttack:
 		return SlashConsensusLightClientAttackName, nil
+	case SlashConsensusDowntime:
+		return SlashConsensusDowntimeName, nil
 	case SlashRuntimeIncorrectResults:
 		return SlashRuntimeIncorrectResultsName, nil
 	case SlashRuntimeEquivocation:
@@ -83,6 +89,8 @@ func (s *SlashReason) UnmarshalText(text []byte) error {
 		*s = SlashConsensusEquivocation
 	case SlashConsensusLightClientAttackName:
 		*s = SlashConsensusLightClientAttack
+	case SlashConsensusDowntimeName:
+		*s = SlashConsensusDowntime
 	case SlashRuntimeIncorrectResultsName:
 		*s = SlashRuntimeIncorrectResults
 	case SlashRuntimeEquivocationName:
@@ -100,3 +108,15 @@ type Slash struct {
 	Amount         quantity.Quantity `json:"amount"`
 	FreezeInterval beacon.EpochTime  `json:"freeze_interval"`
 }
+
+// DowntimeParameters are the validator downtime jailing parameters.
+//
+// A validator that signs fewer than MinSignedBlocks of the last Window blocks is frozen (jailed)
+// and slashed according to the SlashConsensusDowntime slashing configuration.
+type DowntimeParameters struct {
+	// Window is the number of most recent blocks over which validator liveness is tracked.
+	// Zero means that downtime jailing is disabled.
+	Window uint64 `json:"window,omitempty"`
+	// MinSignedBlocks is the minimum number of blocks in the window a validator must sign.
+	MinSignedBlocks uint64 `json:"min_signed_blocks,omitempty"`
+}
@@ -12,6 +12,8 @@ func TestSlashReason(t *testing.T) {
 	// Test valid SlashReasons.
 	for _, k := range []SlashReason{
 		SlashConsensusEquivocation,
+		SlashConsensusLightClientAttack,
+		SlashConsensusDowntime,
 		SlashRuntimeIncorrectResults,
 		SlashRuntimeEquivocation,
 	} {
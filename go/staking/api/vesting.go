@@ -0,0 +1,172 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common/prettyprint"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
+	"github.com/oasisprotocol/oasis-core/go/consensus/api/transaction"
+	"github.com/oasisprotocol/oasis-core/go/staking/api/token"
+)
+
+var (
+	_ prettyprint.PrettyPrinter = (*VestingSchedule)(nil)
+	_ prettyprint.PrettyPrinter = (*VestingTransfer)(nil)
+)
+
+// VestingSchedule is a vesting (lockup) schedule of a general account.
+//
+// The given amount is locked until the cliff epoch after which it unlocks linearly so that it is
+// fully unlocked at the end epoch. Locked tokens can not be transferred, burned or used to pay
+// fees but can be escrowed.
+type VestingSchedule struct {
+	// Amount is the total amount subject to the vesting schedule.
+	Amount quantity.Quantity `json:"amount"`
+	// Start is the epoch at which the amount starts to vest.
+	Start beacon.EpochTime `json:"start"`
+	// Cliff is the epoch before which no part of the amount is unlocked.
+	Cliff beacon.EpochTime `json:"cliff"`
+	// End is the epoch at which the amount is fully unlocked.
+	End beacon.EpochTime `json:"end"`
+}
+
+// ValidateBasic performs basic vesting schedule validity checks.
+func (v *VestingSchedule) ValidateBasic() error {
+	if !v.Amount.IsValid() || v.Amount.IsZero() {
+		return fmt.Errorf("vesting schedule amount must be positive")
+	}
+	if v.Start >= v.End {
+		return fmt.Errorf("vesting schedule start (%d) must be before end (%d)", v.Start, v.End)
+	}
+	if v.Cliff < v.Start || v.Cliff > v.End {
+		return fmt.Errorf("vesting schedule cliff (%d) must be between start (%d) and end (%d)", v.Cliff, v.Start, v.End)
+	}
+	return nil
+}
+
+// LockedAmount returns the amount that is still locked at the given epoch.
+func (v *VestingSchedule) LockedAmount(epoch beacon.EpochTime) *quantity.Quantity {
+	switch {
+	case epoch < v.Cliff:
+		return v.Amount.Clone()
+	case epoch >= v.End:
+		return quantity.NewQuantity()
+	}
+
+	// Linear unlock between start and end: locked = amount * (end - epoch) / (end - start).
+	locked := v.Amount.Clone()
+	_ = locked.Mul(quantity.NewFromUint64(uint64(v.End - epoch)))
+	_ = locked.Quo(quantity.NewFromUint64(uint64(v.End - v.Start)))
+	return locked
+}
+
+// IsFullyVested returns true iff no part of the amount is locked at the given epoch.
+func (v *VestingSchedule) IsFullyVested(epoch beacon.EpochTime) bool {
+	return epoch >= v.End
+}
+
+// PrettyPrint writes a pretty-printed representation of VestingSchedule to the given writer.
+func (v VestingSchedule) PrettyPrint(ctx context.Context, prefix string, w io.Writer) {
+	fmt.Fprintf(w, "%sAmount: ", prefix)
+	token.PrettyPrintAmount(ctx, v.Amount, w)
+	fmt.Fprintln(w)
+
+	fmt.Fprintf(w, "%sStart:  epoch %d\n", prefix, v.Start)
+	fmt.Fprintf(w, "%sCliff:  epoch %d\n", prefix, v.Cliff)
+	fmt.Fprintf(w, "%sEnd:    epoch %d\n", prefix, v.End)
+}
+
+// PrettyType returns a representation of VestingSchedule that can be used for pretty printing.
+func (v VestingSchedule) PrettyType() (interface{}, error) {
+	return v, nil
+}
+
+// LockedBalance returns the part of the general balance that is still locked by the vesting
+// schedule (if any) at the given epoch. Locked tokens that are escrowed are not included.
+func (ga *GeneralAccount) LockedBalance(epoch beacon.EpochTime) *quantity.Quantity {
+	if ga.Vesting == nil {
+		return quantity.NewQuantity()
+	}
+	locked := ga.Vesting.LockedAmount(epoch)
+	if locked.Cmp(&ga.DelegatedVesting) <= 0 {
+		return quantity.NewQuantity()
+	}
+	_ = locked.Sub(&ga.DelegatedVesting)
+	return locked
+}
+
+// CheckSpendable checks that the general balance of the account is not lower than the amount
+// that is still locked by its vesting schedule (if any) at the given epoch.
+func (ga *GeneralAccount) CheckSpendable(epoch beacon.EpochTime) error {
+	if ga.Vesting == nil {
+		return nil
+	}
+	if ga.Balance.Cmp(ga.LockedBalance(epoch)) < 0 {
+		return ErrLockedByVesting
+	}
+	return nil
+}
+
+// DelegateVesting records that the given amount has been escrowed from the general balance.
+//
+// Locked tokens are considered to be escrowed first, so the delegated vesting amount increases
+// by up to the part of the given amount that was still locked at the given epoch.
+func (ga *GeneralAccount) DelegateVesting(epoch beacon.EpochTime, amount *quantity.Quantity) error {
+	if ga.Vesting == nil {
+		return nil
+	}
+	locked := ga.Vesting.LockedAmount(epoch)
+	if locked.Cmp(&ga.DelegatedVesting) <= 0 {
+		return nil
+	}
+	if err := locked.Sub(&ga.DelegatedVesting); err != nil {
+		return err
+	}
+	if amount.Cmp(locked) < 0 {
+		locked = amount
+	}
+	return ga.DelegatedVesting.Add(locked)
+}
+
+// UndelegateVesting records that the given amount has been returned from escrow to the general
+// balance.
+//
+// Returned tokens are considered to be locked first, so the delegated vesting amount decreases
+// by up to the given amount.
+func (ga *GeneralAccount) UndelegateVesting(amount *quantity.Quantity) error {
+	if ga.DelegatedVesting.Cmp(amount) <= 0 {
+		ga.DelegatedVesting = quantity.Quantity{}
+		return nil
+	}
+	return ga.DelegatedVesting.Sub(amount)
+}
+
+// VestingTransfer is a privileged stake transfer which subjects the transferred amount to a
+// vesting schedule in the destination account.
+//
+// Only senders explicitly allowed by the consensus parameters can perform vesting transfers.
+type VestingTransfer struct {
+	To Address `json:"to"`
+	// Schedule is the vesting schedule. The transferred amount is the amount of the schedule.
+	Schedule VestingSchedule `json:"schedule"`
+}
+
+// PrettyPrint writes a pretty-printed representation of VestingTransfer to the given writer.
+func (vt VestingTransfer) PrettyPrint(ctx context.Context, prefix string, w io.Writer) {
+	fmt.Fprintf(w, "%sTo:       %s\n", prefix, vt.To)
+	fmt.Fprintf(w, "%sSchedule:\n", prefix)
+	vt.Schedule.PrettyPrint(ctx, prefix+"  ", w)
+}
+
+// PrettyType returns a representation of VestingTransfer that can be used for pretty printing.
+func (vt VestingTransfer) PrettyType() (interface{}, error) {
+	return vt, nil
+}
+
+// NewVestingTransferTx creates a new vesting transfer transaction.
+func NewVestingTransferTx(nonce uint64, fee *transaction.Fee, xfer *VestingTransfer) *transaction.Transaction {
+	return transaction.NewTransaction(nonce, fee, MethodVestingTransfer, xfer)
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
+	"github.com/oasisprotocol/oasis-core/go/common/quantity"
+)
+
+func TestVestingSchedule(t *testing.T) {
+	require := require.New(t)
+
+	for _, tc := range []struct {
+		msg      string
+		schedule VestingSchedule
+		valid    bool
+	}{
+		{"zero amount", VestingSchedule{Start: 0, Cliff: 0, End: 10}, false},
+		{"empty period", VestingSchedule{Amount: *quantity.NewFromUint64(100), Start: 10, Cliff: 10, End: 10}, false},
+		{"cliff before start", VestingSchedule{Amount: *quantity.NewFromUint64(100), Start: 10, Cliff: 5, End: 20}, false},
+		{"cliff after end", VestingSchedule{Amount: *quantity.NewFromUint64(100), Start: 10, Cliff: 25, End: 20}, false},
+		{"valid without cliff", VestingSchedule{Amount: *quantity.NewFromUint64(100), Start: 10, Cliff: 10, End: 20}, true},
+		{"valid with cliff", VestingSchedule{Amount: *quantity.NewFromUint64(100), Start: 10, Cliff: 15, End: 20}, true},
+	} {
+		err := tc.schedule.ValidateBasic()
+		if tc.valid {
+			require.NoError(err, tc.msg)
+		} else {
+			require.Error(err, tc.msg)
+		}
+	}
+
+	v := VestingSchedule{
+		Amount: *quantity.NewFromUint64(1_000),
+		Start:  10,
+		Cliff:  15,
+		End:    20,
+	}
+	for _, tc := range []struct {
+		epoch  beacon.EpochTime
+		locked uint64
+	}{
+		{0, 1_000},
+		{10, 1_000},
+		{14, 1_000},
+		{15, 500},
+		{17, 300},
+		{19, 100},
+		{20, 0},
+		{100, 0},
+	} {
+		require.EqualValues(*quantity.NewFromUint64(tc.locked), *v.LockedAmount(tc.epoch), "locked amount at epoch %d", tc.epoch)
+	}
+	require.False(v.IsFullyVested(19))
+	require.True(v.IsFullyVested(20))
+
+	ga := GeneralAccount{
+		Balance: *quantity.NewFromUint64(400),
+		Vesting: &v,
+	}
+	require.ErrorIs(ga.CheckSpendable(15), ErrLockedByVesting, "balance below locked amount should not be spendable")
+	require.NoError(ga.CheckSpendable(17), "balance above locked amount should be spendable")
+	ga.Vesting = nil
+	require.NoError(ga.CheckSpendable(0), "balance without vesting schedule should be spendable")
+
+	// Escrowed locked tokens should count towards the locked amount.
+	ga = GeneralAccount{
+		Balance: *quantity.NewFromUint64(1_200),
+		Vesting: &v,
+	}
+	require.NoError(ga.DelegateVesting(10, quantity.NewFromUint64(600)), "DelegateVesting")
+	require.EqualValues(*quantity.NewFromUint64(600), ga.DelegatedVesting, "locked tokens should be escrowed first")
+	require.EqualValues(*quantity.NewFromUint64(400), *ga.LockedBalance(10), "escrowed tokens should not be locked in the balance")
+	ga.Balance = *quantity.NewFromUint64(400)
+	require.NoError(ga.CheckSpendable(10), "balance covering the remaining locked amount should be spendable")
+	require.NoError(ga.DelegateVesting(10, quantity.NewFromUint64(1_000)), "DelegateVesting")
+	require.EqualValues(*quantity.NewFromUint64(1_000), ga.DelegatedVesting, "delegated vesting should not exceed the locked amount")
+	require.True(ga.LockedBalance(10).IsZero(), "nothing should be locked in the balance")
+
+	// Tokens returned from escrow should be locked first.
+	require.NoError(ga.UndelegateVesting(quantity.NewFromUint64(300)), "UndelegateVesting")
+	require.EqualValues(*quantity.NewFromUint64(700), ga.DelegatedVesting, "returned tokens should be locked first")
+	require.NoError(ga.UndelegateVesting(quantity.NewFromUint64(1_000)), "UndelegateVesting")
+	require.True(ga.DelegatedVesting.IsZero(), "delegated vesting should not underflow")
+}
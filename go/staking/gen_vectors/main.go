@@ -88,6 +88,19 @@ func main() {
 				}
 			}
 
+			// Generate redelegate transactions.
+			for _, amt := range []uint64{0, 1000, 10_000_000} {
+				for _, tx := range []*transaction.Transaction{
+					staking.NewRedelegateTx(nonce, fee, &staking.Redelegate{
+						From:   escrowSrcAddr,
+						To:     escrowDstAddr,
+						Shares: *quantity.NewFromUint64(amt),
+					}),
+				} {
+					vectors = append(vectors, testvectors.MakeTestVector("Redelegate", tx, true))
+				}
+			}
+
 			// Generate amend commission schedule transactions.
 			for _, steps := range []int{0, 1, 2, 5} {
 				for _, startEpoch := range []uint64{0, 10, 1000, 1_000_000} {
@@ -162,6 +162,7 @@ func StakingImplementationTests(
 		{"Burn", testBurn},
 		{"Escrow", testEscrow},
 		{"EscrowSelf", testSelfEscrow},
+		{"Redelegate", testRedelegate},
 		{"Allowance", testAllowance},
 	} {
 		state := newStakingTestsState(t, backend, consensus)
@@ -190,6 +191,7 @@ func StakingClientImplementationTests(t *testing.T, backend api.Backend, consens
 		{"Burn", testBurn},
 		{"Escrow", testEscrow},
 		{"EscrowSelf", testSelfEscrow},
+		{"Redelegate", testRedelegate},
 		{"Allowance", testAllowance},
 	} {
 		state := newStakingTestsState(t, backend, consensus)
@@ -1034,6 +1036,155 @@ func testEscrowHelper( // nolint: gocyclo
 	require.Error(err, "AddEscrow")
 }
 
+func testRedelegate(t *testing.T, state *stakingTestsState, backend api.Backend, consensus consensusAPI.Backend) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	// Account 1 delegates to account 2 and then redelegates everything back to its own escrow.
+	delegatorAccData := state.accounts.getAccount(1)
+	srcAddr := state.accounts.GetAddress(2)
+	dstAddr := delegatorAccData.Address
+
+	params, err := backend.ConsensusParameters(ctx, consensusAPI.HeightLatest)
+	require.NoError(err, "ConsensusParameters")
+
+	ch, sub, err := backend.WatchEvents(ctx)
+	require.NoError(err, "WatchEvents")
+	defer sub.Close()
+
+	acc, err := backend.Account(ctx, &api.OwnerQuery{Owner: delegatorAccData.Address, Height: consensusAPI.HeightLatest})
+	require.NoError(err, "Account")
+
+	escrow := &api.Escrow{
+		Account: srcAddr,
+		Amount:  *quantity.NewFromUint64(1000),
+	}
+	tx := api.NewAddEscrowTx(acc.General.Nonce, nil, escrow)
+	err = consensusAPI.SignAndSubmitTx(ctx, consensus, delegatorAccData.Signer, tx)
+	require.NoError(err, "AddEscrow")
+
+	select {
+	case rawEv := <-ch:
+		if rawEv.Escrow == nil || rawEv.Escrow.Add == nil {
+			t.Fatalf("expected add escrow event, got: %+v", rawEv)
+		}
+	case <-time.After(recvTimeout):
+		t.Fatalf("failed to receive escrow event")
+	}
+
+	dels, err := backend.DelegationsFor(ctx, &api.OwnerQuery{Owner: delegatorAccData.Address, Height: consensusAPI.HeightLatest})
+	require.NoError(err, "DelegationsFor - before")
+	require.Contains(dels, srcAddr, "delegation to source account before redelegating")
+	dstDelegationShares := dels[dstAddr].Shares.Clone()
+
+	dstAcc, err := backend.Account(ctx, &api.OwnerQuery{Owner: dstAddr, Height: consensusAPI.HeightLatest})
+	require.NoError(err, "dst: Account - before")
+
+	// Redelegating to the same account should fail.
+	redelegate := &api.Redelegate{
+		From:   srcAddr,
+		To:     srcAddr,
+		Shares: dels[srcAddr].Shares,
+	}
+	tx = api.NewRedelegateTx(acc.General.Nonce+1, nil, redelegate)
+	err = consensusAPI.SignAndSubmitTx(ctx, consensus, delegatorAccData.Signer, tx)
+	require.Error(err, "Redelegate (same account)")
+
+	// Redelegate.
+	redelegate.To = dstAddr
+	tx = api.NewRedelegateTx(acc.General.Nonce+1, nil, redelegate)
+	err = consensusAPI.SignAndSubmitTx(ctx, consensus, delegatorAccData.Signer, tx)
+	require.NoError(err, "Redelegate")
+
+	epoch, err := consensus.Beacon().GetEpoch(ctx, consensusAPI.HeightLatest)
+	require.NoError(err, "GetEpoch")
+
+	var newShares quantity.Quantity
+	select {
+	case rawEv := <-ch:
+		if rawEv.Escrow == nil || rawEv.Escrow.Redelegate == nil {
+			t.Fatalf("expected redelegate escrow event, got: %+v", rawEv)
+		}
+
+		ev := rawEv.Escrow.Redelegate
+		require.Equal(delegatorAccData.Address, ev.Owner, "Event: owner")
+		require.Equal(srcAddr, ev.From, "Event: from")
+		require.Equal(dstAddr, ev.To, "Event: to")
+		require.Equal(escrow.Amount, ev.Amount, "Event: amount") // Nothing else is escrowed, so ratio is 1:1.
+		require.Equal(redelegate.Shares, ev.SourceShares, "Event: source shares")
+		require.Equal(epoch+params.DebondingInterval, ev.ExposureEndTime, "Event: exposure end time")
+		newShares = ev.NewShares
+
+		// Make sure that GetEvents also returns the redelegate escrow event.
+		evts, grr := backend.GetEvents(ctx, rawEv.Height)
+		require.NoError(grr, "GetEvents")
+		var gotIt bool
+		for _, evt := range evts {
+			if evt.Escrow != nil && evt.Escrow.Redelegate != nil {
+				if evt.Escrow.Redelegate.Owner.Equal(ev.Owner) && evt.Escrow.Redelegate.To.Equal(ev.To) && evt.Escrow.Redelegate.Amount.Cmp(&ev.Amount) == 0 {
+					gotIt = true
+					break
+				}
+			}
+		}
+		require.EqualValues(true, gotIt, "GetEvents should return redelegate escrow event")
+	case <-time.After(recvTimeout):
+		t.Fatalf("failed to receive redelegate escrow event")
+	}
+
+	srcAcc, err := backend.Account(ctx, &api.OwnerQuery{Owner: srcAddr, Height: consensusAPI.HeightLatest})
+	require.NoError(err, "src: Account - after")
+	require.True(srcAcc.Escrow.Active.Balance.IsZero(), "src: active escrow balance == 0 - after")
+	require.True(srcAcc.Escrow.Active.TotalShares.IsZero(), "src: active escrow total shares == 0 - after")
+
+	newDstAcc, err := backend.Account(ctx, &api.OwnerQuery{Owner: dstAddr, Height: consensusAPI.HeightLatest})
+	require.NoError(err, "dst: Account - after")
+	require.NoError(dstAcc.Escrow.Active.Balance.Add(&escrow.Amount))
+	require.Equal(dstAcc.Escrow.Active.Balance, newDstAcc.Escrow.Active.Balance, "dst: active escrow balance - after")
+
+	dels, err = backend.DelegationsFor(ctx, &api.OwnerQuery{Owner: delegatorAccData.Address, Height: consensusAPI.HeightLatest})
+	require.NoError(err, "DelegationsFor - after")
+	require.NotContains(dels, srcAddr, "no delegation to source account after redelegating")
+	require.NoError(dstDelegationShares.Add(&newShares))
+	require.Equal(*dstDelegationShares, dels[dstAddr].Shares, "delegation shares to destination account - after")
+
+	// Reclaim the redelegated stake so that the delegations remain as in genesis.
+	reclaim := &api.ReclaimEscrow{
+		Account: dstAddr,
+		Shares:  newShares,
+	}
+	tx = api.NewReclaimEscrowTx(acc.General.Nonce+2, nil, reclaim)
+	err = consensusAPI.SignAndSubmitTx(ctx, consensus, delegatorAccData.Signer, tx)
+	require.NoError(err, "ReclaimEscrow")
+
+	select {
+	case rawEv := <-ch:
+		if rawEv.Escrow == nil || rawEv.Escrow.DebondingStart == nil {
+			t.Fatalf("expected debonding start event, got: %+v", rawEv)
+		}
+	case <-time.After(recvTimeout):
+		t.Fatalf("failed to receive debonding start event")
+	}
+
+	// Advance epoch to trigger debonding and the end of redelegation exposure.
+	timeSource := consensus.Beacon().(beacon.SetableBackend)
+	beaconTests.MustAdvanceEpoch(t, timeSource)
+
+	select {
+	case rawEv := <-ch:
+		if rawEv.Escrow == nil || rawEv.Escrow.Reclaim == nil {
+			t.Fatalf("expected reclaim escrow event, got: %+v", rawEv)
+		}
+	case <-time.After(recvTimeout):
+		t.Fatalf("failed to receive reclaim escrow event")
+	}
+
+	// Redelegating without any shares in the source account should fail.
+	tx = api.NewRedelegateTx(acc.General.Nonce+3, nil, redelegate)
+	err = consensusAPI.SignAndSubmitTx(ctx, consensus, delegatorAccData.Signer, tx)
+	require.Error(err, "Redelegate (without enough shares)")
+}
+
 func testAllowance(t *testing.T, state *stakingTestsState, backend api.Backend, consensus consensusAPI.Backend) {
 	testAllowanceHelper(t, state, backend, consensus, state.accounts.getAccount(1), state.accounts.getAccount(2))
 }
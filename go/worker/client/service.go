@@ -22,7 +22,7 @@ type service struct {
 }
 
 func (s *service) submitTx(ctx context.Context, request *api.SubmitTxRequest) (<-chan *api.SubmitTxResult, *protocol.Error, error) {
-	rt := s.w.runtimes[request.RuntimeID]
+	rt := s.w.getRuntime(request.RuntimeID)
 	if rt == nil {
 		return nil, nil, api.ErrNoHostedRuntime
 	}
@@ -86,7 +86,7 @@ func (s *service) SubmitTxNoWait(ctx context.Context, request *api.SubmitTxReque
 
 // Implements api.RuntimeClient.
 func (s *service) CheckTx(ctx context.Context, request *api.CheckTxRequest) error {
-	rt := s.w.runtimes[request.RuntimeID]
+	rt := s.w.getRuntime(request.RuntimeID)
 	if rt == nil {
 		return api.ErrNoHostedRuntime
 	}
@@ -271,7 +271,7 @@ func (s *service) GetEvents(ctx context.Context, request *api.GetEventsRequest)
 
 // Implements api.RuntimeClient.
 func (s *service) Query(ctx context.Context, request *api.QueryRequest) (*api.QueryResponse, error) {
-	rt := s.w.runtimes[request.RuntimeID]
+	rt := s.w.getRuntime(request.RuntimeID)
 	if rt == nil {
 		return nil, api.ErrNoHostedRuntime
 	}
@@ -2,6 +2,9 @@
 package client
 
 import (
+	"fmt"
+	"sync"
+
 	"github.com/oasisprotocol/oasis-core/go/common"
 	"github.com/oasisprotocol/oasis-core/go/common/grpc"
 	"github.com/oasisprotocol/oasis-core/go/common/logging"
@@ -14,7 +17,10 @@ import (
 
 // Worker is a runtime client worker handling many runtimes.
 type Worker struct {
+	sync.RWMutex
+
 	enabled bool
+	started bool
 
 	commonWorker *workerCommon.Worker
 
@@ -42,18 +48,23 @@ func (w *Worker) Start() error {
 		return nil
 	}
 
+	w.Lock()
+	defer w.Unlock()
+
+	runtimes := w.getRuntimesLocked()
+
 	// Wait for all runtimes to terminate.
 	go func() {
 		defer close(w.quitCh)
 
-		for _, rt := range w.runtimes {
+		for _, rt := range runtimes {
 			<-rt.Quit()
 		}
 	}()
 
 	// Wait for all runtimes to be initialized.
 	go func() {
-		for _, rt := range w.runtimes {
+		for _, rt := range runtimes {
 			<-rt.Initialized()
 		}
 
@@ -61,7 +72,8 @@ func (w *Worker) Start() error {
 	}()
 
 	// Start runtime services.
-	for id, rt := range w.runtimes {
+	w.started = true
+	for id, rt := range runtimes {
 		w.logger.Info("starting services for runtime",
 			"runtime_id", id,
 		)
@@ -81,7 +93,7 @@ func (w *Worker) Stop() {
 		return
 	}
 
-	for id, rt := range w.runtimes {
+	for id, rt := range w.getRuntimes() {
 		w.logger.Info("stopping services for runtime",
 			"runtime_id", id,
 		)
@@ -106,7 +118,7 @@ func (w *Worker) Cleanup() {
 		return
 	}
 
-	for _, rt := range w.runtimes {
+	for _, rt := range w.getRuntimes() {
 		rt.Cleanup()
 	}
 }
@@ -117,6 +129,87 @@ func (w *Worker) Initialized() <-chan struct{} {
 	return w.initCh
 }
 
+func (w *Worker) getRuntime(id common.Namespace) *committee.Node {
+	w.RLock()
+	defer w.RUnlock()
+
+	return w.runtimes[id]
+}
+
+func (w *Worker) getRuntimes() map[common.Namespace]*committee.Node {
+	w.RLock()
+	defer w.RUnlock()
+
+	return w.getRuntimesLocked()
+}
+
+func (w *Worker) getRuntimesLocked() map[common.Namespace]*committee.Node {
+	runtimes := make(map[common.Namespace]*committee.Node, len(w.runtimes))
+	for id, rt := range w.runtimes {
+		runtimes[id] = rt
+	}
+	return runtimes
+}
+
+// AddRuntime registers a new runtime with a running worker and starts its services.
+//
+// In case the worker is disabled this method does nothing.
+func (w *Worker) AddRuntime(commonNode *committeeCommon.Node) error {
+	if !w.enabled {
+		return nil
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	id := commonNode.Runtime.ID()
+	if _, ok := w.runtimes[id]; ok {
+		return fmt.Errorf("worker/client: runtime already registered: %s", id)
+	}
+	if err := w.registerRuntime(commonNode); err != nil {
+		return err
+	}
+	if !w.started {
+		// Runtime will be started together with the worker.
+		return nil
+	}
+
+	w.logger.Info("starting services for runtime",
+		"runtime_id", id,
+	)
+
+	return w.runtimes[id].Start()
+}
+
+// RemoveRuntime stops the services for the given runtime and deregisters it from the worker.
+//
+// In case the runtime is not registered this method does nothing.
+func (w *Worker) RemoveRuntime(id common.Namespace) {
+	w.Lock()
+	rt, ok := w.runtimes[id]
+	delete(w.runtimes, id)
+	started := w.started
+	w.Unlock()
+
+	if !ok {
+		return
+	}
+
+	w.logger.Info("stopping services for runtime",
+		"runtime_id", id,
+	)
+
+	rt.Stop()
+	if started {
+		<-rt.Quit()
+	}
+	rt.Cleanup()
+
+	w.logger.Info("runtime deregistered",
+		"runtime_id", id,
+	)
+}
+
 func (w *Worker) registerRuntime(commonNode *committeeCommon.Node) error {
 	id := commonNode.Runtime.ID()
 
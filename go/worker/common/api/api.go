@@ -3,6 +3,7 @@ package api
 import (
 	"fmt"
 
+	beacon "github.com/oasisprotocol/oasis-core/go/beacon/api"
 	"github.com/oasisprotocol/oasis-core/go/common/version"
 	scheduler "github.com/oasisprotocol/oasis-core/go/scheduler/api"
 )
@@ -133,6 +134,38 @@ type Status struct {
 type HostStatus struct {
 	// Versions are the locally supported versions.
 	Versions []version.Version `json:"versions"`
+	// Bundles is the status of runtime bundles automatically fetched for upcoming deployments.
+	Bundles []BundleStatus `json:"bundles,omitempty"`
+	// Processes are the resource usage statistics of the running runtime processes.
+	Processes []ProcessStats `json:"processes,omitempty"`
+}
+
+// BundleStatus is the status of an automatically fetched runtime bundle.
+type BundleStatus struct {
+	// Version is the runtime version of the bundle.
+	Version version.Version `json:"version"`
+	// ValidFrom is the epoch at which the corresponding deployment becomes active.
+	ValidFrom beacon.EpochTime `json:"valid_from"`
+	// Ready indicates whether the bundle has been fetched, verified and staged.
+	Ready bool `json:"ready"`
+	// Error is the error encountered during the last staging attempt (if any).
+	Error string `json:"error,omitempty"`
+}
+
+// ProcessStats are the resource usage statistics of the processes of a running runtime version.
+type ProcessStats struct {
+	// Version is the runtime version.
+	Version version.Version `json:"version"`
+	// NumProcesses is the number of runtime processes.
+	NumProcesses uint64 `json:"num_processes"`
+	// CPUSeconds is the CPU time spent in user and kernel mode (in seconds).
+	CPUSeconds float64 `json:"cpu_seconds"`
+	// RSSBytes is the resident set size (in bytes).
+	RSSBytes uint64 `json:"rss_bytes"`
+	// IOReadBytes is the number of bytes read from the storage layer.
+	IOReadBytes uint64 `json:"io_read_bytes"`
+	// IOWriteBytes is the number of bytes written to the storage layer.
+	IOWriteBytes uint64 `json:"io_write_bytes"`
 }
 
 // LivenessStatus is the liveness status for the current epoch.
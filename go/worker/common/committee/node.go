@@ -3,6 +3,7 @@ package committee
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -329,6 +330,19 @@ func (n *Node) GetStatus(ctx context.Context) (*api.Status, error) {
 	status.Peers = n.P2P.Peers(n.Runtime.ID())
 
 	status.Host.Versions = n.Runtime.HostVersions()
+	status.Host.Bundles = n.Runtime.StagedBundles()
+	if rt := n.GetHostedRuntime(); rt != nil {
+		for _, st := range rt.GetProcessStats() {
+			status.Host.Processes = append(status.Host.Processes, api.ProcessStats{
+				Version:      st.Version,
+				NumProcesses: st.NumProcesses,
+				CPUSeconds:   st.CPUSeconds,
+				RSSBytes:     st.RSSBytes,
+				IOReadBytes:  st.IOReadBytes,
+				IOWriteBytes: st.IOWriteBytes,
+			})
+		}
+	}
 
 	return &status, nil
 }
@@ -919,7 +933,13 @@ func NewNode(
 	}
 	n.RuntimeHostNode = rhn
 
-	// Prepare transaction pool.
+	// Prepare transaction pool. A relative journal path is resolved against the runtime data
+	// directory as the configuration is shared by all runtimes.
+	if txPoolCfg.JournalPath != "" && !filepath.IsAbs(txPoolCfg.JournalPath) {
+		cfg := *txPoolCfg
+		cfg.JournalPath = filepath.Join(runtime.DataDir(), cfg.JournalPath)
+		txPoolCfg = &cfg
+	}
 	txPool, err := txpool.New(runtime.ID(), txPoolCfg, n, runtime.History(), n)
 	if err != nil {
 		return nil, fmt.Errorf("error creating transaction pool: %w", err)
@@ -25,6 +25,10 @@ var (
 	cfgScheduleTxCacheSize = "worker.tx_pool.schedule_tx_cache_size"
 	cfgCheckTxMaxBatchSize = "worker.tx_pool.check_tx_max_batch_size"
 	cfgRecheckInterval     = "worker.tx_pool.recheck_interval"
+	cfgSchedulePolicy      = "worker.tx_pool.schedule_policy"
+	cfgMaxTxsPerSender     = "worker.tx_pool.schedule_max_txs_per_sender"
+	cfgJournalEnabled      = "worker.tx_pool.journal.enabled"
+	cfgJournalMaxSize      = "worker.tx_pool.journal.max_size"
 
 	// Flags has the configuration flags.
 	Flags = flag.NewFlagSet("", flag.ContinueOnError)
@@ -74,6 +78,12 @@ func NewConfig() (*Config, error) {
 		sentryAddresses = append(sentryAddresses, tlsAddr)
 	}
 
+	// The transaction pool journal is stored in the runtime data directory.
+	var journalPath string
+	if viper.GetBool(cfgJournalEnabled) {
+		journalPath = txpool.JournalFilename
+	}
+
 	cfg := Config{
 		ClientPort:      uint16(viper.GetInt(CfgClientPort)),
 		SentryAddresses: sentryAddresses,
@@ -86,6 +96,12 @@ func NewConfig() (*Config, error) {
 			RepublishInterval: 60 * time.Second,
 
 			RecheckInterval: viper.GetUint64(cfgRecheckInterval),
+
+			SchedulePolicy:  viper.GetString(cfgSchedulePolicy),
+			MaxTxsPerSender: viper.GetUint64(cfgMaxTxsPerSender),
+
+			JournalPath:    journalPath,
+			MaxJournalSize: viper.GetUint64(cfgJournalMaxSize),
 		},
 		logger: logging.GetLogger("worker/config"),
 	}
@@ -101,6 +117,10 @@ func init() {
 	Flags.Uint64(cfgScheduleTxCacheSize, 100_000, "Maximum cache size of recently scheduled transactions to prevent re-scheduling")
 	Flags.Uint64(cfgCheckTxMaxBatchSize, 1000, "Maximum check tx batch size")
 	Flags.Uint64(cfgRecheckInterval, 5, "Transaction recheck interval (in rounds)")
+	Flags.String(cfgSchedulePolicy, txpool.SchedulePolicyPriority, fmt.Sprintf("Transaction pool schedule queue policy (one of %v)", txpool.SchedulePolicies()))
+	Flags.Uint64(cfgMaxTxsPerSender, 16, "Maximum number of transactions per sender in the schedule queue (for policies that support it)")
+	Flags.Bool(cfgJournalEnabled, false, "Persist local and scheduled transactions in an on-disk journal and reload them on restart")
+	Flags.Uint64(cfgJournalMaxSize, 64*1024*1024, "Maximum size (in bytes) of the transaction pool journal")
 
 	_ = viper.BindPFlags(Flags)
 }
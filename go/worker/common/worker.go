@@ -3,6 +3,7 @@ package common
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/oasisprotocol/oasis-core/go/common"
 	"github.com/oasisprotocol/oasis-core/go/common/identity"
@@ -12,13 +13,18 @@ import (
 	ias "github.com/oasisprotocol/oasis-core/go/ias/api"
 	keymanagerApi "github.com/oasisprotocol/oasis-core/go/keymanager/api"
 	p2p "github.com/oasisprotocol/oasis-core/go/p2p/api"
+	"github.com/oasisprotocol/oasis-core/go/p2p/protocol"
 	runtimeRegistry "github.com/oasisprotocol/oasis-core/go/runtime/registry"
 	"github.com/oasisprotocol/oasis-core/go/worker/common/committee"
+	"github.com/oasisprotocol/oasis-core/go/worker/common/p2p/txsync"
 )
 
 // Worker is a garbage bag with lower level services and common runtime objects.
 type Worker struct {
+	sync.RWMutex
+
 	enabled bool
+	started bool
 	cfg     Config
 
 	HostNode        control.NodeController
@@ -58,18 +64,23 @@ func (w *Worker) Start() error {
 		return nil
 	}
 
+	w.Lock()
+	defer w.Unlock()
+
+	runtimes := w.getRuntimesLocked()
+
 	// Wait for all runtimes to terminate.
 	go func() {
 		defer close(w.quitCh)
 
-		for _, rt := range w.runtimes {
+		for _, rt := range runtimes {
 			<-rt.Quit()
 		}
 	}()
 
 	// Wait for all runtimes to be initialized.
 	go func() {
-		for _, rt := range w.runtimes {
+		for _, rt := range runtimes {
 			<-rt.Initialized()
 		}
 
@@ -77,7 +88,8 @@ func (w *Worker) Start() error {
 	}()
 
 	// Start runtime services.
-	for id, rt := range w.runtimes {
+	w.started = true
+	for id, rt := range runtimes {
 		w.logger.Info("starting services for runtime",
 			"runtime_id", id,
 		)
@@ -97,7 +109,7 @@ func (w *Worker) Stop() {
 		return
 	}
 
-	for id, rt := range w.runtimes {
+	for id, rt := range w.GetRuntimes() {
 		w.logger.Info("stopping services for runtime",
 			"runtime_id", id,
 		)
@@ -124,7 +136,7 @@ func (w *Worker) Cleanup() {
 		return
 	}
 
-	for _, rt := range w.runtimes {
+	for _, rt := range w.GetRuntimes() {
 		rt.Cleanup()
 	}
 }
@@ -142,16 +154,110 @@ func (w *Worker) GetConfig() Config {
 
 // GetRuntimes returns a map of configured runtimes.
 func (w *Worker) GetRuntimes() map[common.Namespace]*committee.Node {
-	return w.runtimes
+	w.RLock()
+	defer w.RUnlock()
+
+	return w.getRuntimesLocked()
+}
+
+func (w *Worker) getRuntimesLocked() map[common.Namespace]*committee.Node {
+	runtimes := make(map[common.Namespace]*committee.Node, len(w.runtimes))
+	for id, rt := range w.runtimes {
+		runtimes[id] = rt
+	}
+	return runtimes
 }
 
 // GetRuntime returns a common committee node for the given runtime (if available).
 //
 // In case the runtime with the specified id was not configured for this node it returns nil.
 func (w *Worker) GetRuntime(id common.Namespace) *committee.Node {
+	w.RLock()
+	defer w.RUnlock()
+
 	return w.runtimes[id]
 }
 
+// AddRuntime registers a new runtime with a running worker and starts its services.
+//
+// The given setup function is called with the new common committee node before it is started
+// so that other workers can register their own services for the runtime. In case setup fails,
+// the runtime is deregistered again.
+func (w *Worker) AddRuntime(runtime runtimeRegistry.Runtime, setup func(*committee.Node) error) (*committee.Node, error) {
+	if !w.enabled {
+		return nil, fmt.Errorf("worker/common: worker is disabled")
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	id := runtime.ID()
+	if _, ok := w.runtimes[id]; ok {
+		return nil, fmt.Errorf("worker/common: runtime already registered: %s", id)
+	}
+	if err := w.registerRuntime(runtime); err != nil {
+		return nil, err
+	}
+	rt := w.runtimes[id]
+
+	if err := setup(rt); err != nil {
+		delete(w.runtimes, id)
+		rt.Cleanup()
+		w.unregisterRuntimeServices(id)
+		return nil, err
+	}
+	if !w.started {
+		// Runtime will be started together with the worker.
+		return rt, nil
+	}
+
+	w.logger.Info("starting services for runtime",
+		"runtime_id", id,
+	)
+
+	if err := rt.Start(); err != nil {
+		return nil, err
+	}
+	return rt, nil
+}
+
+// RemoveRuntime stops the services for the given runtime and deregisters it from the worker.
+func (w *Worker) RemoveRuntime(id common.Namespace) error {
+	w.Lock()
+	rt, ok := w.runtimes[id]
+	if !ok {
+		w.Unlock()
+		return fmt.Errorf("worker/common: runtime not registered: %s", id)
+	}
+	delete(w.runtimes, id)
+	started := w.started
+	w.Unlock()
+
+	w.logger.Info("stopping services for runtime",
+		"runtime_id", id,
+	)
+
+	rt.Stop()
+	if started {
+		<-rt.Quit()
+	}
+	rt.Cleanup()
+	w.unregisterRuntimeServices(id)
+
+	w.logger.Info("runtime deregistered",
+		"runtime_id", id,
+	)
+
+	return nil
+}
+
+// unregisterRuntimeServices unregisters the P2P services registered by the common committee
+// node of the given runtime.
+func (w *Worker) unregisterRuntimeServices(id common.Namespace) {
+	w.P2P.UnregisterHandler(protocol.NewTopicKindTxID(w.ChainContext, id))
+	w.P2P.UnregisterProtocolServer(protocol.NewRuntimeProtocolID(w.ChainContext, id, txsync.TxSyncProtocolID, txsync.TxSyncProtocolVersion))
+}
+
 func (w *Worker) registerRuntime(runtime runtimeRegistry.Runtime) error {
 	id := runtime.ID()
 	w.logger.Info("registering new runtime",
@@ -1446,6 +1446,9 @@ func (n *Node) HandleRuntimeHostEventLocked(ev *host.Event) {
 
 		// Cancel any outstanding runtime light client sync.
 		n.cancelRuntimeTrustSyncLocked()
+	case ev.ResourceLimitHit != nil:
+		// Resource limit hits do not affect runtime availability.
+		return
 	default:
 		// Unknown event.
 		n.logger.Warn("unknown worker event",
@@ -3,20 +3,26 @@ package executor
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/oasisprotocol/oasis-core/go/common"
 	"github.com/oasisprotocol/oasis-core/go/common/logging"
 	"github.com/oasisprotocol/oasis-core/go/common/node"
+	"github.com/oasisprotocol/oasis-core/go/p2p/protocol"
 	runtimeRegistry "github.com/oasisprotocol/oasis-core/go/runtime/registry"
 	workerCommon "github.com/oasisprotocol/oasis-core/go/worker/common"
 	committeeCommon "github.com/oasisprotocol/oasis-core/go/worker/common/committee"
+	"github.com/oasisprotocol/oasis-core/go/worker/common/p2p/txsync"
 	"github.com/oasisprotocol/oasis-core/go/worker/compute/executor/committee"
 	"github.com/oasisprotocol/oasis-core/go/worker/registration"
 )
 
 // Worker is an executor worker handling many runtimes.
 type Worker struct {
+	sync.RWMutex
+
 	enabled bool
+	started bool
 
 	commonWorker *workerCommon.Worker
 	registration *registration.Worker
@@ -47,12 +53,17 @@ func (w *Worker) Start() error {
 		return nil
 	}
 
+	w.Lock()
+	defer w.Unlock()
+
+	runtimes := w.getRuntimesLocked()
+
 	// Wait for all runtimes and all proxies to terminate.
 	go func() {
 		defer close(w.quitCh)
 		defer (w.cancelCtx)()
 
-		for _, rt := range w.runtimes {
+		for _, rt := range runtimes {
 			<-rt.Quit()
 		}
 	}()
@@ -60,7 +71,7 @@ func (w *Worker) Start() error {
 	// Wait for all runtimes to be initialized and for the node
 	// to be registered for the current epoch.
 	go func() {
-		for _, rt := range w.runtimes {
+		for _, rt := range runtimes {
 			<-rt.Initialized()
 		}
 
@@ -70,7 +81,8 @@ func (w *Worker) Start() error {
 	}()
 
 	// Start runtime services.
-	for id, rt := range w.runtimes {
+	w.started = true
+	for id, rt := range runtimes {
 		w.logger.Info("starting services for runtime",
 			"runtime_id", id,
 		)
@@ -90,7 +102,7 @@ func (w *Worker) Stop() {
 		return
 	}
 
-	for id, rt := range w.runtimes {
+	for id, rt := range w.getRuntimes() {
 		w.logger.Info("stopping services for runtime",
 			"runtime_id", id,
 		)
@@ -115,7 +127,7 @@ func (w *Worker) Cleanup() {
 		return
 	}
 
-	for _, rt := range w.runtimes {
+	for _, rt := range w.getRuntimes() {
 		rt.Cleanup()
 	}
 }
@@ -131,9 +143,91 @@ func (w *Worker) Initialized() <-chan struct{} {
 // In case the runtime with the specified id was not registered it
 // returns nil.
 func (w *Worker) GetRuntime(id common.Namespace) *committee.Node {
+	w.RLock()
+	defer w.RUnlock()
+
 	return w.runtimes[id]
 }
 
+func (w *Worker) getRuntimes() map[common.Namespace]*committee.Node {
+	w.RLock()
+	defer w.RUnlock()
+
+	return w.getRuntimesLocked()
+}
+
+func (w *Worker) getRuntimesLocked() map[common.Namespace]*committee.Node {
+	runtimes := make(map[common.Namespace]*committee.Node, len(w.runtimes))
+	for id, rt := range w.runtimes {
+		runtimes[id] = rt
+	}
+	return runtimes
+}
+
+// AddRuntime registers a new runtime with a running worker and starts its services.
+//
+// In case the worker is disabled this method does nothing.
+func (w *Worker) AddRuntime(commonNode *committeeCommon.Node) error {
+	if !w.enabled {
+		return nil
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	id := commonNode.Runtime.ID()
+	if _, ok := w.runtimes[id]; ok {
+		return fmt.Errorf("worker/executor: runtime already registered: %s", id)
+	}
+	if err := w.registerRuntime(commonNode); err != nil {
+		return err
+	}
+	if !w.started {
+		// Runtime will be started together with the worker.
+		return nil
+	}
+
+	w.logger.Info("starting services for runtime",
+		"runtime_id", id,
+	)
+
+	return w.runtimes[id].Start()
+}
+
+// RemoveRuntime stops the services for the given runtime and deregisters it from the worker.
+//
+// In case the runtime is not registered this method does nothing.
+func (w *Worker) RemoveRuntime(id common.Namespace) {
+	w.Lock()
+	rt, ok := w.runtimes[id]
+	delete(w.runtimes, id)
+	started := w.started
+	w.Unlock()
+
+	if !ok {
+		return
+	}
+
+	w.logger.Info("stopping services for runtime",
+		"runtime_id", id,
+	)
+
+	rt.Stop()
+	if started {
+		<-rt.Quit()
+	}
+	rt.Cleanup()
+
+	// Unregister P2P handlers.
+	chainContext := w.commonWorker.ChainContext
+	w.commonWorker.P2P.UnregisterHandler(protocol.NewTopicKindCommitteeID(chainContext, id))
+	w.commonWorker.P2P.UnregisterProtocol(protocol.NewRuntimeProtocolID(chainContext, id, txsync.TxSyncProtocolID, txsync.TxSyncProtocolVersion))
+
+	w.logger.Info("runtime deregistered",
+		"runtime_id", id,
+	)
+}
+
 func (w *Worker) registerRuntime(commonNode *committeeCommon.Node) error {
 	id := commonNode.Runtime.ID()
 	w.logger.Info("registering new runtime",
@@ -650,6 +650,8 @@ func (w *Worker) worker() { // nolint: gocyclo
 				// Worker failed to start or was stopped -- we can no longer service requests.
 				currentRuntimeStatus = nil
 				w.roleProvider.SetUnavailable()
+			case ev.ResourceLimitHit != nil:
+				// Resource limit hits do not affect runtime availability.
 			default:
 				// Unknown event.
 				w.logger.Warn("unknown worker event",
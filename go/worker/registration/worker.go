@@ -813,6 +813,27 @@ func (w *Worker) NewRuntimeRoleProvider(role node.RolesMask, runtimeID common.Na
 	return w.newRoleProvider(role, &runtimeID)
 }
 
+// RemoveRuntimeRoleProviders removes all role providers for the given runtime and triggers a
+// node re-registration so that the runtime is no longer included in the node descriptor.
+func (w *Worker) RemoveRuntimeRoleProviders(runtimeID common.Namespace) {
+	w.logger.Debug("removing runtime role providers",
+		"id", runtimeID,
+	)
+
+	w.Lock()
+	roleProviders := w.roleProviders[:0]
+	for _, rp := range w.roleProviders {
+		if rp.runtimeID != nil && rp.runtimeID.Equal(&runtimeID) {
+			continue
+		}
+		roleProviders = append(roleProviders, rp)
+	}
+	w.roleProviders = roleProviders
+	w.Unlock()
+
+	w.registerCh <- struct{}{}
+}
+
 func (w *Worker) newRoleProvider(role node.RolesMask, runtimeID *common.Namespace) (RoleProvider, error) {
 	w.logger.Debug("new role provider",
 		"id", runtimeID,
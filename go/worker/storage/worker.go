@@ -2,6 +2,7 @@ package storage
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/spf13/viper"
 
@@ -11,17 +12,23 @@ import (
 	"github.com/oasisprotocol/oasis-core/go/common/node"
 	"github.com/oasisprotocol/oasis-core/go/common/workerpool"
 	genesis "github.com/oasisprotocol/oasis-core/go/genesis/api"
+	"github.com/oasisprotocol/oasis-core/go/p2p/protocol"
 	"github.com/oasisprotocol/oasis-core/go/storage/mkvs/checkpoint"
 	workerCommon "github.com/oasisprotocol/oasis-core/go/worker/common"
 	committeeCommon "github.com/oasisprotocol/oasis-core/go/worker/common/committee"
 	"github.com/oasisprotocol/oasis-core/go/worker/registration"
 	storageWorkerAPI "github.com/oasisprotocol/oasis-core/go/worker/storage/api"
 	"github.com/oasisprotocol/oasis-core/go/worker/storage/committee"
+	storagePub "github.com/oasisprotocol/oasis-core/go/worker/storage/p2p/pub"
+	storageSync "github.com/oasisprotocol/oasis-core/go/worker/storage/p2p/sync"
 )
 
 // Worker is a worker handling storage operations.
 type Worker struct {
+	sync.RWMutex
+
 	enabled bool
+	started bool
 
 	commonWorker *workerCommon.Worker
 	registration *registration.Worker
@@ -30,8 +37,9 @@ type Worker struct {
 	initCh chan struct{}
 	quitCh chan struct{}
 
-	runtimes  map[common.Namespace]*committee.Node
-	fetchPool *workerpool.Pool
+	runtimes        map[common.Namespace]*committee.Node
+	fetchPool       *workerpool.Pool
+	checkpointerCfg *checkpoint.CheckpointerConfig
 }
 
 // New constructs a new storage worker.
@@ -59,16 +67,15 @@ func New(
 	s.fetchPool = workerpool.New("storage_fetch")
 	s.fetchPool.Resize(viper.GetUint(cfgWorkerFetcherCount))
 
-	var checkpointerCfg *checkpoint.CheckpointerConfig
 	if viper.GetBool(CfgWorkerCheckpointerEnabled) {
-		checkpointerCfg = &checkpoint.CheckpointerConfig{
+		s.checkpointerCfg = &checkpoint.CheckpointerConfig{
 			CheckInterval: viper.GetDuration(CfgWorkerCheckpointCheckInterval),
 		}
 	}
 
 	// Start storage node for every runtime.
 	for id, rt := range s.commonWorker.GetRuntimes() {
-		if err := s.registerRuntime(commonWorker.DataDir, rt, checkpointerCfg); err != nil {
+		if err := s.registerRuntime(rt); err != nil {
 			return nil, fmt.Errorf("failed to create storage worker for runtime %s: %w", id, err)
 		}
 	}
@@ -79,7 +86,7 @@ func New(
 	return s, nil
 }
 
-func (w *Worker) registerRuntime(dataDir string, commonNode *committeeCommon.Node, checkpointerCfg *checkpoint.CheckpointerConfig) error {
+func (w *Worker) registerRuntime(commonNode *committeeCommon.Node) error {
 	id := commonNode.Runtime.ID()
 	w.logger.Info("registering new runtime",
 		"runtime_id", id,
@@ -109,7 +116,7 @@ func (w *Worker) registerRuntime(dataDir string, commonNode *committeeCommon.Nod
 		rpRPC,
 		w.commonWorker.GetConfig(),
 		localStorage,
-		checkpointerCfg,
+		w.checkpointerCfg,
 		&committee.CheckpointSyncConfig{
 			Disabled:          viper.GetBool(CfgWorkerCheckpointSyncDisabled),
 			ChunkFetcherCount: viper.GetUint(cfgWorkerFetcherCount),
@@ -156,11 +163,17 @@ func (w *Worker) Start() error {
 		return nil
 	}
 
+	w.Lock()
+	defer w.Unlock()
+
+	w.started = true
+	runtimes := w.getRuntimesLocked()
+
 	// Wait for all runtimes to terminate.
 	go func() {
 		defer close(w.quitCh)
 
-		for _, r := range w.runtimes {
+		for _, r := range runtimes {
 			<-r.Quit()
 		}
 		if w.fetchPool != nil {
@@ -170,14 +183,14 @@ func (w *Worker) Start() error {
 
 	// Start all runtimes and wait for initialization.
 	go func() {
-		w.logger.Info("starting storage sync services", "num_runtimes", len(w.runtimes))
+		w.logger.Info("starting storage sync services", "num_runtimes", len(runtimes))
 
-		for _, r := range w.runtimes {
+		for _, r := range runtimes {
 			_ = r.Start()
 		}
 
 		// Wait for runtimes to be initialized and the node to be registered.
-		for _, r := range w.runtimes {
+		for _, r := range runtimes {
 			<-r.Initialized()
 		}
 
@@ -198,7 +211,7 @@ func (w *Worker) Stop() {
 		return
 	}
 
-	for _, r := range w.runtimes {
+	for _, r := range w.getRuntimes() {
 		r.Stop()
 	}
 	if w.fetchPool != nil {
@@ -219,5 +232,91 @@ func (w *Worker) Cleanup() {
 //
 // In case the runtime with the specified id was not configured for this node it returns nil.
 func (w *Worker) GetRuntime(id common.Namespace) *committee.Node {
+	w.RLock()
+	defer w.RUnlock()
+
 	return w.runtimes[id]
 }
+
+func (w *Worker) getRuntimes() map[common.Namespace]*committee.Node {
+	w.RLock()
+	defer w.RUnlock()
+
+	return w.getRuntimesLocked()
+}
+
+func (w *Worker) getRuntimesLocked() map[common.Namespace]*committee.Node {
+	runtimes := make(map[common.Namespace]*committee.Node, len(w.runtimes))
+	for id, rt := range w.runtimes {
+		runtimes[id] = rt
+	}
+	return runtimes
+}
+
+// AddRuntime registers a new runtime with a running worker and starts its services.
+//
+// In case the worker is disabled this method does nothing.
+func (w *Worker) AddRuntime(commonNode *committeeCommon.Node) error {
+	if !w.enabled {
+		return nil
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	id := commonNode.Runtime.ID()
+	if _, ok := w.runtimes[id]; ok {
+		return fmt.Errorf("worker/storage: runtime already registered: %s", id)
+	}
+	if err := w.registerRuntime(commonNode); err != nil {
+		return err
+	}
+	if !w.started {
+		// Runtime will be started together with the worker.
+		return nil
+	}
+
+	w.logger.Info("starting storage sync services for runtime",
+		"runtime_id", id,
+	)
+
+	return w.runtimes[id].Start()
+}
+
+// RemoveRuntime stops the storage services for the given runtime and deregisters it from the
+// worker.
+//
+// In case the runtime is not registered this method does nothing.
+func (w *Worker) RemoveRuntime(id common.Namespace) {
+	w.Lock()
+	rt, ok := w.runtimes[id]
+	delete(w.runtimes, id)
+	started := w.started
+	w.Unlock()
+
+	if !ok {
+		return
+	}
+
+	w.logger.Info("stopping storage sync services for runtime",
+		"runtime_id", id,
+	)
+
+	rt.Stop()
+	if started {
+		<-rt.Quit()
+	}
+	rt.Cleanup()
+
+	chainContext := w.commonWorker.ChainContext
+	p2p := w.commonWorker.P2P
+	p2p.UnregisterProtocolServer(protocol.NewRuntimeProtocolID(chainContext, id, storageSync.StorageSyncProtocolID, storageSync.StorageSyncProtocolVersion))
+	p2p.UnregisterProtocol(protocol.NewRuntimeProtocolID(chainContext, id, storageSync.StorageSyncProtocolID, storageSync.StorageSyncProtocolVersion))
+	if viper.GetBool(CfgWorkerPublicRPCEnabled) {
+		p2p.UnregisterProtocolServer(protocol.NewRuntimeProtocolID(chainContext, id, storagePub.StoragePubProtocolID, storagePub.StoragePubProtocolVersion))
+	}
+
+	w.logger.Info("runtime deregistered",
+		"runtime_id", id,
+	)
+}